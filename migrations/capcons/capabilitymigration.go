@@ -19,6 +19,8 @@
 package capcons
 
 import (
+	"sync"
+
 	"github.com/onflow/cadence/migrations"
 	"github.com/onflow/cadence/runtime/common"
 	"github.com/onflow/cadence/runtime/errors"
@@ -29,20 +31,71 @@ import (
 type CapabilityMigrationReporter interface {
 	MigratedPathCapability(
 		accountAddress common.Address,
+		storageMapKey interpreter.StorageMapKey,
 		addressPath interpreter.AddressPath,
 		borrowType *interpreter.ReferenceStaticType,
 		capabilityID interpreter.UInt64Value,
+		dryRun bool,
 	)
 	MissingCapabilityID(
 		accountAddress common.Address,
+		storageMapKey interpreter.StorageMapKey,
 		addressPath interpreter.AddressPath,
+		dryRun bool,
 	)
 	MissingBorrowType(
 		targetPath interpreter.AddressPath,
 		storedPath interpreter.AddressPath,
 	)
+	DeletedCapability(
+		accountAddress common.Address,
+		storageMapKey interpreter.StorageMapKey,
+		addressPath interpreter.AddressPath,
+		dryRun bool,
+	)
+	BorrowTypeWidened(
+		accountAddress common.Address,
+		addressPath interpreter.AddressPath,
+		oldBorrowType *interpreter.ReferenceStaticType,
+		controllerBorrowType *interpreter.ReferenceStaticType,
+		dryRun bool,
+	)
+	BorrowTypeMismatch(
+		accountAddress common.Address,
+		addressPath interpreter.AddressPath,
+		oldBorrowType *interpreter.ReferenceStaticType,
+		controllerBorrowType *interpreter.ReferenceStaticType,
+		chosenBorrowType *interpreter.ReferenceStaticType,
+		dryRun bool,
+	)
+	AlreadyMigrated(
+		accountAddress common.Address,
+		storageMapKey interpreter.StorageMapKey,
+		capabilityAddress common.Address,
+		capabilityID interpreter.UInt64Value,
+	)
+}
+
+// SummaryReporter is an optional interface a CapabilityMigrationReporter may implement
+// to receive aggregate statistics once the migration of all values has completed.
+type SummaryReporter interface {
+	Finalize(stats MigrationStats)
 }
 
+// DomainMigrationStats counts the outcomes of migrating path capabilities
+// which target a particular path domain.
+type DomainMigrationStats struct {
+	Migrated            int
+	MissingCapabilityID int
+	// MissingBorrowType counts untyped storage capabilities
+	// for which no borrow type could be inferred,
+	// and which therefore have no capability ID.
+	MissingBorrowType int
+}
+
+// MigrationStats groups the outcomes of the capability value migration by target path domain.
+type MigrationStats map[common.PathDomain]DomainMigrationStats
+
 // CapabilityValueMigration migrates all path capabilities to ID capabilities,
 // using the path to ID capability controller mapping generated by LinkValueMigration.
 type CapabilityValueMigration struct {
@@ -50,9 +103,19 @@ type CapabilityValueMigration struct {
 	TypedStorageCapabilityMapping   *PathTypeCapabilityMapping
 	UntypedStorageCapabilityMapping *PathCapabilityMapping
 	Reporter                        CapabilityMigrationReporter
+	// DryRun performs all lookups and reports as usual,
+	// but never replaces the migrated values
+	DryRun bool
+	// DeleteUnmapped replaces optional path capabilities which have no capability ID with nil.
+	// Path capabilities which are not optional cannot be replaced with nil,
+	// so they are left as-is, and only reported as missing a capability ID
+	DeleteUnmapped bool
+
+	statsLock sync.Mutex
+	stats     MigrationStats
 }
 
-var _ migrations.ValueMigration = &CapabilityValueMigration{}
+var _ migrations.FinalizableValueMigration = &CapabilityValueMigration{}
 
 func (*CapabilityValueMigration) Name() string {
 	return "CapabilityValueMigration"
@@ -62,6 +125,43 @@ func (*CapabilityValueMigration) Domains() map[string]struct{} {
 	return nil
 }
 
+// Finalize reports the aggregate statistics of the migration,
+// if the reporter implements SummaryReporter.
+// It is called by migrations.FinalizeValueMigrations,
+// once the values of all accounts have been migrated.
+func (m *CapabilityValueMigration) Finalize() {
+	summaryReporter, ok := m.Reporter.(SummaryReporter)
+	if !ok {
+		return
+	}
+
+	m.statsLock.Lock()
+	defer m.statsLock.Unlock()
+
+	stats := make(MigrationStats, len(m.stats))
+	// Safe to iterate, as the order does not matter
+	for domain, domainStats := range m.stats { //nolint:maprange
+		stats[domain] = domainStats
+	}
+
+	summaryReporter.Finalize(stats)
+}
+
+func (m *CapabilityValueMigration) recordStats(
+	domain common.PathDomain,
+	update func(stats *DomainMigrationStats),
+) {
+	m.statsLock.Lock()
+	defer m.statsLock.Unlock()
+
+	if m.stats == nil {
+		m.stats = MigrationStats{}
+	}
+	domainStats := m.stats[domain]
+	update(&domainStats)
+	m.stats[domain] = domainStats
+}
+
 var fullyEntitledAccountReferenceStaticType = interpreter.ConvertSemaReferenceTypeToStaticReferenceType(
 	nil,
 	sema.FullyEntitledAccountReferenceType,
@@ -72,26 +172,107 @@ var fullyEntitledAccountReferenceStaticType = interpreter.ConvertSemaReferenceTy
 // If nil is returned, the value was not updated and no operation has to be performed.
 func (m *CapabilityValueMigration) Migrate(
 	storageKey interpreter.StorageKey,
-	_ interpreter.StorageMapKey,
+	storageMapKey interpreter.StorageMapKey,
 	value interpreter.Value,
-	_ *interpreter.Interpreter,
+	inter *interpreter.Interpreter,
 	_ migrations.ValueMigrationPosition,
 ) (
 	interpreter.Value,
 	error,
 ) {
 
-	// Migrate path capabilities to ID capabilities
-	if pathCapabilityValue, ok := value.(*interpreter.PathCapabilityValue); ok { //nolint:staticcheck
-		return m.migratePathCapabilityValue(pathCapabilityValue, storageKey)
+	switch value := value.(type) {
+	case *interpreter.PathCapabilityValue: //nolint:staticcheck
+		// Migrate path capabilities to ID capabilities
+		return m.migratePathCapabilityValue(value, storageKey, storageMapKey)
+
+	case *interpreter.IDCapabilityValue:
+		// ID capabilities were already migrated,
+		// e.g. by a previous, interrupted run of the migration
+		if m.Reporter != nil {
+			m.Reporter.AlreadyMigrated(
+				storageKey.Address,
+				storageMapKey,
+				value.Address().ToAddress(),
+				value.ID,
+			)
+		}
+		return nil, nil
+
+	case *interpreter.SomeValue:
+		// The inner value has already been migrated, as nested values are migrated first.
+		// If it is still a path capability, it has no capability ID
+		if m.DeleteUnmapped {
+			return m.deleteUnmappedOptionalPathCapability(value, storageKey, storageMapKey, inter)
+		}
 	}
 
 	return nil, nil
 }
 
+// deleteUnmappedOptionalPathCapability replaces the given optional with nil,
+// if it contains a path capability which has no capability ID.
+func (m *CapabilityValueMigration) deleteUnmappedOptionalPathCapability(
+	someValue *interpreter.SomeValue,
+	storageKey interpreter.StorageKey,
+	storageMapKey interpreter.StorageMapKey,
+	inter *interpreter.Interpreter,
+) (interpreter.Value, error) {
+
+	innerValue := someValue.InnerValue(inter, interpreter.EmptyLocationRange)
+	pathCapabilityValue, ok := innerValue.(*interpreter.PathCapabilityValue) //nolint:staticcheck
+	if !ok {
+		return nil, nil
+	}
+
+	// In a dry run, the inner path capability is not replaced even if it has a capability ID
+	if m.hasCapabilityID(pathCapabilityValue) {
+		return nil, nil
+	}
+
+	if m.Reporter != nil {
+		m.Reporter.DeletedCapability(
+			storageKey.Address,
+			storageMapKey,
+			pathCapabilityValue.AddressPath(),
+			m.DryRun,
+		)
+	}
+
+	if m.DryRun {
+		return nil, nil
+	}
+
+	return interpreter.Nil, nil
+}
+
+// hasCapabilityID returns true if the given path capability can be migrated to an ID capability.
+func (m *CapabilityValueMigration) hasCapabilityID(
+	pathCapabilityValue *interpreter.PathCapabilityValue, //nolint:staticcheck
+) bool {
+	capabilityAddressPath := pathCapabilityValue.AddressPath()
+	borrowType := pathCapabilityValue.BorrowType
+
+	var ok bool
+	switch capabilityAddressPath.Path.Domain {
+	case common.PathDomainPrivate, common.PathDomainPublic:
+		_, _, ok = m.PrivatePublicCapabilityMapping.Get(capabilityAddressPath)
+
+	case common.PathDomainStorage:
+		if borrowType != nil {
+			_, ok = m.TypedStorageCapabilityMapping.Get(capabilityAddressPath, borrowType.ID())
+		} else {
+			_, _, ok = m.UntypedStorageCapabilityMapping.Get(capabilityAddressPath)
+		}
+	}
+
+	return ok
+}
+
 func (m *CapabilityValueMigration) migratePathCapabilityValue(
 	oldCapability *interpreter.PathCapabilityValue, //nolint:staticcheck
 	storageKey interpreter.StorageKey,
+	storageMapKey interpreter.StorageMapKey,
 ) (interpreter.Value, error) {
 
 	reporter := m.Reporter
@@ -109,10 +290,15 @@ func (m *CapabilityValueMigration) migratePathCapabilityValue(
 		var ok bool
 		capabilityID, controllerBorrowType, ok = m.PrivatePublicCapabilityMapping.Get(capabilityAddressPath)
 		if !ok {
+			m.recordStats(targetPath.Domain, func(stats *DomainMigrationStats) {
+				stats.MissingCapabilityID++
+			})
 			if reporter != nil {
 				reporter.MissingCapabilityID(
 					storageKey.Address,
+					storageMapKey,
 					capabilityAddressPath,
+					m.DryRun,
 				)
 			}
 			return nil, nil
@@ -122,6 +308,28 @@ func (m *CapabilityValueMigration) migratePathCapabilityValue(
 		// by using capability controller's borrow type
 		if oldBorrowType == nil {
 			oldBorrowType = controllerBorrowType
+		} else if reporter != nil {
+			if isBorrowTypeWidened(oldBorrowType, controllerBorrowType) {
+				reporter.BorrowTypeWidened(
+					storageKey.Address,
+					capabilityAddressPath,
+					oldBorrowType.(*interpreter.ReferenceStaticType),
+					controllerBorrowType,
+					m.DryRun,
+				)
+			} else if isBorrowTypeMismatch(oldBorrowType, controllerBorrowType) {
+				// The old borrow type is kept for the ID capability,
+				// but its authorization is not granted by the borrow type of the capability controller
+				oldReferenceType := oldBorrowType.(*interpreter.ReferenceStaticType)
+				reporter.BorrowTypeMismatch(
+					storageKey.Address,
+					capabilityAddressPath,
+					oldReferenceType,
+					controllerBorrowType,
+					oldReferenceType,
+					m.DryRun,
+				)
+			}
 		}
 
 	case common.PathDomainStorage:
@@ -131,10 +339,15 @@ func (m *CapabilityValueMigration) migratePathCapabilityValue(
 			var ok bool
 			capabilityID, ok = m.TypedStorageCapabilityMapping.Get(capabilityAddressPath, oldBorrowType.ID())
 			if !ok {
+				m.recordStats(targetPath.Domain, func(stats *DomainMigrationStats) {
+					stats.MissingCapabilityID++
+				})
 				if reporter != nil {
 					reporter.MissingCapabilityID(
 						storageKey.Address,
+						storageMapKey,
 						capabilityAddressPath,
+						m.DryRun,
 					)
 				}
 				return nil, nil
@@ -143,10 +356,15 @@ func (m *CapabilityValueMigration) migratePathCapabilityValue(
 			var ok bool
 			capabilityID, oldBorrowType, ok = m.UntypedStorageCapabilityMapping.Get(capabilityAddressPath)
 			if !ok {
+				m.recordStats(targetPath.Domain, func(stats *DomainMigrationStats) {
+					stats.MissingBorrowType++
+				})
 				if reporter != nil {
 					reporter.MissingCapabilityID(
 						storageKey.Address,
+						storageMapKey,
 						capabilityAddressPath,
+						m.DryRun,
 					)
 				}
 				return nil, nil
@@ -168,18 +386,122 @@ func (m *CapabilityValueMigration) migratePathCapabilityValue(
 		newBorrowType,
 	)
 
+	m.recordStats(targetPath.Domain, func(stats *DomainMigrationStats) {
+		stats.Migrated++
+	})
+
 	if reporter != nil {
 		reporter.MigratedPathCapability(
 			storageKey.Address,
+			storageMapKey,
 			capabilityAddressPath,
 			newBorrowType,
 			capabilityID,
+			m.DryRun,
 		)
 	}
 
+	if m.DryRun {
+		return nil, nil
+	}
+
 	return newCapability, nil
 }
 
+// isBorrowTypeWidened returns true if the old borrow type is an unauthorized reference type,
+// but the borrow type of the capability controller is an authorized (entitled) reference
+// to the same type, i.e. authorization was added to the capability through its controller
+func isBorrowTypeWidened(
+	oldBorrowType interpreter.StaticType,
+	controllerBorrowType *interpreter.ReferenceStaticType,
+) bool {
+	oldReferenceType, ok := oldBorrowType.(*interpreter.ReferenceStaticType)
+	if !ok || controllerBorrowType == nil {
+		return false
+	}
+
+	if !oldReferenceType.ReferencedType.Equal(controllerBorrowType.ReferencedType) {
+		return false
+	}
+
+	return oldReferenceType.Authorization.Equal(interpreter.UnauthorizedAccess) &&
+		!controllerBorrowType.Authorization.Equal(interpreter.UnauthorizedAccess)
+}
+
+// isBorrowTypeMismatch returns true if the authorization of the old borrow type
+// is not granted by the borrow type of the capability controller,
+// e.g. the old borrow type is an authorized (entitled) reference type,
+// but the borrow type of the capability controller is an unauthorized reference type,
+// or the two borrow types have unrelated entitlements,
+// i.e. the ID capability would claim authorization its controller does not grant
+func isBorrowTypeMismatch(
+	oldBorrowType interpreter.StaticType,
+	controllerBorrowType *interpreter.ReferenceStaticType,
+) bool {
+	oldReferenceType, ok := oldBorrowType.(*interpreter.ReferenceStaticType)
+	if !ok || controllerBorrowType == nil {
+		return false
+	}
+
+	return !isAuthorizationSubtype(
+		controllerBorrowType.Authorization,
+		oldReferenceType.Authorization,
+	)
+}
+
+// isAuthorizationSubtype returns true if a reference with the given sub-authorization
+// is a subtype of a reference with the given super-authorization.
+// It follows the rules of sema.Access.PermitsAccess,
+// but entitlement map authorizations are only subtypes of equal authorizations
+func isAuthorizationSubtype(subAuthorization, superAuthorization interpreter.Authorization) bool {
+	if subAuthorization.Equal(superAuthorization) {
+		return true
+	}
+
+	switch superAuthorization := superAuthorization.(type) {
+	case interpreter.Unauthorized:
+		_, ok := subAuthorization.(interpreter.Inaccessible)
+		return !ok
+
+	case interpreter.EntitlementSetAuthorization:
+		subAuthorization, ok := subAuthorization.(interpreter.EntitlementSetAuthorization)
+		if !ok {
+			return false
+		}
+
+		superEntitlements := superAuthorization.Entitlements
+		subEntitlements := subAuthorization.Entitlements
+
+		switch superAuthorization.SetKind {
+		case sema.Conjunction:
+			switch subAuthorization.SetKind {
+			case sema.Conjunction:
+				// The sub-authorization must have all entitlements of the super-authorization
+				return superEntitlements.ForAllKeys(subEntitlements.Contains)
+			case sema.Disjunction:
+				// The sub-authorization must have exactly the same single entitlement
+				return superEntitlements.ForAllKeys(func(superKey common.TypeID) bool {
+					return subEntitlements.ForAllKeys(func(subKey common.TypeID) bool {
+						return superKey == subKey
+					})
+				})
+			}
+
+		case sema.Disjunction:
+			switch subAuthorization.SetKind {
+			case sema.Conjunction:
+				// The sub-authorization must have any of the entitlements of the super-authorization
+				return superEntitlements.ForAnyKey(subEntitlements.Contains)
+			case sema.Disjunction:
+				// The sub-authorization entitlements must be a subset of the super-authorization
+				return subEntitlements.ForAllKeys(superEntitlements.Contains)
+			}
+		}
+	}
+
+	return false
+}
+
 func (m *CapabilityValueMigration) CanSkip(valueType interpreter.StaticType) bool {
 	return CanSkipCapabilityValueMigration(valueType)
 }
@@ -196,34 +518,70 @@ func CanSkipCapabilityValueMigration(valueType interpreter.StaticType) bool {
 	case *interpreter.OptionalStaticType:
 		return CanSkipCapabilityValueMigration(valueType.Type)
 
+	case interpreter.InclusiveRangeStaticType:
+		// Conservatively assume the range may contain capabilities,
+		// if the element type is unknown
+		if valueType.ElementType == nil {
+			return false
+		}
+		return CanSkipCapabilityValueMigration(valueType.ElementType)
+
 	case *interpreter.CapabilityStaticType:
 		return false
 
 	case interpreter.PrimitiveStaticType:
+		return canSkipPrimitiveStaticType(valueType)
+	}
 
-		switch valueType {
-		case interpreter.PrimitiveStaticTypeCapability:
-			return false
+	return false
+}
 
-		case interpreter.PrimitiveStaticTypeBool,
-			interpreter.PrimitiveStaticTypeVoid,
-			interpreter.PrimitiveStaticTypeAddress,
-			interpreter.PrimitiveStaticTypeMetaType,
-			interpreter.PrimitiveStaticTypeBlock,
-			interpreter.PrimitiveStaticTypeString,
-			interpreter.PrimitiveStaticTypeCharacter:
+// canSkipPrimitiveStaticTypeCache caches the results of uncachedCanSkipPrimitiveStaticType.
+//
+// Deciding primitive types may require subtyping checks, which are comparatively costly,
+// and the same few primitive types occur for millions of stored values.
+// The set of primitive types is small, so the cache is bounded.
+//
+// Container types (arrays, dictionaries, optionals, etc.) are not cached themselves:
+// Deciding them only requires an allocation-free walk over their nested types,
+// which is cheaper than computing their type ID as a cache key
+var canSkipPrimitiveStaticTypeCache sync.Map
+
+func canSkipPrimitiveStaticType(primitiveType interpreter.PrimitiveStaticType) bool {
+	if canSkip, ok := canSkipPrimitiveStaticTypeCache.Load(primitiveType); ok {
+		return canSkip.(bool)
+	}
 
-			return true
-		}
+	canSkip := uncachedCanSkipPrimitiveStaticType(primitiveType)
 
-		if !valueType.IsDeprecated() { //nolint:staticcheck
-			semaType := valueType.SemaType()
+	canSkipPrimitiveStaticTypeCache.Store(primitiveType, canSkip)
 
-			if sema.IsSubType(semaType, sema.NumberType) ||
-				sema.IsSubType(semaType, sema.PathType) {
+	return canSkip
+}
 
-				return true
-			}
+func uncachedCanSkipPrimitiveStaticType(primitiveType interpreter.PrimitiveStaticType) bool {
+	switch primitiveType {
+	case interpreter.PrimitiveStaticTypeCapability:
+		return false
+
+	case interpreter.PrimitiveStaticTypeBool,
+		interpreter.PrimitiveStaticTypeVoid,
+		interpreter.PrimitiveStaticTypeAddress,
+		interpreter.PrimitiveStaticTypeMetaType,
+		interpreter.PrimitiveStaticTypeBlock,
+		interpreter.PrimitiveStaticTypeString,
+		interpreter.PrimitiveStaticTypeCharacter:
+
+		return true
+	}
+
+	if !primitiveType.IsDeprecated() { //nolint:staticcheck
+		semaType := primitiveType.SemaType()
+
+		if sema.IsSubType(semaType, sema.NumberType) ||
+			sema.IsSubType(semaType, sema.PathType) {
+
+			return true
 		}
 	}
 
@@ -0,0 +1,282 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package capcons
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"sync"
+
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/interpreter"
+)
+
+const (
+	CSVReporterStatusMigrated            = "migrated"
+	CSVReporterStatusMissingCapabilityID = "missing capability ID"
+	CSVReporterStatusMissingBorrowType   = "missing borrow type"
+	CSVReporterStatusDeleted             = "deleted"
+	CSVReporterStatusBorrowTypeWidened   = "borrow type widened"
+	CSVReporterStatusBorrowTypeMismatch  = "borrow type mismatch"
+	CSVReporterStatusAlreadyMigrated     = "already migrated"
+)
+
+var CSVReporterHeader = []string{
+	"account address",
+	"storage key",
+	"target address",
+	"target path domain",
+	"target path identifier",
+	"borrow type",
+	"controller borrow type",
+	"capability ID",
+	"status",
+	"dry run",
+}
+
+// CSVReporter is a CapabilityMigrationReporter which writes an audit log of the migration in CSV format.
+//
+// Each reported event is written as one row, see CSVReporterHeader for the columns.
+// The account address and storage key are the location of the stored capability value,
+// the target address and path are the target of the path capability.
+// The borrow type is the borrow type of the migrated ID capability,
+// the controller borrow type is the borrow type of the capability controller,
+// if it differs from the borrow type.
+// Already migrated ID capabilities have no path, so only their target address is written.
+// Columns which do not apply to an event are left empty.
+//
+// The reporter is safe for concurrent use.
+// Rows are buffered, so Flush must be called once the migration has completed.
+type CSVReporter struct {
+	lock   sync.Mutex
+	writer *csv.Writer
+}
+
+var _ CapabilityMigrationReporter = &CSVReporter{}
+
+// NewCSVReporter returns a new CSVReporter which writes to the given writer,
+// starting with the header row.
+func NewCSVReporter(w io.Writer) *CSVReporter {
+	reporter := &CSVReporter{
+		writer: csv.NewWriter(w),
+	}
+	reporter.write(CSVReporterHeader)
+	return reporter
+}
+
+// Flush writes any buffered rows to the underlying writer,
+// and returns the first error which occurred while writing, if any.
+func (r *CSVReporter) Flush() error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.writer.Flush()
+	return r.writer.Error()
+}
+
+func (r *CSVReporter) write(record []string) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	// Errors are sticky, and reported by Flush
+	_ = r.writer.Write(record)
+}
+
+// csvReporterRow is a row written by CSVReporter.
+// Fields which are nil are written as empty columns
+type csvReporterRow struct {
+	accountAddress       *common.Address
+	storageMapKey        interpreter.StorageMapKey
+	targetAddress        common.Address
+	targetPath           *interpreter.PathValue
+	borrowType           *interpreter.ReferenceStaticType
+	controllerBorrowType *interpreter.ReferenceStaticType
+	capabilityID         *interpreter.UInt64Value
+	status               string
+	dryRun               *bool
+}
+
+func (r *CSVReporter) writeRow(row csvReporterRow) {
+	var accountAddressColumn string
+	if row.accountAddress != nil {
+		accountAddressColumn = row.accountAddress.HexWithPrefix()
+	}
+
+	var storageMapKeyColumn string
+	if row.storageMapKey != nil {
+		storageMapKeyColumn = fmt.Sprint(row.storageMapKey)
+	}
+
+	var pathDomainColumn, pathIdentifierColumn string
+	if row.targetPath != nil {
+		pathDomainColumn = row.targetPath.Domain.Identifier()
+		pathIdentifierColumn = row.targetPath.Identifier
+	}
+
+	var borrowTypeColumn string
+	if row.borrowType != nil {
+		borrowTypeColumn = string(row.borrowType.ID())
+	}
+
+	var controllerBorrowTypeColumn string
+	if row.controllerBorrowType != nil {
+		controllerBorrowTypeColumn = string(row.controllerBorrowType.ID())
+	}
+
+	var capabilityIDColumn string
+	if row.capabilityID != nil {
+		capabilityIDColumn = row.capabilityID.String()
+	}
+
+	var dryRunColumn string
+	if row.dryRun != nil {
+		dryRunColumn = strconv.FormatBool(*row.dryRun)
+	}
+
+	r.write([]string{
+		accountAddressColumn,
+		storageMapKeyColumn,
+		row.targetAddress.HexWithPrefix(),
+		pathDomainColumn,
+		pathIdentifierColumn,
+		borrowTypeColumn,
+		controllerBorrowTypeColumn,
+		capabilityIDColumn,
+		row.status,
+		dryRunColumn,
+	})
+}
+
+func (r *CSVReporter) MigratedPathCapability(
+	accountAddress common.Address,
+	storageMapKey interpreter.StorageMapKey,
+	addressPath interpreter.AddressPath,
+	borrowType *interpreter.ReferenceStaticType,
+	capabilityID interpreter.UInt64Value,
+	dryRun bool,
+) {
+	r.writeRow(csvReporterRow{
+		accountAddress: &accountAddress,
+		storageMapKey:  storageMapKey,
+		targetAddress:  addressPath.Address,
+		targetPath:     &addressPath.Path,
+		borrowType:     borrowType,
+		capabilityID:   &capabilityID,
+		status:         CSVReporterStatusMigrated,
+		dryRun:         &dryRun,
+	})
+}
+
+func (r *CSVReporter) MissingCapabilityID(
+	accountAddress common.Address,
+	storageMapKey interpreter.StorageMapKey,
+	addressPath interpreter.AddressPath,
+	dryRun bool,
+) {
+	r.writeRow(csvReporterRow{
+		accountAddress: &accountAddress,
+		storageMapKey:  storageMapKey,
+		targetAddress:  addressPath.Address,
+		targetPath:     &addressPath.Path,
+		status:         CSVReporterStatusMissingCapabilityID,
+		dryRun:         &dryRun,
+	})
+}
+
+func (r *CSVReporter) MissingBorrowType(
+	targetPath interpreter.AddressPath,
+	storedPath interpreter.AddressPath,
+) {
+	r.writeRow(csvReporterRow{
+		accountAddress: &storedPath.Address,
+		targetAddress:  targetPath.Address,
+		targetPath:     &targetPath.Path,
+		status:         CSVReporterStatusMissingBorrowType,
+	})
+}
+
+func (r *CSVReporter) DeletedCapability(
+	accountAddress common.Address,
+	storageMapKey interpreter.StorageMapKey,
+	addressPath interpreter.AddressPath,
+	dryRun bool,
+) {
+	r.writeRow(csvReporterRow{
+		accountAddress: &accountAddress,
+		storageMapKey:  storageMapKey,
+		targetAddress:  addressPath.Address,
+		targetPath:     &addressPath.Path,
+		status:         CSVReporterStatusDeleted,
+		dryRun:         &dryRun,
+	})
+}
+
+func (r *CSVReporter) BorrowTypeWidened(
+	accountAddress common.Address,
+	addressPath interpreter.AddressPath,
+	oldBorrowType *interpreter.ReferenceStaticType,
+	controllerBorrowType *interpreter.ReferenceStaticType,
+	dryRun bool,
+) {
+	r.writeRow(csvReporterRow{
+		accountAddress:       &accountAddress,
+		targetAddress:        addressPath.Address,
+		targetPath:           &addressPath.Path,
+		borrowType:           oldBorrowType,
+		controllerBorrowType: controllerBorrowType,
+		status:               CSVReporterStatusBorrowTypeWidened,
+		dryRun:               &dryRun,
+	})
+}
+
+func (r *CSVReporter) BorrowTypeMismatch(
+	accountAddress common.Address,
+	addressPath interpreter.AddressPath,
+	_ *interpreter.ReferenceStaticType,
+	controllerBorrowType *interpreter.ReferenceStaticType,
+	chosenBorrowType *interpreter.ReferenceStaticType,
+	dryRun bool,
+) {
+	r.writeRow(csvReporterRow{
+		accountAddress:       &accountAddress,
+		targetAddress:        addressPath.Address,
+		targetPath:           &addressPath.Path,
+		borrowType:           chosenBorrowType,
+		controllerBorrowType: controllerBorrowType,
+		status:               CSVReporterStatusBorrowTypeMismatch,
+		dryRun:               &dryRun,
+	})
+}
+
+func (r *CSVReporter) AlreadyMigrated(
+	accountAddress common.Address,
+	storageMapKey interpreter.StorageMapKey,
+	capabilityAddress common.Address,
+	capabilityID interpreter.UInt64Value,
+) {
+	r.writeRow(csvReporterRow{
+		accountAddress: &accountAddress,
+		storageMapKey:  storageMapKey,
+		targetAddress:  capabilityAddress,
+		capabilityID:   &capabilityID,
+		status:         CSVReporterStatusAlreadyMigrated,
+	})
+}
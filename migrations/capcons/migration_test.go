@@ -19,7 +19,9 @@
 package capcons
 
 import (
+	"encoding/csv"
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -81,14 +83,49 @@ type testCapConsLinkMigration struct {
 
 type testCapConsPathCapabilityMigration struct {
 	accountAddress common.Address
+	storageMapKey  interpreter.StorageMapKey
 	addressPath    interpreter.AddressPath
 	borrowType     *interpreter.ReferenceStaticType
 	capabilityID   interpreter.UInt64Value
+	dryRun         bool
 }
 
 type testCapConsMissingCapabilityID struct {
 	accountAddress common.Address
+	storageMapKey  interpreter.StorageMapKey
 	addressPath    interpreter.AddressPath
+	dryRun         bool
+}
+
+type testCapConsDeletedCapability struct {
+	accountAddress common.Address
+	storageMapKey  interpreter.StorageMapKey
+	addressPath    interpreter.AddressPath
+	dryRun         bool
+}
+
+type testCapConsBorrowTypeWidened struct {
+	accountAddress       common.Address
+	addressPath          interpreter.AddressPath
+	oldBorrowType        *interpreter.ReferenceStaticType
+	controllerBorrowType *interpreter.ReferenceStaticType
+	dryRun               bool
+}
+
+type testCapConsBorrowTypeMismatch struct {
+	accountAddress       common.Address
+	addressPath          interpreter.AddressPath
+	oldBorrowType        *interpreter.ReferenceStaticType
+	controllerBorrowType *interpreter.ReferenceStaticType
+	chosenBorrowType     *interpreter.ReferenceStaticType
+	dryRun               bool
+}
+
+type testCapConsAlreadyMigrated struct {
+	accountAddress    common.Address
+	storageMapKey     interpreter.StorageMapKey
+	capabilityAddress common.Address
+	capabilityID      interpreter.UInt64Value
 }
 
 type testStorageCapConIssued struct {
@@ -121,17 +158,23 @@ type testMigrationReporter struct {
 	linkMigrations                   []testCapConsLinkMigration
 	pathCapabilityMigrations         []testCapConsPathCapabilityMigration
 	missingCapabilityIDs             []testCapConsMissingCapabilityID
+	deletedCapabilities              []testCapConsDeletedCapability
+	widenedBorrowTypes               []testCapConsBorrowTypeWidened
+	mismatchedBorrowTypes            []testCapConsBorrowTypeMismatch
+	alreadyMigrated                  []testCapConsAlreadyMigrated
 	issuedStorageCapCons             []testStorageCapConIssued
 	missingStorageCapConBorrowTypes  []testStorageCapConsMissingBorrowType
 	inferredStorageCapConBorrowTypes []testStorageCapConsInferredBorrowType
 	cyclicLinkErrors                 []CyclicLinkError
 	missingTargets                   []interpreter.AddressPath
+	stats                            MigrationStats
 }
 
 var _ migrations.Reporter = &testMigrationReporter{}
 var _ LinkMigrationReporter = &testMigrationReporter{}
 var _ CapabilityMigrationReporter = &testMigrationReporter{}
 var _ StorageCapabilityMigrationReporter = &testMigrationReporter{}
+var _ SummaryReporter = &testMigrationReporter{}
 
 func (t *testMigrationReporter) Migrated(
 	storageKey interpreter.StorageKey,
@@ -166,30 +209,112 @@ func (t *testMigrationReporter) MigratedLink(
 
 func (t *testMigrationReporter) MigratedPathCapability(
 	accountAddress common.Address,
+	storageMapKey interpreter.StorageMapKey,
 	addressPath interpreter.AddressPath,
 	borrowType *interpreter.ReferenceStaticType,
 	capabilityID interpreter.UInt64Value,
+	dryRun bool,
 ) {
 	t.pathCapabilityMigrations = append(
 		t.pathCapabilityMigrations,
 		testCapConsPathCapabilityMigration{
 			accountAddress: accountAddress,
+			storageMapKey:  storageMapKey,
 			addressPath:    addressPath,
 			borrowType:     borrowType,
 			capabilityID:   capabilityID,
+			dryRun:         dryRun,
 		},
 	)
 }
 
 func (t *testMigrationReporter) MissingCapabilityID(
 	accountAddress common.Address,
+	storageMapKey interpreter.StorageMapKey,
 	addressPath interpreter.AddressPath,
+	dryRun bool,
 ) {
 	t.missingCapabilityIDs = append(
 		t.missingCapabilityIDs,
 		testCapConsMissingCapabilityID{
 			accountAddress: accountAddress,
+			storageMapKey:  storageMapKey,
+			addressPath:    addressPath,
+			dryRun:         dryRun,
+		},
+	)
+}
+
+func (t *testMigrationReporter) DeletedCapability(
+	accountAddress common.Address,
+	storageMapKey interpreter.StorageMapKey,
+	addressPath interpreter.AddressPath,
+	dryRun bool,
+) {
+	t.deletedCapabilities = append(
+		t.deletedCapabilities,
+		testCapConsDeletedCapability{
+			accountAddress: accountAddress,
+			storageMapKey:  storageMapKey,
 			addressPath:    addressPath,
+			dryRun:         dryRun,
+		},
+	)
+}
+
+func (t *testMigrationReporter) BorrowTypeWidened(
+	accountAddress common.Address,
+	addressPath interpreter.AddressPath,
+	oldBorrowType *interpreter.ReferenceStaticType,
+	controllerBorrowType *interpreter.ReferenceStaticType,
+	dryRun bool,
+) {
+	t.widenedBorrowTypes = append(
+		t.widenedBorrowTypes,
+		testCapConsBorrowTypeWidened{
+			accountAddress:       accountAddress,
+			addressPath:          addressPath,
+			oldBorrowType:        oldBorrowType,
+			controllerBorrowType: controllerBorrowType,
+			dryRun:               dryRun,
+		},
+	)
+}
+
+func (t *testMigrationReporter) BorrowTypeMismatch(
+	accountAddress common.Address,
+	addressPath interpreter.AddressPath,
+	oldBorrowType *interpreter.ReferenceStaticType,
+	controllerBorrowType *interpreter.ReferenceStaticType,
+	chosenBorrowType *interpreter.ReferenceStaticType,
+	dryRun bool,
+) {
+	t.mismatchedBorrowTypes = append(
+		t.mismatchedBorrowTypes,
+		testCapConsBorrowTypeMismatch{
+			accountAddress:       accountAddress,
+			addressPath:          addressPath,
+			oldBorrowType:        oldBorrowType,
+			controllerBorrowType: controllerBorrowType,
+			chosenBorrowType:     chosenBorrowType,
+			dryRun:               dryRun,
+		},
+	)
+}
+
+func (t *testMigrationReporter) AlreadyMigrated(
+	accountAddress common.Address,
+	storageMapKey interpreter.StorageMapKey,
+	capabilityAddress common.Address,
+	capabilityID interpreter.UInt64Value,
+) {
+	t.alreadyMigrated = append(
+		t.alreadyMigrated,
+		testCapConsAlreadyMigrated{
+			accountAddress:    accountAddress,
+			storageMapKey:     storageMapKey,
+			capabilityAddress: capabilityAddress,
+			capabilityID:      capabilityID,
 		},
 	)
 }
@@ -255,6 +380,10 @@ func (t *testMigrationReporter) MissingTarget(
 	)
 }
 
+func (t *testMigrationReporter) Finalize(stats MigrationStats) {
+	t.stats = stats
+}
+
 func (t *testMigrationReporter) DictionaryKeyConflict(addressPath interpreter.AddressPath) {
 	// For testing purposes, record the conflict as an error
 	t.errors = append(t.errors, fmt.Errorf("dictionary key conflict: %s", addressPath))
@@ -789,6 +918,7 @@ func TestPathCapabilityValueMigration(t *testing.T) {
 			expectedPathMigrations: []testCapConsPathCapabilityMigration{
 				{
 					accountAddress: testAddress,
+					storageMapKey:  interpreter.StringStorageMapKey("wrappedCapability"),
 					addressPath: interpreter.AddressPath{
 						Address: testAddress,
 						Path: interpreter.NewUnmeteredPathValue(
@@ -845,6 +975,7 @@ func TestPathCapabilityValueMigration(t *testing.T) {
 			expectedPathMigrations: []testCapConsPathCapabilityMigration{
 				{
 					accountAddress: testAddress,
+					storageMapKey:  interpreter.StringStorageMapKey("wrappedCapability"),
 					addressPath: interpreter.AddressPath{
 						Address: testAddress,
 						Path: interpreter.NewUnmeteredPathValue(
@@ -900,6 +1031,7 @@ func TestPathCapabilityValueMigration(t *testing.T) {
 			expectedPathMigrations: []testCapConsPathCapabilityMigration{
 				{
 					accountAddress: testAddress,
+					storageMapKey:  interpreter.StringStorageMapKey("wrappedCapability"),
 					addressPath: interpreter.AddressPath{
 						Address: testAddress,
 						Path: interpreter.NewUnmeteredPathValue(
@@ -981,6 +1113,7 @@ func TestPathCapabilityValueMigration(t *testing.T) {
 			expectedPathMigrations: []testCapConsPathCapabilityMigration{
 				{
 					accountAddress: testAddress,
+					storageMapKey:  interpreter.StringStorageMapKey("wrappedCapability"),
 					addressPath: interpreter.AddressPath{
 						Address: testAddress,
 						Path: interpreter.NewUnmeteredPathValue(
@@ -1039,6 +1172,7 @@ func TestPathCapabilityValueMigration(t *testing.T) {
 			expectedPathMigrations: []testCapConsPathCapabilityMigration{
 				{
 					accountAddress: testAddress,
+					storageMapKey:  interpreter.StringStorageMapKey("wrappedCapability"),
 					addressPath: interpreter.AddressPath{
 						Address: testAddress,
 						Path: interpreter.NewUnmeteredPathValue(
@@ -1098,6 +1232,7 @@ func TestPathCapabilityValueMigration(t *testing.T) {
 			expectedMissingCapabilityIDs: []testCapConsMissingCapabilityID{
 				{
 					accountAddress: testAddress,
+					storageMapKey:  interpreter.StringStorageMapKey("wrappedCapability"),
 					addressPath: interpreter.AddressPath{
 						Address: testAddress,
 						Path: interpreter.NewUnmeteredPathValue(
@@ -1125,6 +1260,7 @@ func TestPathCapabilityValueMigration(t *testing.T) {
 			expectedMissingCapabilityIDs: []testCapConsMissingCapabilityID{
 				{
 					accountAddress: testAddress,
+					storageMapKey:  interpreter.StringStorageMapKey("wrappedCapability"),
 					addressPath: interpreter.AddressPath{
 						Address: testAddress,
 						Path: interpreter.NewUnmeteredPathValue(
@@ -1166,6 +1302,7 @@ func TestPathCapabilityValueMigration(t *testing.T) {
 			expectedMissingCapabilityIDs: []testCapConsMissingCapabilityID{
 				{
 					accountAddress: testAddress,
+					storageMapKey:  interpreter.StringStorageMapKey("wrappedCapability"),
 					addressPath: interpreter.AddressPath{
 						Address: testAddress,
 						Path: interpreter.NewUnmeteredPathValue(
@@ -1195,6 +1332,7 @@ func TestPathCapabilityValueMigration(t *testing.T) {
 			expectedPathMigrations: []testCapConsPathCapabilityMigration{
 				{
 					accountAddress: testAddress,
+					storageMapKey:  interpreter.StringStorageMapKey("wrappedCapability"),
 					addressPath: interpreter.AddressPath{
 						Address: testAddress,
 						Path: interpreter.NewUnmeteredPathValue(
@@ -1243,6 +1381,7 @@ func TestPathCapabilityValueMigration(t *testing.T) {
 			expectedPathMigrations: []testCapConsPathCapabilityMigration{
 				{
 					accountAddress: testAddress,
+					storageMapKey:  interpreter.StringStorageMapKey("wrappedCapability"),
 					addressPath: interpreter.AddressPath{
 						Address: testAddress,
 						Path: interpreter.NewUnmeteredPathValue(
@@ -1295,6 +1434,7 @@ func TestPathCapabilityValueMigration(t *testing.T) {
 			expectedPathMigrations: []testCapConsPathCapabilityMigration{
 				{
 					accountAddress: testAddress,
+					storageMapKey:  interpreter.StringStorageMapKey("wrappedCapability"),
 					addressPath: interpreter.AddressPath{
 						Address: testAddress,
 						Path: interpreter.NewUnmeteredPathValue(
@@ -1343,6 +1483,7 @@ func TestPathCapabilityValueMigration(t *testing.T) {
 			expectedPathMigrations: []testCapConsPathCapabilityMigration{
 				{
 					accountAddress: testAddress,
+					storageMapKey:  interpreter.StringStorageMapKey("wrappedCapability"),
 					addressPath: interpreter.AddressPath{
 						Address: testAddress,
 						Path: interpreter.NewUnmeteredPathValue(
@@ -1391,6 +1532,7 @@ func TestPathCapabilityValueMigration(t *testing.T) {
 			expectedPathMigrations: []testCapConsPathCapabilityMigration{
 				{
 					accountAddress: testAddress,
+					storageMapKey:  interpreter.StringStorageMapKey("wrappedCapability"),
 					addressPath: interpreter.AddressPath{
 						Address: testAddress,
 						Path: interpreter.NewUnmeteredPathValue(
@@ -2358,6 +2500,7 @@ func TestPublishedPathCapabilityValueMigration(t *testing.T) {
 	expectedPathMigrations := []testCapConsPathCapabilityMigration{
 		{
 			accountAddress: testAddress,
+			storageMapKey:  interpreter.StringStorageMapKey("foo"),
 			addressPath: interpreter.AddressPath{
 				Address: testAddress,
 				Path: interpreter.NewUnmeteredPathValue(
@@ -2610,6 +2753,7 @@ func TestUntypedPathCapabilityValueMigration(t *testing.T) {
 	expectedPathMigrations := []testCapConsPathCapabilityMigration{
 		{
 			accountAddress: testAddress,
+			storageMapKey:  interpreter.StringStorageMapKey("cap"),
 			addressPath: interpreter.AddressPath{
 				Address: testAddress,
 				Path: interpreter.NewUnmeteredPathValue(
@@ -2891,12 +3035,104 @@ func TestCanSkipCapabilityValueMigration(t *testing.T) {
 				actual := CanSkipCapabilityValueMigration(dictionaryType)
 				assert.Equal(t, expected, actual)
 			})
+
+			t.Run("inclusive range", func(t *testing.T) {
+
+				t.Parallel()
+
+				inclusiveRangeType := interpreter.NewInclusiveRangeStaticType(nil, ty)
+
+				actual := CanSkipCapabilityValueMigration(inclusiveRangeType)
+				assert.Equal(t, expected, actual)
+			})
 		})
 	}
 
 	for ty, expected := range testCases {
 		test(ty, expected)
 	}
+
+	t.Run("inclusive range with unknown element type", func(t *testing.T) {
+
+		t.Parallel()
+
+		inclusiveRangeType := interpreter.InclusiveRangeStaticType{}
+
+		actual := CanSkipCapabilityValueMigration(inclusiveRangeType)
+		assert.False(t, actual)
+	})
+}
+
+func TestCanSkipPrimitiveStaticTypeCache(t *testing.T) {
+
+	t.Parallel()
+
+	for ty := interpreter.PrimitiveStaticTypeUnknown + 1; ty < interpreter.PrimitiveStaticType_Count; ty++ {
+		if !ty.IsDefined() {
+			continue
+		}
+
+		uncached := uncachedCanSkipPrimitiveStaticType(ty)
+
+		// invoke twice, so the second invocation uses the cached result
+		for i := 0; i < 2; i++ {
+			cached := canSkipPrimitiveStaticType(ty)
+			assert.Equal(t, uncached, cached, ty.String())
+		}
+	}
+}
+
+func BenchmarkCanSkipCapabilityValueMigration(b *testing.B) {
+
+	primitiveTypes := []interpreter.PrimitiveStaticType{
+		interpreter.PrimitiveStaticTypeUInt64,
+		interpreter.PrimitiveStaticTypeStoragePath,
+		interpreter.PrimitiveStaticTypeAnyStruct,
+	}
+
+	b.Run("uncached", func(b *testing.B) {
+		b.ReportAllocs()
+
+		for i := 0; i < b.N; i++ {
+			for _, ty := range primitiveTypes {
+				uncachedCanSkipPrimitiveStaticType(ty)
+			}
+		}
+	})
+
+	b.Run("cached", func(b *testing.B) {
+		b.ReportAllocs()
+
+		for i := 0; i < b.N; i++ {
+			for _, ty := range primitiveTypes {
+				canSkipPrimitiveStaticType(ty)
+			}
+		}
+	})
+
+	b.Run("nested", func(b *testing.B) {
+		b.ReportAllocs()
+
+		// {String: [[UInt64?]]}
+		valueType := interpreter.NewDictionaryStaticType(
+			nil,
+			interpreter.PrimitiveStaticTypeString,
+			interpreter.NewVariableSizedStaticType(
+				nil,
+				interpreter.NewVariableSizedStaticType(
+					nil,
+					interpreter.NewOptionalStaticType(
+						nil,
+						interpreter.PrimitiveStaticTypeUInt64,
+					),
+				),
+			),
+		)
+
+		for i := 0; i < b.N; i++ {
+			CanSkipCapabilityValueMigration(valueType)
+		}
+	})
 }
 
 func TestStorageCapMigration(t *testing.T) {
@@ -3129,18 +3365,21 @@ func TestStorageCapMigration(t *testing.T) {
 		[]testCapConsPathCapabilityMigration{
 			{
 				accountAddress: testAddress,
+				storageMapKey:  interpreter.StringStorageMapKey("cap1"),
 				addressPath:    testAddressPath,
 				borrowType:     testBorrowType1,
 				capabilityID:   1,
 			},
 			{
 				accountAddress: testAddress,
+				storageMapKey:  interpreter.StringStorageMapKey("cap3"),
 				addressPath:    testAddressPath,
 				borrowType:     testBorrowType2,
 				capabilityID:   2,
 			},
 			{
 				accountAddress: testAddress,
+				storageMapKey:  interpreter.StringStorageMapKey("cap2"),
 				addressPath:    testAddressPath,
 				borrowType:     testBorrowType2,
 				capabilityID:   2,
@@ -3639,6 +3878,7 @@ func TestUntypedStorageCapWithMissingTargetMigration(t *testing.T) {
 		[]testCapConsMissingCapabilityID{
 			{
 				accountAddress: addressA,
+				storageMapKey:  interpreter.StringStorageMapKey("cap"),
 				addressPath: interpreter.AddressPath{
 					Address: addressB,
 					Path:    targetPath,
@@ -3708,3 +3948,1146 @@ func TestUntypedStorageCapWithMissingTargetMigration(t *testing.T) {
 		actuals,
 	)
 }
+
+func TestCapabilityValueMigrationSummary(t *testing.T) {
+
+	t.Parallel()
+
+	addressA := common.MustBytesToAddress([]byte{0x1})
+	addressB := common.MustBytesToAddress([]byte{0x2})
+
+	publicPath := interpreter.NewUnmeteredPathValue(common.PathDomainPublic, "public")
+	missingPublicPath := interpreter.NewUnmeteredPathValue(common.PathDomainPublic, "missingPublic")
+	privatePath := interpreter.NewUnmeteredPathValue(common.PathDomainPrivate, "private")
+	storagePath := interpreter.NewUnmeteredPathValue(common.PathDomainStorage, "storage")
+	missingTypedStoragePath := interpreter.NewUnmeteredPathValue(common.PathDomainStorage, "missingTyped")
+	missingUntypedStoragePath := interpreter.NewUnmeteredPathValue(common.PathDomainStorage, "missingUntyped")
+
+	privatePublicCapabilityMapping := &PathCapabilityMapping{}
+	privatePublicCapabilityMapping.Record(
+		interpreter.AddressPath{
+			Address: addressB,
+			Path:    publicPath,
+		},
+		1,
+		testRReferenceStaticType,
+	)
+	privatePublicCapabilityMapping.Record(
+		interpreter.AddressPath{
+			Address: addressB,
+			Path:    privatePath,
+		},
+		2,
+		testRReferenceStaticType,
+	)
+
+	typedStorageCapabilityMapping := &PathTypeCapabilityMapping{}
+	typedStorageCapabilityMapping.Record(
+		interpreter.AddressPath{
+			Address: addressB,
+			Path:    storagePath,
+		},
+		3,
+		testRReferenceStaticType.ID(),
+	)
+
+	reporter := &testMigrationReporter{}
+
+	migration := &CapabilityValueMigration{
+		PrivatePublicCapabilityMapping:  privatePublicCapabilityMapping,
+		TypedStorageCapabilityMapping:   typedStorageCapabilityMapping,
+		UntypedStorageCapabilityMapping: &PathCapabilityMapping{},
+		Reporter:                        reporter,
+	}
+
+	capabilityValues := []*interpreter.PathCapabilityValue{ //nolint:staticcheck
+		// Migrated
+		interpreter.NewUnmeteredPathCapabilityValue( //nolint:staticcheck
+			testRReferenceStaticType,
+			interpreter.AddressValue(addressB),
+			publicPath,
+		),
+		// Migrated
+		interpreter.NewUnmeteredPathCapabilityValue( //nolint:staticcheck
+			nil,
+			interpreter.AddressValue(addressB),
+			privatePath,
+		),
+		// Missing capability ID
+		interpreter.NewUnmeteredPathCapabilityValue( //nolint:staticcheck
+			testRReferenceStaticType,
+			interpreter.AddressValue(addressB),
+			missingPublicPath,
+		),
+		// Migrated
+		interpreter.NewUnmeteredPathCapabilityValue( //nolint:staticcheck
+			testRReferenceStaticType,
+			interpreter.AddressValue(addressB),
+			storagePath,
+		),
+		// Missing capability ID
+		interpreter.NewUnmeteredPathCapabilityValue( //nolint:staticcheck
+			testRReferenceStaticType,
+			interpreter.AddressValue(addressB),
+			missingTypedStoragePath,
+		),
+		// Missing borrow type
+		interpreter.NewUnmeteredPathCapabilityValue( //nolint:staticcheck
+			nil,
+			interpreter.AddressValue(addressB),
+			missingUntypedStoragePath,
+		),
+	}
+
+	storageKey := interpreter.NewStorageKey(nil, addressA, common.PathDomainStorage.Identifier())
+
+	for _, capabilityValue := range capabilityValues {
+		_, err := migration.Migrate(
+			storageKey,
+			interpreter.StringStorageMapKey("test"),
+			capabilityValue,
+			nil,
+			migrations.ValueMigrationPositionOther,
+		)
+		require.NoError(t, err)
+	}
+
+	// Stats are only reported once the migration is finalized
+	require.Nil(t, reporter.stats)
+
+	migration.Finalize()
+
+	assert.Equal(t,
+		MigrationStats{
+			common.PathDomainPublic: {
+				Migrated:            1,
+				MissingCapabilityID: 1,
+			},
+			common.PathDomainPrivate: {
+				Migrated: 1,
+			},
+			common.PathDomainStorage: {
+				Migrated:            1,
+				MissingCapabilityID: 1,
+				MissingBorrowType:   1,
+			},
+		},
+		reporter.stats,
+	)
+
+	assert.Len(t, reporter.pathCapabilityMigrations, 3)
+	assert.Len(t, reporter.missingCapabilityIDs, 3)
+}
+
+func TestCapabilityValueMigrationFinalize(t *testing.T) {
+
+	t.Parallel()
+
+	addressA := common.MustBytesToAddress([]byte{0x2})
+	addressB := common.MustBytesToAddress([]byte{0x3})
+
+	rt := NewTestInterpreterRuntime()
+
+	runtimeInterface := &TestRuntimeInterface{
+		Storage: NewTestLedger(nil, nil),
+	}
+
+	storage, inter, err := rt.Storage(runtime.Context{
+		Interface: runtimeInterface,
+	})
+	require.NoError(t, err)
+
+	publicPath := interpreter.NewUnmeteredPathValue(common.PathDomainPublic, "public")
+	missingPublicPath := interpreter.NewUnmeteredPathValue(common.PathDomainPublic, "missingPublic")
+
+	storageMapKey := interpreter.StringStorageMapKey("cap")
+
+	for _, address := range []common.Address{addressA, addressB} {
+		capabilityValue := interpreter.NewUnmeteredPathCapabilityValue( //nolint:staticcheck
+			testRReferenceStaticType,
+			interpreter.AddressValue(testAddress),
+			publicPath,
+		)
+
+		storage.GetStorageMap(address, common.PathDomainStorage.Identifier(), true).
+			SetValue(inter, storageMapKey, capabilityValue)
+	}
+
+	storage.GetStorageMap(addressB, common.PathDomainStorage.Identifier(), false).
+		SetValue(
+			inter,
+			interpreter.StringStorageMapKey("missing"),
+			interpreter.NewUnmeteredPathCapabilityValue( //nolint:staticcheck
+				testRReferenceStaticType,
+				interpreter.AddressValue(testAddress),
+				missingPublicPath,
+			),
+		)
+
+	err = storage.Commit(inter, false)
+	require.NoError(t, err)
+
+	privatePublicCapabilityMapping := &PathCapabilityMapping{}
+	privatePublicCapabilityMapping.Record(
+		interpreter.AddressPath{
+			Address: testAddress,
+			Path:    publicPath,
+		},
+		1,
+		testRReferenceStaticType,
+	)
+
+	reporter := &testMigrationReporter{}
+
+	capabilityValueMigration := &CapabilityValueMigration{
+		PrivatePublicCapabilityMapping:  privatePublicCapabilityMapping,
+		TypedStorageCapabilityMapping:   &PathTypeCapabilityMapping{},
+		UntypedStorageCapabilityMapping: &PathCapabilityMapping{},
+		Reporter:                        reporter,
+	}
+
+	// Migrate the storage of each account
+
+	for _, address := range []common.Address{addressA, addressB} {
+		migration, err := migrations.NewStorageMigration(inter, storage, "test", address)
+		require.NoError(t, err)
+
+		migration.Migrate(
+			migration.NewValueMigrationsPathMigrator(
+				reporter,
+				capabilityValueMigration,
+			),
+		)
+
+		err = migration.Commit()
+		require.NoError(t, err)
+	}
+
+	// Stats are only reported once the migrations are finalized
+
+	require.Nil(t, reporter.stats)
+
+	migrations.FinalizeValueMigrations(capabilityValueMigration)
+
+	require.Empty(t, reporter.errors)
+
+	assert.Equal(t,
+		MigrationStats{
+			common.PathDomainPublic: {
+				Migrated:            2,
+				MissingCapabilityID: 1,
+			},
+		},
+		reporter.stats,
+	)
+
+	err = storage.CheckHealth()
+	require.NoError(t, err)
+}
+
+func TestCapabilityValueMigrationCSVReporter(t *testing.T) {
+
+	t.Parallel()
+
+	addressA := common.MustBytesToAddress([]byte{0x1})
+	addressB := common.MustBytesToAddress([]byte{0x2})
+
+	publicPath := interpreter.NewUnmeteredPathValue(common.PathDomainPublic, "public")
+	missingPublicPath := interpreter.NewUnmeteredPathValue(common.PathDomainPublic, "missingPublic")
+	privatePath := interpreter.NewUnmeteredPathValue(common.PathDomainPrivate, "private")
+
+	privatePublicCapabilityMapping := &PathCapabilityMapping{}
+	privatePublicCapabilityMapping.Record(
+		interpreter.AddressPath{
+			Address: addressB,
+			Path:    publicPath,
+		},
+		1,
+		testRReferenceStaticType,
+	)
+	privatePublicCapabilityMapping.Record(
+		interpreter.AddressPath{
+			Address: addressB,
+			Path:    privatePath,
+		},
+		2,
+		testRReferenceStaticType,
+	)
+
+	var output strings.Builder
+	reporter := NewCSVReporter(&output)
+
+	migration := &CapabilityValueMigration{
+		PrivatePublicCapabilityMapping:  privatePublicCapabilityMapping,
+		TypedStorageCapabilityMapping:   &PathTypeCapabilityMapping{},
+		UntypedStorageCapabilityMapping: &PathCapabilityMapping{},
+		Reporter:                        reporter,
+		DryRun:                          true,
+	}
+
+	capabilityValues := []*interpreter.PathCapabilityValue{ //nolint:staticcheck
+		// Migrated
+		interpreter.NewUnmeteredPathCapabilityValue( //nolint:staticcheck
+			testRReferenceStaticType,
+			interpreter.AddressValue(addressB),
+			publicPath,
+		),
+		// Missing capability ID
+		interpreter.NewUnmeteredPathCapabilityValue( //nolint:staticcheck
+			testRReferenceStaticType,
+			interpreter.AddressValue(addressB),
+			missingPublicPath,
+		),
+		// Migrated, borrow type is inferred from the capability controller
+		interpreter.NewUnmeteredPathCapabilityValue( //nolint:staticcheck
+			nil,
+			interpreter.AddressValue(addressB),
+			privatePath,
+		),
+	}
+
+	storageKey := interpreter.NewStorageKey(nil, addressA, common.PathDomainStorage.Identifier())
+
+	for _, capabilityValue := range capabilityValues {
+		_, err := migration.Migrate(
+			storageKey,
+			interpreter.StringStorageMapKey("test"),
+			capabilityValue,
+			nil,
+			migrations.ValueMigrationPositionOther,
+		)
+		require.NoError(t, err)
+	}
+
+	err := reporter.Flush()
+	require.NoError(t, err)
+
+	records, err := csv.NewReader(strings.NewReader(output.String())).ReadAll()
+	require.NoError(t, err)
+
+	borrowType := string(testRReferenceStaticType.ID())
+
+	assert.Equal(t,
+		[][]string{
+			CSVReporterHeader,
+			{
+				"0x0000000000000001", "test",
+				"0x0000000000000002", "public", "public",
+				borrowType, "", "1",
+				CSVReporterStatusMigrated, "true",
+			},
+			{
+				"0x0000000000000001", "test",
+				"0x0000000000000002", "public", "missingPublic",
+				"", "", "",
+				CSVReporterStatusMissingCapabilityID, "true",
+			},
+			{
+				"0x0000000000000001", "test",
+				"0x0000000000000002", "private", "private",
+				borrowType, "", "2",
+				CSVReporterStatusMigrated, "true",
+			},
+		},
+		records,
+	)
+}
+
+func TestCapabilityValueMigrationDryRun(t *testing.T) {
+
+	t.Parallel()
+
+	rt := NewTestInterpreterRuntime()
+
+	runtimeInterface := &TestRuntimeInterface{
+		Storage: NewTestLedger(nil, nil),
+	}
+
+	storage, inter, err := rt.Storage(runtime.Context{
+		Interface: runtimeInterface,
+	})
+	require.NoError(t, err)
+
+	publicPath := interpreter.NewUnmeteredPathValue(common.PathDomainPublic, "public")
+
+	capabilityValue := interpreter.NewUnmeteredPathCapabilityValue( //nolint:staticcheck
+		testRReferenceStaticType,
+		interpreter.AddressValue(testAddress),
+		publicPath,
+	)
+
+	storageMapKey := interpreter.StringStorageMapKey("cap")
+
+	storage.GetStorageMap(testAddress, common.PathDomainStorage.Identifier(), true).
+		SetValue(inter, storageMapKey, capabilityValue)
+
+	err = storage.Commit(inter, false)
+	require.NoError(t, err)
+
+	privatePublicCapabilityMapping := &PathCapabilityMapping{}
+	privatePublicCapabilityMapping.Record(
+		interpreter.AddressPath{
+			Address: testAddress,
+			Path:    publicPath,
+		},
+		1,
+		testRReferenceStaticType,
+	)
+
+	// Migrate
+
+	migration, err := migrations.NewStorageMigration(inter, storage, "test", testAddress)
+	require.NoError(t, err)
+
+	reporter := &testMigrationReporter{}
+
+	migration.Migrate(
+		migration.NewValueMigrationsPathMigrator(
+			reporter,
+			&CapabilityValueMigration{
+				PrivatePublicCapabilityMapping:  privatePublicCapabilityMapping,
+				TypedStorageCapabilityMapping:   &PathTypeCapabilityMapping{},
+				UntypedStorageCapabilityMapping: &PathCapabilityMapping{},
+				Reporter:                        reporter,
+				DryRun:                          true,
+			},
+		),
+	)
+
+	err = migration.Commit()
+	require.NoError(t, err)
+
+	// Assert
+
+	require.Empty(t, reporter.errors)
+	require.Empty(t, reporter.migrations)
+	require.Empty(t, reporter.missingCapabilityIDs)
+
+	assert.Equal(t,
+		[]testCapConsPathCapabilityMigration{
+			{
+				accountAddress: testAddress,
+				storageMapKey:  interpreter.StringStorageMapKey("cap"),
+				addressPath: interpreter.AddressPath{
+					Address: testAddress,
+					Path:    publicPath,
+				},
+				borrowType:   testRReferenceStaticType,
+				capabilityID: 1,
+				dryRun:       true,
+			},
+		},
+		reporter.pathCapabilityMigrations,
+	)
+
+	// The stored value must still be the path capability
+
+	storedValue := storage.GetStorageMap(testAddress, common.PathDomainStorage.Identifier(), false).
+		ReadValue(nil, storageMapKey)
+	require.IsType(t, &interpreter.PathCapabilityValue{}, storedValue) //nolint:staticcheck
+
+	err = storage.CheckHealth()
+	require.NoError(t, err)
+}
+
+func TestCapabilityValueMigrationAlreadyMigrated(t *testing.T) {
+
+	t.Parallel()
+
+	rt := NewTestInterpreterRuntime()
+
+	runtimeInterface := &TestRuntimeInterface{
+		Storage: NewTestLedger(nil, nil),
+	}
+
+	storage, inter, err := rt.Storage(runtime.Context{
+		Interface: runtimeInterface,
+	})
+	require.NoError(t, err)
+
+	publicPath := interpreter.NewUnmeteredPathValue(common.PathDomainPublic, "public")
+
+	// A path capability, which still needs to be migrated,
+	// and an ID capability, which was migrated by a previous, interrupted run
+
+	pathCapabilityValue := interpreter.NewUnmeteredPathCapabilityValue( //nolint:staticcheck
+		testRReferenceStaticType,
+		interpreter.AddressValue(testAddress),
+		publicPath,
+	)
+
+	idCapabilityValue := interpreter.NewUnmeteredCapabilityValue(
+		2,
+		interpreter.AddressValue(testAddress),
+		testRReferenceStaticType,
+	)
+
+	pathCapabilityStorageMapKey := interpreter.StringStorageMapKey("pathCap")
+	idCapabilityStorageMapKey := interpreter.StringStorageMapKey("idCap")
+
+	storageMap := storage.GetStorageMap(testAddress, common.PathDomainStorage.Identifier(), true)
+	storageMap.SetValue(inter, pathCapabilityStorageMapKey, pathCapabilityValue)
+	storageMap.SetValue(inter, idCapabilityStorageMapKey, idCapabilityValue)
+
+	err = storage.Commit(inter, false)
+	require.NoError(t, err)
+
+	privatePublicCapabilityMapping := &PathCapabilityMapping{}
+	privatePublicCapabilityMapping.Record(
+		interpreter.AddressPath{
+			Address: testAddress,
+			Path:    publicPath,
+		},
+		1,
+		testRReferenceStaticType,
+	)
+
+	// Migrate
+
+	migration, err := migrations.NewStorageMigration(inter, storage, "test", testAddress)
+	require.NoError(t, err)
+
+	reporter := &testMigrationReporter{}
+
+	migration.Migrate(
+		migration.NewValueMigrationsPathMigrator(
+			reporter,
+			&CapabilityValueMigration{
+				PrivatePublicCapabilityMapping:  privatePublicCapabilityMapping,
+				TypedStorageCapabilityMapping:   &PathTypeCapabilityMapping{},
+				UntypedStorageCapabilityMapping: &PathCapabilityMapping{},
+				Reporter:                        reporter,
+			},
+		),
+	)
+
+	err = migration.Commit()
+	require.NoError(t, err)
+
+	// Assert
+
+	require.Empty(t, reporter.errors)
+
+	assert.Equal(t,
+		[]testMigration{
+			{
+				storageKey: interpreter.StorageKey{
+					Address: testAddress,
+					Key:     common.PathDomainStorage.Identifier(),
+				},
+				storageMapKey: pathCapabilityStorageMapKey,
+				migration:     "CapabilityValueMigration",
+			},
+		},
+		reporter.migrations,
+	)
+
+	assert.Equal(t,
+		[]testCapConsAlreadyMigrated{
+			{
+				accountAddress:    testAddress,
+				storageMapKey:     idCapabilityStorageMapKey,
+				capabilityAddress: testAddress,
+				capabilityID:      2,
+			},
+		},
+		reporter.alreadyMigrated,
+	)
+
+	storageMap = storage.GetStorageMap(testAddress, common.PathDomainStorage.Identifier(), false)
+
+	assert.Equal(t,
+		interpreter.NewUnmeteredCapabilityValue(
+			1,
+			interpreter.AddressValue(testAddress),
+			testRReferenceStaticType,
+		),
+		storageMap.ReadValue(nil, pathCapabilityStorageMapKey),
+	)
+
+	assert.Equal(t,
+		idCapabilityValue,
+		storageMap.ReadValue(nil, idCapabilityStorageMapKey),
+	)
+
+	err = storage.CheckHealth()
+	require.NoError(t, err)
+}
+
+func TestCapabilityValueMigrationNestedStorageMapKey(t *testing.T) {
+
+	t.Parallel()
+
+	rt := NewTestInterpreterRuntime()
+
+	runtimeInterface := &TestRuntimeInterface{
+		Storage: NewTestLedger(nil, nil),
+	}
+
+	storage, inter, err := rt.Storage(runtime.Context{
+		Interface: runtimeInterface,
+	})
+	require.NoError(t, err)
+
+	publicPath := interpreter.NewUnmeteredPathValue(common.PathDomainPublic, "public")
+	missingPath := interpreter.NewUnmeteredPathValue(common.PathDomainPublic, "missing")
+
+	// Store the capabilities in a dictionary,
+	// so the storage map key is the key of the dictionary's slot,
+	// not a key of the capabilities themselves
+
+	dictionaryValue := interpreter.NewDictionaryValueWithAddress(
+		inter,
+		interpreter.EmptyLocationRange,
+		interpreter.NewDictionaryStaticType(
+			nil,
+			interpreter.PrimitiveStaticTypeString,
+			interpreter.NewCapabilityStaticType(nil, testRReferenceStaticType),
+		),
+		testAddress,
+		interpreter.NewUnmeteredStringValue("migrated"),
+		interpreter.NewUnmeteredPathCapabilityValue( //nolint:staticcheck
+			testRReferenceStaticType,
+			interpreter.AddressValue(testAddress),
+			publicPath,
+		),
+		interpreter.NewUnmeteredStringValue("missing"),
+		interpreter.NewUnmeteredPathCapabilityValue( //nolint:staticcheck
+			testRReferenceStaticType,
+			interpreter.AddressValue(testAddress),
+			missingPath,
+		),
+	)
+
+	storageMapKey := interpreter.StringStorageMapKey("caps")
+
+	storage.GetStorageMap(testAddress, common.PathDomainStorage.Identifier(), true).
+		SetValue(inter, storageMapKey, dictionaryValue)
+
+	err = storage.Commit(inter, false)
+	require.NoError(t, err)
+
+	privatePublicCapabilityMapping := &PathCapabilityMapping{}
+	privatePublicCapabilityMapping.Record(
+		interpreter.AddressPath{
+			Address: testAddress,
+			Path:    publicPath,
+		},
+		1,
+		testRReferenceStaticType,
+	)
+
+	// Migrate
+
+	migration, err := migrations.NewStorageMigration(inter, storage, "test", testAddress)
+	require.NoError(t, err)
+
+	reporter := &testMigrationReporter{}
+
+	migration.Migrate(
+		migration.NewValueMigrationsPathMigrator(
+			reporter,
+			&CapabilityValueMigration{
+				PrivatePublicCapabilityMapping:  privatePublicCapabilityMapping,
+				TypedStorageCapabilityMapping:   &PathTypeCapabilityMapping{},
+				UntypedStorageCapabilityMapping: &PathCapabilityMapping{},
+				Reporter:                        reporter,
+			},
+		),
+	)
+
+	err = migration.Commit()
+	require.NoError(t, err)
+
+	// Assert
+
+	require.Empty(t, reporter.errors)
+
+	assert.Equal(t,
+		[]testCapConsPathCapabilityMigration{
+			{
+				accountAddress: testAddress,
+				storageMapKey:  storageMapKey,
+				addressPath: interpreter.AddressPath{
+					Address: testAddress,
+					Path:    publicPath,
+				},
+				borrowType:   testRReferenceStaticType,
+				capabilityID: 1,
+			},
+		},
+		reporter.pathCapabilityMigrations,
+	)
+
+	assert.Equal(t,
+		[]testCapConsMissingCapabilityID{
+			{
+				accountAddress: testAddress,
+				storageMapKey:  storageMapKey,
+				addressPath: interpreter.AddressPath{
+					Address: testAddress,
+					Path:    missingPath,
+				},
+			},
+		},
+		reporter.missingCapabilityIDs,
+	)
+
+	err = storage.CheckHealth()
+	require.NoError(t, err)
+}
+
+func TestCapabilityValueMigrationBorrowTypeWidened(t *testing.T) {
+
+	t.Parallel()
+
+	publicPath := interpreter.NewUnmeteredPathValue(common.PathDomainPublic, "public")
+
+	addressPath := interpreter.AddressPath{
+		Address: testAddress,
+		Path:    publicPath,
+	}
+
+	// auth(E) &Test.R
+	entitledBorrowType := interpreter.NewReferenceStaticType(
+		nil,
+		interpreter.NewEntitlementSetAuthorization(
+			nil,
+			func() []common.TypeID {
+				return []common.TypeID{
+					common.NewAddressLocation(nil, testAddress, "Test").TypeID(nil, "Test.E"),
+				}
+			},
+			1,
+			sema.Conjunction,
+		),
+		testRCompositeStaticType,
+	)
+
+	test := func(t *testing.T, controllerBorrowType *interpreter.ReferenceStaticType) *testMigrationReporter {
+
+		privatePublicCapabilityMapping := &PathCapabilityMapping{}
+		privatePublicCapabilityMapping.Record(addressPath, 1, controllerBorrowType)
+
+		reporter := &testMigrationReporter{}
+
+		migration := &CapabilityValueMigration{
+			PrivatePublicCapabilityMapping:  privatePublicCapabilityMapping,
+			TypedStorageCapabilityMapping:   &PathTypeCapabilityMapping{},
+			UntypedStorageCapabilityMapping: &PathCapabilityMapping{},
+			Reporter:                        reporter,
+		}
+
+		// &Test.R
+		capabilityValue := interpreter.NewUnmeteredPathCapabilityValue( //nolint:staticcheck
+			testRReferenceStaticType,
+			interpreter.AddressValue(testAddress),
+			publicPath,
+		)
+
+		newValue, err := migration.Migrate(
+			interpreter.NewStorageKey(nil, testAddress, common.PathDomainStorage.Identifier()),
+			interpreter.StringStorageMapKey("test"),
+			capabilityValue,
+			nil,
+			migrations.ValueMigrationPositionOther,
+		)
+		require.NoError(t, err)
+		require.IsType(t, &interpreter.IDCapabilityValue{}, newValue)
+
+		assert.Len(t, reporter.pathCapabilityMigrations, 1)
+
+		return reporter
+	}
+
+	t.Run("entitled controller", func(t *testing.T) {
+
+		t.Parallel()
+
+		reporter := test(t, entitledBorrowType)
+
+		assert.Equal(t,
+			[]testCapConsBorrowTypeWidened{
+				{
+					accountAddress:       testAddress,
+					addressPath:          addressPath,
+					oldBorrowType:        testRReferenceStaticType,
+					controllerBorrowType: entitledBorrowType,
+				},
+			},
+			reporter.widenedBorrowTypes,
+		)
+	})
+
+	t.Run("unauthorized controller", func(t *testing.T) {
+
+		t.Parallel()
+
+		reporter := test(t, testRReferenceStaticType)
+
+		assert.Empty(t, reporter.widenedBorrowTypes)
+	})
+
+	t.Run("entitled controller, different referenced type", func(t *testing.T) {
+
+		t.Parallel()
+
+		// auth(E) &Test.S
+		reporter := test(
+			t,
+			interpreter.NewReferenceStaticType(
+				nil,
+				entitledBorrowType.Authorization,
+				testSCompositeStaticType,
+			),
+		)
+
+		assert.Empty(t, reporter.widenedBorrowTypes)
+	})
+}
+
+func TestCapabilityValueMigrationBorrowTypeMismatch(t *testing.T) {
+
+	t.Parallel()
+
+	publicPath := interpreter.NewUnmeteredPathValue(common.PathDomainPublic, "public")
+
+	addressPath := interpreter.AddressPath{
+		Address: testAddress,
+		Path:    publicPath,
+	}
+
+	testLocation := common.NewAddressLocation(nil, testAddress, "Test")
+
+	newEntitledBorrowType := func(setKind sema.EntitlementSetKind, entitlements ...string) *interpreter.ReferenceStaticType {
+		return interpreter.NewReferenceStaticType(
+			nil,
+			interpreter.NewEntitlementSetAuthorization(
+				nil,
+				func() []common.TypeID {
+					typeIDs := make([]common.TypeID, 0, len(entitlements))
+					for _, entitlement := range entitlements {
+						typeIDs = append(typeIDs, testLocation.TypeID(nil, entitlement))
+					}
+					return typeIDs
+				},
+				len(entitlements),
+				setKind,
+			),
+			testRCompositeStaticType,
+		)
+	}
+
+	test := func(
+		t *testing.T,
+		oldBorrowType *interpreter.ReferenceStaticType,
+		controllerBorrowType *interpreter.ReferenceStaticType,
+	) *testMigrationReporter {
+
+		privatePublicCapabilityMapping := &PathCapabilityMapping{}
+		privatePublicCapabilityMapping.Record(addressPath, 1, controllerBorrowType)
+
+		reporter := &testMigrationReporter{}
+
+		migration := &CapabilityValueMigration{
+			PrivatePublicCapabilityMapping:  privatePublicCapabilityMapping,
+			TypedStorageCapabilityMapping:   &PathTypeCapabilityMapping{},
+			UntypedStorageCapabilityMapping: &PathCapabilityMapping{},
+			Reporter:                        reporter,
+		}
+
+		capabilityValue := interpreter.NewUnmeteredPathCapabilityValue( //nolint:staticcheck
+			oldBorrowType,
+			interpreter.AddressValue(testAddress),
+			publicPath,
+		)
+
+		newValue, err := migration.Migrate(
+			interpreter.NewStorageKey(nil, testAddress, common.PathDomainStorage.Identifier()),
+			interpreter.StringStorageMapKey("test"),
+			capabilityValue,
+			nil,
+			migrations.ValueMigrationPositionOther,
+		)
+		require.NoError(t, err)
+		require.IsType(t, &interpreter.IDCapabilityValue{}, newValue)
+
+		assert.Len(t, reporter.pathCapabilityMigrations, 1)
+
+		return reporter
+	}
+
+	t.Run("matching", func(t *testing.T) {
+
+		t.Parallel()
+
+		// auth(E) &Test.R
+		borrowType := newEntitledBorrowType(sema.Conjunction, "Test.E")
+
+		reporter := test(t, borrowType, borrowType)
+
+		assert.Empty(t, reporter.widenedBorrowTypes)
+		assert.Empty(t, reporter.mismatchedBorrowTypes)
+	})
+
+	t.Run("widened", func(t *testing.T) {
+
+		t.Parallel()
+
+		// &Test.R, controller: auth(E) &Test.R
+		reporter := test(
+			t,
+			testRReferenceStaticType,
+			newEntitledBorrowType(sema.Conjunction, "Test.E"),
+		)
+
+		assert.Len(t, reporter.widenedBorrowTypes, 1)
+		assert.Empty(t, reporter.mismatchedBorrowTypes)
+	})
+
+	t.Run("narrowed, unauthorized controller", func(t *testing.T) {
+
+		t.Parallel()
+
+		// auth(E) &Test.R, controller: &Test.R
+		oldBorrowType := newEntitledBorrowType(sema.Conjunction, "Test.E")
+
+		reporter := test(t, oldBorrowType, testRReferenceStaticType)
+
+		assert.Empty(t, reporter.widenedBorrowTypes)
+		assert.Equal(t,
+			[]testCapConsBorrowTypeMismatch{
+				{
+					accountAddress:       testAddress,
+					addressPath:          addressPath,
+					oldBorrowType:        oldBorrowType,
+					controllerBorrowType: testRReferenceStaticType,
+					chosenBorrowType:     oldBorrowType,
+				},
+			},
+			reporter.mismatchedBorrowTypes,
+		)
+	})
+
+	t.Run("narrowed, fewer entitlements", func(t *testing.T) {
+
+		t.Parallel()
+
+		// auth(E, F) &Test.R, controller: auth(E) &Test.R
+		oldBorrowType := newEntitledBorrowType(sema.Conjunction, "Test.E", "Test.F")
+		controllerBorrowType := newEntitledBorrowType(sema.Conjunction, "Test.E")
+
+		reporter := test(t, oldBorrowType, controllerBorrowType)
+
+		assert.Empty(t, reporter.widenedBorrowTypes)
+		assert.Equal(t,
+			[]testCapConsBorrowTypeMismatch{
+				{
+					accountAddress:       testAddress,
+					addressPath:          addressPath,
+					oldBorrowType:        oldBorrowType,
+					controllerBorrowType: controllerBorrowType,
+					chosenBorrowType:     oldBorrowType,
+				},
+			},
+			reporter.mismatchedBorrowTypes,
+		)
+	})
+
+	t.Run("unrelated entitlements", func(t *testing.T) {
+
+		t.Parallel()
+
+		// auth(E) &Test.R, controller: auth(F) &Test.R
+		oldBorrowType := newEntitledBorrowType(sema.Conjunction, "Test.E")
+		controllerBorrowType := newEntitledBorrowType(sema.Conjunction, "Test.F")
+
+		reporter := test(t, oldBorrowType, controllerBorrowType)
+
+		assert.Empty(t, reporter.widenedBorrowTypes)
+		assert.Equal(t,
+			[]testCapConsBorrowTypeMismatch{
+				{
+					accountAddress:       testAddress,
+					addressPath:          addressPath,
+					oldBorrowType:        oldBorrowType,
+					controllerBorrowType: controllerBorrowType,
+					chosenBorrowType:     oldBorrowType,
+				},
+			},
+			reporter.mismatchedBorrowTypes,
+		)
+	})
+
+	t.Run("more entitlements", func(t *testing.T) {
+
+		t.Parallel()
+
+		// auth(E) &Test.R, controller: auth(E, F) &Test.R
+		reporter := test(
+			t,
+			newEntitledBorrowType(sema.Conjunction, "Test.E"),
+			newEntitledBorrowType(sema.Conjunction, "Test.E", "Test.F"),
+		)
+
+		assert.Empty(t, reporter.widenedBorrowTypes)
+		assert.Empty(t, reporter.mismatchedBorrowTypes)
+	})
+}
+
+func TestCapabilityValueMigrationDeleteUnmapped(t *testing.T) {
+
+	t.Parallel()
+
+	const fieldName = "cap"
+
+	missingPath := interpreter.NewUnmeteredPathValue(common.PathDomainPublic, "missing")
+
+	missingAddressPath := interpreter.AddressPath{
+		Address: testAddress,
+		Path:    missingPath,
+	}
+
+	newMissingPathCapabilityValue := func() *interpreter.PathCapabilityValue { //nolint:staticcheck
+		return interpreter.NewUnmeteredPathCapabilityValue( //nolint:staticcheck
+			testRReferenceStaticType,
+			interpreter.AddressValue(testAddress),
+			missingPath,
+		)
+	}
+
+	test := func(
+		t *testing.T,
+		fieldValue interpreter.Value,
+	) (
+		migratedFieldValue interpreter.Value,
+		reporter *testMigrationReporter,
+	) {
+
+		rt := NewTestInterpreterRuntime()
+
+		runtimeInterface := &TestRuntimeInterface{
+			Storage: NewTestLedger(nil, nil),
+		}
+
+		storage, inter, err := rt.Storage(runtime.Context{
+			Interface: runtimeInterface,
+		})
+		require.NoError(t, err)
+
+		compositeValue := interpreter.NewCompositeValue(
+			inter,
+			interpreter.EmptyLocationRange,
+			common.NewAddressLocation(nil, testAddress, "Test"),
+			"Test.S",
+			common.CompositeKindStructure,
+			[]interpreter.CompositeField{
+				{
+					Name:  fieldName,
+					Value: fieldValue,
+				},
+			},
+			testAddress,
+		)
+
+		storageMapKey := interpreter.StringStorageMapKey("s")
+
+		storage.GetStorageMap(testAddress, common.PathDomainStorage.Identifier(), true).
+			SetValue(inter, storageMapKey, compositeValue)
+
+		err = storage.Commit(inter, false)
+		require.NoError(t, err)
+
+		// Migrate
+
+		migration, err := migrations.NewStorageMigration(inter, storage, "test", testAddress)
+		require.NoError(t, err)
+
+		reporter = &testMigrationReporter{}
+
+		migration.Migrate(
+			migration.NewValueMigrationsPathMigrator(
+				reporter,
+				&CapabilityValueMigration{
+					PrivatePublicCapabilityMapping:  &PathCapabilityMapping{},
+					TypedStorageCapabilityMapping:   &PathTypeCapabilityMapping{},
+					UntypedStorageCapabilityMapping: &PathCapabilityMapping{},
+					Reporter:                        reporter,
+					DeleteUnmapped:                  true,
+				},
+			),
+		)
+
+		err = migration.Commit()
+		require.NoError(t, err)
+
+		require.Empty(t, reporter.errors)
+
+		assert.Equal(t,
+			[]testCapConsMissingCapabilityID{
+				{
+					accountAddress: testAddress,
+					storageMapKey:  storageMapKey,
+					addressPath:    missingAddressPath,
+				},
+			},
+			reporter.missingCapabilityIDs,
+		)
+
+		err = storage.CheckHealth()
+		require.NoError(t, err)
+
+		storedValue := storage.GetStorageMap(testAddress, common.PathDomainStorage.Identifier(), false).
+			ReadValue(nil, storageMapKey)
+		require.IsType(t, &interpreter.CompositeValue{}, storedValue)
+
+		migratedFieldValue = storedValue.(*interpreter.CompositeValue).
+			GetField(inter, interpreter.EmptyLocationRange, fieldName)
+
+		return migratedFieldValue, reporter
+	}
+
+	t.Run("optional field", func(t *testing.T) {
+
+		t.Parallel()
+
+		migratedFieldValue, reporter := test(
+			t,
+			interpreter.NewUnmeteredSomeValueNonCopying(
+				newMissingPathCapabilityValue(),
+			),
+		)
+
+		assert.Equal(t, interpreter.Nil, migratedFieldValue)
+
+		assert.Equal(t,
+			[]testCapConsDeletedCapability{
+				{
+					accountAddress: testAddress,
+					storageMapKey:  interpreter.StringStorageMapKey("s"),
+					addressPath:    missingAddressPath,
+				},
+			},
+			reporter.deletedCapabilities,
+		)
+	})
+
+	t.Run("non-optional field", func(t *testing.T) {
+
+		t.Parallel()
+
+		migratedFieldValue, reporter := test(
+			t,
+			newMissingPathCapabilityValue(),
+		)
+
+		// Only optional path capabilities can be replaced with nil
+		require.IsType(t, &interpreter.PathCapabilityValue{}, migratedFieldValue) //nolint:staticcheck
+		assert.Equal(t,
+			missingAddressPath,
+			migratedFieldValue.(*interpreter.PathCapabilityValue).AddressPath(), //nolint:staticcheck
+		)
+
+		assert.Empty(t, reporter.deletedCapabilities)
+	})
+}
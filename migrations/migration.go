@@ -19,6 +19,7 @@
 package migrations
 
 import (
+	"context"
 	"fmt"
 	"runtime/debug"
 
@@ -45,6 +46,25 @@ type ValueMigration interface {
 	Domains() map[string]struct{}
 }
 
+// FinalizableValueMigration is a ValueMigration which must be finalized
+// once the values of all accounts have been migrated, e.g. to report aggregate results.
+type FinalizableValueMigration interface {
+	ValueMigration
+	Finalize()
+}
+
+// FinalizeValueMigrations finalizes the given value migrations which are finalizable.
+// It must be called once, after the storage of all accounts has been migrated with the value migrations.
+func FinalizeValueMigrations(valueMigrations ...ValueMigration) {
+	for _, valueMigration := range valueMigrations {
+		finalizableValueMigration, ok := valueMigration.(FinalizableValueMigration)
+		if !ok {
+			continue
+		}
+		finalizableValueMigration.Finalize()
+	}
+}
+
 type ValueMigrationPosition uint8
 
 const (
@@ -141,6 +161,76 @@ func (m *StorageMigration) Migrate(migrator StorageMapKeyMigrator) {
 	)
 }
 
+// MigrationProgressFunc is called after each stored value has been migrated,
+// with the number of stored values which have been migrated so far.
+type MigrationProgressFunc func(migratedValues int)
+
+// MigrateWithContext migrates the storage of the account like Migrate,
+// but reports the progress after each stored value using the optional progress function,
+// and stops once the given context is cancelled.
+//
+// The context is only checked between stored values, i.e. a stored value is either
+// migrated completely, or not at all, and the storage can be committed afterwards.
+// If the context got cancelled before all stored values have been migrated,
+// the error of the context is returned, e.g. context.Canceled.
+func (m *StorageMigration) MigrateWithContext(
+	ctx context.Context,
+	migrator StorageMapKeyMigrator,
+	progress MigrationProgressFunc,
+) error {
+	contextMigrator := &contextStorageMapKeyMigrator{
+		ctx:      ctx,
+		migrator: migrator,
+		progress: progress,
+	}
+
+	m.Migrate(contextMigrator)
+
+	if contextMigrator.stopped {
+		return ctx.Err()
+	}
+	return nil
+}
+
+// contextStorageMapKeyMigrator is a StorageMapKeyMigrator
+// which skips all stored values once the context is cancelled.
+type contextStorageMapKeyMigrator struct {
+	ctx            context.Context
+	migrator       StorageMapKeyMigrator
+	progress       MigrationProgressFunc
+	migratedValues int
+	stopped        bool
+}
+
+var _ StorageMapKeyMigrator = &contextStorageMapKeyMigrator{}
+
+func (m *contextStorageMapKeyMigrator) Migrate(
+	inter *interpreter.Interpreter,
+	storageKey interpreter.StorageKey,
+	storageMap *interpreter.StorageMap,
+	storageMapKey interpreter.StorageMapKey,
+) {
+	if m.stopped {
+		return
+	}
+
+	if m.ctx.Err() != nil {
+		m.stopped = true
+		return
+	}
+
+	m.migrator.Migrate(inter, storageKey, storageMap, storageMapKey)
+
+	m.migratedValues++
+	if m.progress != nil {
+		m.progress(m.migratedValues)
+	}
+}
+
+func (m *contextStorageMapKeyMigrator) Domains() map[string]struct{} {
+	return m.migrator.Domains()
+}
+
 func (m *StorageMigration) NewValueMigrationsPathMigrator(
 	reporter Reporter,
 	valueMigrations ...ValueMigration,
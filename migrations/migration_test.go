@@ -20,6 +20,7 @@ package migrations
 
 import (
 	"bytes"
+	"context"
 	_ "embed"
 	"encoding/csv"
 	"encoding/hex"
@@ -3901,3 +3902,177 @@ func TestDictionaryKeyMutationMigration(t *testing.T) {
 		require.NoError(t, err)
 	})()
 }
+
+func TestMigrateWithContext(t *testing.T) {
+
+	t.Parallel()
+
+	const valueCount = 10
+	const elementCount = 3
+
+	testAddress := common.MustBytesToAddress([]byte{0x1})
+
+	newStorage := func(t *testing.T) (*runtime.Storage, *interpreter.Interpreter) {
+
+		ledger := NewTestLedger(nil, nil)
+		storage := runtime.NewStorage(ledger, nil)
+
+		inter, err := interpreter.NewInterpreter(
+			nil,
+			utils.TestLocation,
+			&interpreter.Config{
+				Storage:                       storage,
+				AtreeValueValidationEnabled:   true,
+				AtreeStorageValidationEnabled: true,
+			},
+		)
+		require.NoError(t, err)
+
+		storageMap := storage.GetStorageMap(
+			testAddress,
+			common.PathDomainStorage.Identifier(),
+			true,
+		)
+
+		for i := 0; i < valueCount; i++ {
+
+			var elements []interpreter.Value
+			for j := 0; j < elementCount; j++ {
+				elements = append(elements, interpreter.NewUnmeteredStringValue(strconv.Itoa(j)))
+			}
+
+			storageMap.WriteValue(
+				inter,
+				interpreter.StringStorageMapKey(strconv.Itoa(i)),
+				interpreter.NewArrayValue(
+					inter,
+					emptyLocationRange,
+					interpreter.NewVariableSizedStaticType(nil, interpreter.PrimitiveStaticTypeString),
+					testAddress,
+					elements...,
+				),
+			)
+		}
+
+		err = storage.Commit(inter, false)
+		require.NoError(t, err)
+
+		return storage, inter
+	}
+
+	// countMigratedValues returns the number of stored arrays which have been migrated,
+	// and asserts that all arrays have either been migrated completely, or not at all
+	countMigratedValues := func(t *testing.T, storage *runtime.Storage, inter *interpreter.Interpreter) int {
+
+		storageMap := storage.GetStorageMap(
+			testAddress,
+			common.PathDomainStorage.Identifier(),
+			false,
+		)
+		require.NotNil(t, storageMap)
+
+		migratedValues := 0
+
+		for i := 0; i < valueCount; i++ {
+			value := storageMap.ReadValue(nil, interpreter.StringStorageMapKey(strconv.Itoa(i)))
+			require.IsType(t, &interpreter.ArrayValue{}, value)
+			array := value.(*interpreter.ArrayValue)
+
+			migratedElements := 0
+			for j := 0; j < elementCount; j++ {
+				element := array.Get(inter, emptyLocationRange, j)
+				require.IsType(t, &interpreter.StringValue{}, element)
+
+				if strings.HasPrefix(element.(*interpreter.StringValue).Str, "updated_") {
+					migratedElements++
+				}
+			}
+
+			switch migratedElements {
+			case 0:
+			case elementCount:
+				migratedValues++
+			default:
+				require.Failf(t, "partially migrated value", "%s", array)
+			}
+		}
+
+		return migratedValues
+	}
+
+	t.Run("completed", func(t *testing.T) {
+
+		t.Parallel()
+
+		storage, inter := newStorage(t)
+
+		migration, err := NewStorageMigration(inter, storage, "test", testAddress)
+		require.NoError(t, err)
+
+		reporter := newTestReporter()
+
+		var progress []int
+
+		err = migration.MigrateWithContext(
+			context.Background(),
+			migration.NewValueMigrationsPathMigrator(
+				reporter,
+				testStringMigration{},
+			),
+			func(migratedValues int) {
+				progress = append(progress, migratedValues)
+			},
+		)
+		require.NoError(t, err)
+
+		err = migration.Commit()
+		require.NoError(t, err)
+
+		assert.Empty(t, reporter.errors)
+		assert.Equal(t, []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, progress)
+		assert.Equal(t, valueCount, countMigratedValues(t, storage, inter))
+
+		err = storage.CheckHealth()
+		require.NoError(t, err)
+	})
+
+	t.Run("cancelled", func(t *testing.T) {
+
+		t.Parallel()
+
+		const cancelAfter = 3
+
+		storage, inter := newStorage(t)
+
+		migration, err := NewStorageMigration(inter, storage, "test", testAddress)
+		require.NoError(t, err)
+
+		reporter := newTestReporter()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		err = migration.MigrateWithContext(
+			ctx,
+			migration.NewValueMigrationsPathMigrator(
+				reporter,
+				testStringMigration{},
+			),
+			func(migratedValues int) {
+				if migratedValues == cancelAfter {
+					cancel()
+				}
+			},
+		)
+		require.ErrorIs(t, err, context.Canceled)
+
+		err = migration.Commit()
+		require.NoError(t, err)
+
+		assert.Empty(t, reporter.errors)
+		assert.Equal(t, cancelAfter, countMigratedValues(t, storage, inter))
+
+		err = storage.CheckHealth()
+		require.NoError(t, err)
+	})
+}
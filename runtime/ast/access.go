@@ -205,6 +205,16 @@ func (e *MappedAccess) MarshalJSON() ([]byte, error) {
 	})
 }
 
+// LegacyAccessModifier is a legacy access keyword, i.e. `pub` or `priv`.
+//
+// Legacy access keywords are rejected by the parser, but their positions are recorded,
+// so they can be rewritten, e.g. by the Reconstructor.
+type LegacyAccessModifier struct {
+	// Access is the access which replaces the legacy access keyword
+	Access PrimitiveAccess
+	Range
+}
+
 type PrimitiveAccess uint8
 
 // NOTE: order indicates permissiveness: from least to most permissive!
@@ -243,6 +243,7 @@ type Condition interface {
 type TestCondition struct {
 	Test    Expression
 	Message Expression
+	Comments
 }
 
 func (c TestCondition) ElementType() ElementType {
@@ -303,9 +304,11 @@ func (c TestCondition) Doc() prettier.Doc {
 		}
 	}
 
-	return prettier.Group{
-		Doc: doc,
-	}
+	return c.Comments.doc(
+		prettier.Group{
+			Doc: doc,
+		},
+	)
 }
 
 // EmitCondition
@@ -599,4 +599,38 @@ func TestFunctionBlock_String(t *testing.T) {
 			block.String(),
 		)
 	})
+	t.Run("with commented preconditions", func(t *testing.T) {
+
+		t.Parallel()
+
+		block := &FunctionBlock{
+			Block: &Block{},
+			PreConditions: &Conditions{
+				&TestCondition{
+					Test: &BoolExpression{
+						Value: true,
+					},
+					Comments: Comments{
+						Leading: []*Comment{
+							NewComment(nil, []byte("// always holds"), Range{}),
+							NewComment(nil, []byte("/* really\n       * always */"), Range{}),
+						},
+					},
+				},
+			},
+		}
+
+		require.Equal(
+			t,
+			"{\n"+
+				"    pre {\n"+
+				"        // always holds\n"+
+				"        /* really\n"+
+				"         * always */\n"+
+				"        true\n"+
+				"    }\n"+
+				"}",
+			block.String(),
+		)
+	})
 }
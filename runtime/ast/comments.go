@@ -0,0 +1,203 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ast
+
+import (
+	"bytes"
+
+	"github.com/turbolent/prettier"
+
+	"github.com/onflow/cadence/runtime/common"
+)
+
+// Comments are the comments attached to an element.
+type Comments struct {
+	// Leading are the comments which precede the element
+	Leading []*Comment `json:"-"`
+	// Trailing are the comments which follow the element on the same line
+	Trailing []*Comment `json:"-"`
+}
+
+// LeadingDocComments returns the leading comments which are doc comments.
+func (c Comments) LeadingDocComments() (docComments []*Comment) {
+	for _, comment := range c.Leading {
+		if comment.Doc() {
+			docComments = append(docComments, comment)
+		}
+	}
+	return
+}
+
+// doc returns the given document of the element,
+// preceded by the leading comments, and followed by the trailing comments.
+func (c Comments) doc(elementDoc prettier.Doc) prettier.Doc {
+	if len(c.Leading) == 0 && len(c.Trailing) == 0 {
+		return elementDoc
+	}
+
+	var doc prettier.Concat
+	for _, comment := range c.Leading {
+		doc = append(
+			doc,
+			comment.sourceDoc(),
+			prettier.HardLine{},
+		)
+	}
+
+	doc = append(doc, elementDoc)
+
+	for _, comment := range c.Trailing {
+		doc = append(
+			doc,
+			prettier.Space,
+			comment.sourceDoc(),
+		)
+	}
+
+	return doc
+}
+
+// Comment is a line comment or a block comment.
+type Comment struct {
+	// source is the source code of the comment,
+	// including the comment markers (e.g. `//`, `/*`, `*/`)
+	source []byte
+	Range
+}
+
+func NewComment(memoryGauge common.MemoryGauge, source []byte, commentRange Range) *Comment {
+	common.UseMemory(memoryGauge, common.CommentMemoryUsage)
+
+	return &Comment{
+		source: source,
+		Range:  commentRange,
+	}
+}
+
+var lineCommentPrefix = []byte("//")
+var lineCommentDocStringPrefix = []byte("///")
+var blockCommentPrefix = []byte("/*")
+var blockCommentDocStringPrefix = []byte("/**")
+var blockCommentSuffix = []byte("*/")
+
+// Multiline returns true if the comment is a block comment.
+func (c *Comment) Multiline() bool {
+	return bytes.HasPrefix(c.source, blockCommentPrefix)
+}
+
+// Doc returns true if the comment is a doc comment,
+// i.e. a line comment starting with `///`, or a block comment starting with `/**`.
+func (c *Comment) Doc() bool {
+	if c.Multiline() {
+		// NOTE: trim the suffix first, so the empty block comment `/**/` is not a doc comment
+		content := bytes.TrimSuffix(c.source, blockCommentSuffix)
+		return bytes.HasPrefix(content, blockCommentDocStringPrefix)
+	}
+	return bytes.HasPrefix(c.source, lineCommentDocStringPrefix)
+}
+
+// Source returns the source code of the comment, including the comment markers.
+func (c *Comment) Source() []byte {
+	return c.source
+}
+
+// sourceDoc returns the document of the comment, including the comment markers.
+// Continuation lines of block comments are re-indented,
+// aligning a leading `*` with the start of the comment.
+func (c *Comment) sourceDoc() prettier.Doc {
+	lines := bytes.Split(c.source, []byte{'\n'})
+	if len(lines) == 1 {
+		return prettier.Text(c.source)
+	}
+
+	var doc prettier.Concat
+	for i, line := range lines {
+		if i > 0 {
+			doc = append(doc, prettier.HardLine{})
+			line = bytes.TrimLeft(line, " \t")
+			if bytes.HasPrefix(line, []byte{'*'}) {
+				doc = append(doc, prettier.Space)
+			}
+		}
+		doc = append(doc, prettier.Text(bytes.TrimRight(line, " \t\r")))
+	}
+	return doc
+}
+
+// Text returns the content of the comment, without the comment markers.
+func (c *Comment) Text() []byte {
+	text := c.source
+	if c.Multiline() {
+		text = trimBlockCommentMarkers(text)
+	} else {
+		text = trimLineCommentPrefix(text)
+	}
+	return bytes.TrimSpace(text)
+}
+
+// Lines returns the content of the comment, without the comment markers, split into lines.
+// Line comments may span multiple consecutive lines, each starting with a comment marker.
+// Each line is trimmed of surrounding whitespace, and of its comment marker,
+// or for block comments, of a single leading `*`.
+// Leading and trailing empty lines are removed.
+func (c *Comment) Lines() []string {
+	multiline := c.Multiline()
+
+	source := c.source
+	if multiline {
+		source = trimBlockCommentMarkers(source)
+	}
+
+	sourceLines := bytes.Split(source, []byte{'\n'})
+
+	lines := make([]string, 0, len(sourceLines))
+	for _, line := range sourceLines {
+		line = bytes.TrimSpace(line)
+		if multiline {
+			line = bytes.TrimPrefix(line, []byte{'*'})
+		} else {
+			line = trimLineCommentPrefix(line)
+		}
+		lines = append(lines, string(bytes.TrimSpace(line)))
+	}
+
+	for len(lines) > 0 && lines[0] == "" {
+		lines = lines[1:]
+	}
+	for len(lines) > 0 && lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+
+	return lines
+}
+
+func trimBlockCommentMarkers(source []byte) []byte {
+	source = bytes.TrimSuffix(source, blockCommentSuffix)
+	if bytes.HasPrefix(source, blockCommentDocStringPrefix) {
+		return source[len(blockCommentDocStringPrefix):]
+	}
+	return source[len(blockCommentPrefix):]
+}
+
+func trimLineCommentPrefix(source []byte) []byte {
+	if bytes.HasPrefix(source, lineCommentDocStringPrefix) {
+		return source[len(lineCommentDocStringPrefix):]
+	}
+	return bytes.TrimPrefix(source, lineCommentPrefix)
+}
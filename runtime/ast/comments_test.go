@@ -0,0 +1,114 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ast
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestComment_Text(t *testing.T) {
+
+	t.Parallel()
+
+	type testCase struct {
+		source    string
+		text      string
+		multiline bool
+		doc       bool
+	}
+
+	testCases := []testCase{
+		{source: "// foo", text: "foo"},
+		{source: "/// foo", text: "foo", doc: true},
+		{source: "/* foo */", text: "foo", multiline: true},
+		{source: "/** foo */", text: "foo", multiline: true, doc: true},
+		{source: "/**/", text: "", multiline: true},
+		{source: "/*\n  foo\n  bar\n*/", text: "foo\n  bar", multiline: true},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.source, func(t *testing.T) {
+
+			t.Parallel()
+
+			comment := NewComment(nil, []byte(testCase.source), EmptyRange)
+
+			assert.Equal(t, testCase.text, string(comment.Text()))
+			assert.Equal(t, testCase.multiline, comment.Multiline())
+			assert.Equal(t, testCase.doc, comment.Doc())
+		})
+	}
+}
+
+func TestComment_Lines(t *testing.T) {
+
+	t.Parallel()
+
+	type testCase struct {
+		name   string
+		source string
+		lines  []string
+	}
+
+	testCases := []testCase{
+		{
+			name:   "line comment",
+			source: "// foo",
+			lines:  []string{"foo"},
+		},
+		{
+			name:   "doc line comments",
+			source: "/// foo\n  /// bar\n///\n/// baz",
+			lines:  []string{"foo", "bar", "", "baz"},
+		},
+		{
+			name:   "single-line block comment",
+			source: "/** foo */",
+			lines:  []string{"foo"},
+		},
+		{
+			name:   "empty block comment",
+			source: "/**/",
+			lines:  []string{},
+		},
+		{
+			name:   "doc block comment with aligned asterisks",
+			source: "/**\n   * foo\n   *\n   * **bar**\n   */",
+			lines:  []string{"foo", "", "**bar**"},
+		},
+		{
+			name:   "block comment without asterisks",
+			source: "/*\n  foo\n  bar\n*/",
+			lines:  []string{"foo", "bar"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			t.Parallel()
+
+			comment := NewComment(nil, []byte(testCase.source), EmptyRange)
+
+			assert.Equal(t, testCase.lines, comment.Lines())
+		})
+	}
+}
@@ -310,6 +310,7 @@ type FieldDeclaration struct {
 	Access       Access
 	VariableKind VariableKind
 	Flags        FieldDeclarationFlags
+	Comments
 }
 
 var _ Element = &FieldDeclaration{}
@@ -325,6 +326,7 @@ func NewFieldDeclaration(
 	typeAnnotation *TypeAnnotation,
 	docString string,
 	declRange Range,
+	comments Comments,
 ) *FieldDeclaration {
 	common.UseMemory(memoryGauge, common.FieldDeclarationMemoryUsage)
 
@@ -344,6 +346,7 @@ func NewFieldDeclaration(
 		TypeAnnotation: typeAnnotation,
 		DocString:      docString,
 		Range:          declRange,
+		Comments:       comments,
 	}
 }
 
@@ -496,6 +499,7 @@ type EnumCaseDeclaration struct {
 	Identifier Identifier
 	StartPos   Position `json:"-"`
 	Access     Access
+	Comments
 }
 
 var _ Element = &EnumCaseDeclaration{}
@@ -507,6 +511,7 @@ func NewEnumCaseDeclaration(
 	identifier Identifier,
 	docString string,
 	startPos Position,
+	comments Comments,
 ) *EnumCaseDeclaration {
 	common.UseMemory(memoryGauge, common.EnumCaseDeclarationMemoryUsage)
 
@@ -515,6 +520,7 @@ func NewEnumCaseDeclaration(
 		Identifier: identifier,
 		DocString:  docString,
 		StartPos:   startPos,
+		Comments:   comments,
 	}
 }
 
@@ -74,6 +74,7 @@ type FunctionDeclaration struct {
 	StartPos             Position `json:"-"`
 	Access               Access
 	Flags                FunctionDeclarationFlags
+	Comments
 }
 
 var _ Element = &FunctionDeclaration{}
@@ -93,6 +94,7 @@ func NewFunctionDeclaration(
 	functionBlock *FunctionBlock,
 	startPos Position,
 	docString string,
+	comments Comments,
 ) *FunctionDeclaration {
 	common.UseMemory(gauge, common.FunctionDeclarationMemoryUsage)
 
@@ -115,6 +117,7 @@ func NewFunctionDeclaration(
 		FunctionBlock:        functionBlock,
 		StartPos:             startPos,
 		DocString:            docString,
+		Comments:             comments,
 	}
 }
 
@@ -31,17 +31,24 @@ import (
 type PragmaDeclaration struct {
 	Expression Expression
 	Range
+	Comments
 }
 
 var _ Element = &PragmaDeclaration{}
 var _ Declaration = &PragmaDeclaration{}
 
-func NewPragmaDeclaration(gauge common.MemoryGauge, expression Expression, declRange Range) *PragmaDeclaration {
+func NewPragmaDeclaration(
+	gauge common.MemoryGauge,
+	expression Expression,
+	declRange Range,
+	comments Comments,
+) *PragmaDeclaration {
 	common.UseMemory(gauge, common.PragmaDeclarationMemoryUsage)
 
 	return &PragmaDeclaration{
 		Expression: expression,
 		Range:      declRange,
+		Comments:   comments,
 	}
 }
 
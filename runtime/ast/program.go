@@ -29,7 +29,9 @@ import (
 type Program struct {
 	// all declarations, in the order they are defined
 	declarations []Declaration
-	indices      programIndices
+	// the legacy access modifiers, in the order they occur in the source
+	legacyAccessModifiers []LegacyAccessModifier
+	indices               programIndices
 }
 
 var _ Element = &Program{}
@@ -41,6 +43,18 @@ func NewProgram(memoryGauge common.MemoryGauge, declarations []Declaration) *Pro
 	}
 }
 
+// NewProgramWithLegacyAccessModifiers returns a program with the given declarations,
+// and the given legacy access modifiers, which were rejected when parsing the declarations.
+func NewProgramWithLegacyAccessModifiers(
+	memoryGauge common.MemoryGauge,
+	declarations []Declaration,
+	legacyAccessModifiers []LegacyAccessModifier,
+) *Program {
+	program := NewProgram(memoryGauge, declarations)
+	program.legacyAccessModifiers = legacyAccessModifiers
+	return program
+}
+
 func (*Program) ElementType() ElementType {
 	return ElementTypeProgram
 }
@@ -49,6 +63,12 @@ func (p *Program) Declarations() []Declaration {
 	return p.declarations
 }
 
+// LegacyAccessModifiers returns the legacy access modifiers of the program,
+// in the order they occur in the source.
+func (p *Program) LegacyAccessModifiers() []LegacyAccessModifier {
+	return p.legacyAccessModifiers
+}
+
 func (p *Program) StartPosition() Position {
 	if len(p.declarations) == 0 {
 		return EmptyPosition
@@ -0,0 +1,132 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ast
+
+import (
+	"bytes"
+)
+
+// ReplaceFunc is called by Reconstructor for each element of a program, in depth-first order.
+// If it returns ok = true, the source of the element is replaced by the given replacement,
+// and the children of the element are not visited.
+type ReplaceFunc func(element Element) (replacement []byte, ok bool)
+
+// Reconstructor re-emits the original source code of a program,
+// replacing the source ranges of individual elements.
+//
+// All regions of the source code which are not replaced,
+// including comments and whitespace, are copied verbatim.
+type Reconstructor struct {
+	source []byte
+	config ReconstructorConfig
+}
+
+// ReconstructorConfig configures a Reconstructor.
+type ReconstructorConfig struct {
+	// NormalizeAccessModifiers enables the rewriting of the legacy access keywords
+	// `pub` and `priv` to `access(all)` and `access(self)`, respectively.
+	// The legacy keywords are rejected by the parser,
+	// but the declarations they modify are still parsed,
+	// and the parser records the legacy keywords in the program.
+	NormalizeAccessModifiers bool
+}
+
+// NewReconstructor returns a Reconstructor for the given original source code.
+func NewReconstructor(source []byte, config ReconstructorConfig) *Reconstructor {
+	return &Reconstructor{
+		source: source,
+		config: config,
+	}
+}
+
+// Reconstruct re-emits the source code of the given program,
+// which must have been parsed from the source code of the reconstructor.
+//
+// Replacements for elements which overlap an already replaced region are ignored.
+func (r *Reconstructor) Reconstruct(program *Program, replace ReplaceFunc) []byte {
+	var buffer bytes.Buffer
+
+	// offset of the first byte of the source which has not been emitted yet
+	offset := 0
+
+	var legacyAccessModifiers []LegacyAccessModifier
+	if r.config.NormalizeAccessModifiers {
+		legacyAccessModifiers = program.LegacyAccessModifiers()
+	}
+
+	// write emits the source up to the given end offset,
+	// rewriting the legacy access modifiers in it, if enabled.
+	// Legacy access modifiers in replaced regions are skipped
+	write := func(endOffset int) {
+		for len(legacyAccessModifiers) > 0 {
+			modifier := legacyAccessModifiers[0]
+
+			modifierStartOffset := modifier.StartPos.Offset
+			if modifierStartOffset >= endOffset {
+				break
+			}
+
+			legacyAccessModifiers = legacyAccessModifiers[1:]
+
+			if modifierStartOffset < offset {
+				continue
+			}
+
+			buffer.Write(r.source[offset:modifierStartOffset])
+			buffer.WriteString(modifier.Access.Keyword())
+			// End positions are inclusive
+			offset = modifier.EndPos.Offset + 1
+		}
+
+		buffer.Write(r.source[offset:endOffset])
+		offset = endOffset
+	}
+
+	Inspect(program, func(element Element) bool {
+		if element == nil {
+			return true
+		}
+
+		startOffset := element.StartPosition().Offset
+		if startOffset < offset {
+			return false
+		}
+
+		replacement, ok := replace(element)
+		if !ok {
+			return true
+		}
+
+		// End positions are inclusive
+		endOffset := element.EndPosition(nil).Offset + 1
+		if endOffset > len(r.source) {
+			endOffset = len(r.source)
+		}
+
+		write(startOffset)
+		buffer.Write(replacement)
+		offset = endOffset
+
+		return false
+	})
+
+	write(len(r.source))
+
+	return buffer.Bytes()
+}
@@ -0,0 +1,232 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ast_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/parser"
+)
+
+func TestReconstructor(t *testing.T) {
+
+	t.Parallel()
+
+	const code = `
+      // A comment which must be kept
+      access(all) fun test(a: Int, b: Int): Int {
+          /* the sum */
+          return a   +   b // trailing
+      }
+    `
+
+	program, err := parser.ParseProgram(nil, []byte(code), parser.Config{})
+	require.NoError(t, err)
+
+	t.Run("no replacements", func(t *testing.T) {
+
+		t.Parallel()
+
+		result := ast.NewReconstructor([]byte(code), ast.ReconstructorConfig{}).Reconstruct(
+			program,
+			func(ast.Element) ([]byte, bool) {
+				return nil, false
+			},
+		)
+
+		assert.Equal(t, code, string(result))
+	})
+
+	t.Run("replace identifier", func(t *testing.T) {
+
+		t.Parallel()
+
+		var replaced []ast.Element
+
+		result := ast.NewReconstructor([]byte(code), ast.ReconstructorConfig{}).Reconstruct(
+			program,
+			func(element ast.Element) ([]byte, bool) {
+				identifierExpression, ok := element.(*ast.IdentifierExpression)
+				if !ok || identifierExpression.Identifier.Identifier != "b" {
+					return nil, false
+				}
+
+				replaced = append(replaced, element)
+
+				return []byte("other"), true
+			},
+		)
+
+		require.Len(t, replaced, 1)
+
+		assert.Equal(t,
+			`
+      // A comment which must be kept
+      access(all) fun test(a: Int, b: Int): Int {
+          /* the sum */
+          return a   +   other // trailing
+      }
+    `,
+			string(result),
+		)
+	})
+
+	t.Run("replace parent", func(t *testing.T) {
+
+		t.Parallel()
+
+		var replaced []ast.Element
+
+		result := ast.NewReconstructor([]byte(code), ast.ReconstructorConfig{}).Reconstruct(
+			program,
+			func(element ast.Element) ([]byte, bool) {
+				switch element.(type) {
+				case *ast.BinaryExpression, *ast.IdentifierExpression:
+					replaced = append(replaced, element)
+					return []byte("a - b"), true
+				}
+
+				return nil, false
+			},
+		)
+
+		// The children of the replaced binary expression are not visited
+		require.Len(t, replaced, 1)
+		require.IsType(t, &ast.BinaryExpression{}, replaced[0])
+
+		assert.Equal(t,
+			`
+      // A comment which must be kept
+      access(all) fun test(a: Int, b: Int): Int {
+          /* the sum */
+          return a - b // trailing
+      }
+    `,
+			string(result),
+		)
+	})
+}
+
+func TestReconstructorNormalizeAccessModifiers(t *testing.T) {
+
+	t.Parallel()
+
+	const code = `
+      /// Returns the answer
+      pub fun answer(): Int {
+          return 42
+      }
+
+      // The resource
+      pub resource R {
+
+          priv var count: Int
+
+          /// Increments the count
+          pub  fun increment() {
+              self.count = self.count + 1 // pub
+          }
+
+          access(contract) fun reset() {
+              self.count = 0
+          }
+
+          init() {
+              self.count = 0
+              // not a modifier: pub
+              let y = self.count + pub
+              fun local() {}
+          }
+      }
+    `
+
+	// The legacy access keywords are rejected,
+	// but the declarations are still parsed
+	program, err := parser.ParseProgram(nil, []byte(code), parser.Config{})
+	require.Error(t, err)
+
+	t.Run("disabled", func(t *testing.T) {
+
+		t.Parallel()
+
+		result := ast.NewReconstructor([]byte(code), ast.ReconstructorConfig{}).Reconstruct(
+			program,
+			func(ast.Element) ([]byte, bool) {
+				return nil, false
+			},
+		)
+
+		assert.Equal(t, code, string(result))
+	})
+
+	t.Run("enabled", func(t *testing.T) {
+
+		t.Parallel()
+
+		result := ast.NewReconstructor(
+			[]byte(code),
+			ast.ReconstructorConfig{
+				NormalizeAccessModifiers: true,
+			},
+		).Reconstruct(
+			program,
+			func(ast.Element) ([]byte, bool) {
+				return nil, false
+			},
+		)
+
+		const expected = `
+      /// Returns the answer
+      access(all) fun answer(): Int {
+          return 42
+      }
+
+      // The resource
+      access(all) resource R {
+
+          access(self) var count: Int
+
+          /// Increments the count
+          access(all)  fun increment() {
+              self.count = self.count + 1 // pub
+          }
+
+          access(contract) fun reset() {
+              self.count = 0
+          }
+
+          init() {
+              self.count = 0
+              // not a modifier: pub
+              let y = self.count + pub
+              fun local() {}
+          }
+      }
+    `
+
+		assert.Equal(t, expected, string(result))
+
+		_, err := parser.ParseProgram(nil, result, parser.Config{})
+		require.NoError(t, err)
+	})
+}
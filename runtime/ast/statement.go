@@ -39,16 +39,23 @@ type Statement interface {
 type ReturnStatement struct {
 	Expression Expression
 	Range
+	Comments
 }
 
 var _ Element = &ReturnStatement{}
 var _ Statement = &ReturnStatement{}
 
-func NewReturnStatement(gauge common.MemoryGauge, expression Expression, stmtRange Range) *ReturnStatement {
+func NewReturnStatement(
+	gauge common.MemoryGauge,
+	expression Expression,
+	stmtRange Range,
+	comments Comments,
+) *ReturnStatement {
 	common.UseMemory(gauge, common.ReturnStatementMemoryUsage)
 	return &ReturnStatement{
 		Expression: expression,
 		Range:      stmtRange,
+		Comments:   comments,
 	}
 }
 
@@ -69,13 +76,15 @@ const returnStatementKeywordSpaceDoc = prettier.Text("return ")
 
 func (s *ReturnStatement) Doc() prettier.Doc {
 	if s.Expression == nil {
-		return returnStatementKeywordDoc
+		return s.Comments.doc(returnStatementKeywordDoc)
 	}
 
-	return prettier.Concat{
-		returnStatementKeywordSpaceDoc,
-		s.Expression.Doc(),
-	}
+	return s.Comments.doc(
+		prettier.Concat{
+			returnStatementKeywordSpaceDoc,
+			s.Expression.Doc(),
+		},
+	)
 }
 
 func (s *ReturnStatement) String() string {
@@ -202,6 +211,7 @@ type IfStatement struct {
 	Then     *Block
 	Else     *Block
 	StartPos Position `json:"-"`
+	Comments
 }
 
 var _ Element = &IfStatement{}
@@ -213,6 +223,7 @@ func NewIfStatement(
 	thenBlock *Block,
 	elseBlock *Block,
 	startPos Position,
+	comments Comments,
 ) *IfStatement {
 	common.UseMemory(gauge, common.IfStatementMemoryUsage)
 	return &IfStatement{
@@ -220,6 +231,7 @@ func NewIfStatement(
 		Then:     thenBlock,
 		Else:     elseBlock,
 		StartPos: startPos,
+		Comments: comments,
 	}
 }
 
@@ -281,9 +293,11 @@ func (s *IfStatement) Doc() prettier.Doc {
 		)
 	}
 
-	return prettier.Group{
-		Doc: doc,
-	}
+	return s.Comments.doc(
+		prettier.Group{
+			Doc: doc,
+		},
+	)
 }
 
 func (s *IfStatement) String() string {
@@ -309,6 +323,7 @@ type WhileStatement struct {
 	Test     Expression
 	Block    *Block
 	StartPos Position `json:"-"`
+	Comments
 }
 
 var _ Element = &WhileStatement{}
@@ -319,12 +334,14 @@ func NewWhileStatement(
 	expression Expression,
 	block *Block,
 	startPos Position,
+	comments Comments,
 ) *WhileStatement {
 	common.UseMemory(gauge, common.WhileStatementMemoryUsage)
 	return &WhileStatement{
 		Test:     expression,
 		Block:    block,
 		StartPos: startPos,
+		Comments: comments,
 	}
 }
 
@@ -350,14 +367,16 @@ func (s *WhileStatement) EndPosition(memoryGauge common.MemoryGauge) Position {
 const whileStatementKeywordSpaceDoc = prettier.Text("while ")
 
 func (s *WhileStatement) Doc() prettier.Doc {
-	return prettier.Group{
-		Doc: prettier.Concat{
-			whileStatementKeywordSpaceDoc,
-			s.Test.Doc(),
-			prettier.Space,
-			s.Block.Doc(),
+	return s.Comments.doc(
+		prettier.Group{
+			Doc: prettier.Concat{
+				whileStatementKeywordSpaceDoc,
+				s.Test.Doc(),
+				prettier.Space,
+				s.Block.Doc(),
+			},
 		},
-	}
+	)
 }
 
 func (s *WhileStatement) String() string {
@@ -385,6 +404,7 @@ type ForStatement struct {
 	Block      *Block
 	Identifier Identifier
 	StartPos   Position `json:"-"`
+	Comments
 }
 
 var _ Element = &ForStatement{}
@@ -397,6 +417,7 @@ func NewForStatement(
 	block *Block,
 	expression Expression,
 	startPos Position,
+	comments Comments,
 ) *ForStatement {
 	common.UseMemory(gauge, common.ForStatementMemoryUsage)
 
@@ -406,6 +427,7 @@ func NewForStatement(
 		Block:      block,
 		Value:      expression,
 		StartPos:   startPos,
+		Comments:   comments,
 	}
 }
 
@@ -453,9 +475,11 @@ func (s *ForStatement) Doc() prettier.Doc {
 		s.Block.Doc(),
 	)
 
-	return prettier.Group{
-		Doc: doc,
-	}
+	return s.Comments.doc(
+		prettier.Group{
+			Doc: doc,
+		},
+	)
 }
 
 func (s *ForStatement) String() string {
@@ -169,6 +169,39 @@ func TestReturnStatement_Doc(t *testing.T) {
 			stmt.Doc(),
 		)
 	})
+
+	t.Run("comments", func(t *testing.T) {
+
+		t.Parallel()
+
+		stmt := &ReturnStatement{
+			Expression: &BoolExpression{
+				Value: false,
+			},
+			Comments: Comments{
+				Leading: []*Comment{
+					NewComment(nil, []byte("// leave early"), Range{}),
+				},
+				Trailing: []*Comment{
+					NewComment(nil, []byte("/* the result */"), Range{}),
+				},
+			},
+		}
+
+		require.Equal(t,
+			prettier.Concat{
+				prettier.Text("// leave early"),
+				prettier.HardLine{},
+				prettier.Concat{
+					prettier.Text("return "),
+					prettier.Text("false"),
+				},
+				prettier.Space,
+				prettier.Text("/* the result */"),
+			},
+			stmt.Doc(),
+		)
+	})
 }
 
 func TestReturnStatement_String(t *testing.T) {
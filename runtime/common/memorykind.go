@@ -233,6 +233,7 @@ const (
 
 	MemoryKindPosition
 	MemoryKindRange
+	MemoryKindComment
 
 	MemoryKindElaboration
 	MemoryKindActivation
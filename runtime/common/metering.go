@@ -127,6 +127,7 @@ var (
 
 	PositionMemoryUsage = NewConstantMemoryUsage(MemoryKindPosition)
 	RangeMemoryUsage    = NewConstantMemoryUsage(MemoryKindRange)
+	CommentMemoryUsage  = NewConstantMemoryUsage(MemoryKindComment)
 
 	ElaborationMemoryUsage       = NewConstantMemoryUsage(MemoryKindElaboration)
 	ActivationMemoryUsage        = NewConstantMemoryUsage(MemoryKindActivation)
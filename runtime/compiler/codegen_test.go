@@ -115,19 +115,25 @@ func TestWasmCodeGenSimple(t *testing.T) {
 			},
 			Imports: []*wasm.Import{
 				{
-					Module:    RuntimeModuleName,
-					Name:      "Int",
-					TypeIndex: 0,
+					Module: RuntimeModuleName,
+					Name:   "Int",
+					Descriptor: wasm.FunctionImport{
+						TypeIndex: 0,
+					},
 				},
 				{
-					Module:    RuntimeModuleName,
-					Name:      "String",
-					TypeIndex: 1,
+					Module: RuntimeModuleName,
+					Name:   "String",
+					Descriptor: wasm.FunctionImport{
+						TypeIndex: 1,
+					},
 				},
 				{
-					Module:    RuntimeModuleName,
-					Name:      "add",
-					TypeIndex: 2,
+					Module: RuntimeModuleName,
+					Name:   "add",
+					Descriptor: wasm.FunctionImport{
+						TypeIndex: 2,
+					},
 				},
 			},
 			Functions: []*wasm.Function{
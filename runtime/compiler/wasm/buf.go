@@ -91,6 +91,14 @@ func (buf *Buffer) ReadBytesEqual(expected []byte) (bool, error) {
 	return true, nil
 }
 
+// remaining returns the number of bytes that are left to be read
+func (buf *Buffer) remaining() uint32 {
+	if buf.offset >= offset(len(buf.data)) {
+		return 0
+	}
+	return uint32(offset(len(buf.data)) - buf.offset)
+}
+
 func (buf *Buffer) Bytes() []byte {
 	return buf.data
 }
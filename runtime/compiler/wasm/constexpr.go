@@ -0,0 +1,57 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package wasm
+
+// ValidateConstExpr validates that the given instructions form a constant expression,
+// as required for e.g. global initializers and element segment offsets.
+//
+// Only constant instructions (i32.const, i64.const, ref.null, ref.func),
+// global.get, and a terminating end instruction are allowed.
+//
+// NOTE: Whether a global.get refers to an immutable imported global
+// cannot be determined from the instructions alone,
+// and must be validated against the module.
+func ValidateConstExpr(instructions []Instruction) error {
+	lastIndex := len(instructions) - 1
+
+	for i, instruction := range instructions {
+		switch instruction.(type) {
+		case InstructionI32Const,
+			InstructionI64Const,
+			InstructionRefNull,
+			InstructionRefFunc,
+			InstructionGlobalGet:
+
+			continue
+
+		case InstructionEnd:
+			// The end instruction must terminate the expression
+			if i == lastIndex {
+				continue
+			}
+		}
+
+		return InvalidConstantExpressionInstructionError{
+			Instruction: instruction,
+			Index:       i,
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,89 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package wasm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateConstExpr(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("valid", func(t *testing.T) {
+
+		t.Parallel()
+
+		err := ValidateConstExpr([]Instruction{
+			InstructionI32Const{Value: 42},
+			InstructionEnd{},
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("global.get", func(t *testing.T) {
+
+		t.Parallel()
+
+		err := ValidateConstExpr([]Instruction{
+			InstructionGlobalGet{GlobalIndex: 0},
+			InstructionEnd{},
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("i32.add", func(t *testing.T) {
+
+		t.Parallel()
+
+		err := ValidateConstExpr([]Instruction{
+			InstructionI32Const{Value: 1},
+			InstructionI32Const{Value: 2},
+			InstructionI32Add{},
+			InstructionEnd{},
+		})
+		require.Equal(t,
+			InvalidConstantExpressionInstructionError{
+				Instruction: InstructionI32Add{},
+				Index:       2,
+			},
+			err,
+		)
+		require.EqualError(t, err, "invalid instruction 'i32.add' at index 2 in constant expression")
+	})
+
+	t.Run("instruction after end", func(t *testing.T) {
+
+		t.Parallel()
+
+		err := ValidateConstExpr([]Instruction{
+			InstructionEnd{},
+			InstructionI32Const{Value: 1},
+		})
+		require.Equal(t,
+			InvalidConstantExpressionInstructionError{
+				Instruction: InstructionEnd{},
+				Index:       0,
+			},
+			err,
+		)
+	})
+}
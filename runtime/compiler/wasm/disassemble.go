@@ -0,0 +1,186 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package wasm
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// DisassembleFunction reads the given function body, i.e. the locals and the instructions,
+// and returns one human-readable line per instruction, prefixed with its byte offset in the body.
+// The instructions of nested blocks, loops, and ifs are indented.
+//
+// The disassembly is only intended for debugging, e.g. of generated code.
+func DisassembleFunction(body []byte) ([]string, error) {
+	r := NewWASMReader(&Buffer{data: body})
+
+	_, err := r.readLocals()
+	if err != nil {
+		return nil, err
+	}
+
+	var lines []string
+	depth := 0
+
+	addLine := func(offset offset, depth int, text string) {
+		lines = append(
+			lines,
+			fmt.Sprintf(
+				"%04x: %s%s",
+				offset,
+				strings.Repeat("  ", depth),
+				text,
+			),
+		)
+	}
+
+	for {
+		instructionOffset := r.buf.offset
+
+		b, err := r.buf.PeekByte()
+		if err != nil {
+			return nil, MissingEndInstructionError{
+				Offset: int(instructionOffset),
+			}
+		}
+
+		// Blocks are read recursively by readInstruction,
+		// so read the opcodes that start, separate, and end blocks here,
+		// to be able to report the offsets of the nested instructions
+
+		switch opcode(b) {
+		case opcodeBlock, opcodeLoop, opcodeIf:
+			r.buf.offset++
+
+			blockType, err := r.readBlockType()
+			if err != nil {
+				return nil, err
+			}
+
+			var name string
+			switch opcode(b) {
+			case opcodeBlock:
+				name = InstructionBlock{}.name()
+			case opcodeLoop:
+				name = InstructionLoop{}.name()
+			case opcodeIf:
+				name = InstructionIf{}.name()
+			}
+
+			addLine(instructionOffset, depth, name+disassembleBlockType(blockType))
+			depth++
+
+		case opcodeElse:
+			if depth == 0 {
+				return nil, InvalidBlockSecondInstructionsError{
+					Offset: int(instructionOffset),
+				}
+			}
+
+			r.buf.offset++
+
+			addLine(instructionOffset, depth-1, "else")
+
+		case opcodeEnd:
+			r.buf.offset++
+
+			if depth == 0 {
+				// end of the function
+				addLine(instructionOffset, depth, InstructionEnd{}.name())
+
+				if int(r.buf.offset) < len(body) {
+					return nil, InstructionTrailingDataError{
+						Offset: int(r.buf.offset),
+					}
+				}
+
+				return lines, nil
+			}
+
+			depth--
+			addLine(instructionOffset, depth, InstructionEnd{}.name())
+
+		default:
+			instruction, err := r.readInstruction()
+			if err != nil {
+				return nil, err
+			}
+
+			addLine(instructionOffset, depth, disassembleInstruction(instruction))
+		}
+	}
+}
+
+func disassembleInstruction(instruction Instruction) string {
+	var builder strings.Builder
+	builder.WriteString(instruction.name())
+
+	value := reflect.ValueOf(instruction)
+	for i := 0; i < value.NumField(); i++ {
+		builder.WriteByte(' ')
+
+		switch argument := value.Field(i).Interface().(type) {
+		case ValueType:
+			builder.WriteString(disassembleValueType(argument))
+
+		case []ValueType:
+			for j, valueType := range argument {
+				if j > 0 {
+					builder.WriteByte(' ')
+				}
+				builder.WriteString(disassembleValueType(valueType))
+			}
+
+		default:
+			_, _ = fmt.Fprint(&builder, argument)
+		}
+	}
+
+	return builder.String()
+}
+
+func disassembleBlockType(blockType BlockType) string {
+	switch blockType := blockType.(type) {
+	case nil:
+		return ""
+	case ValueType:
+		return fmt.Sprintf(" (result %s)", disassembleValueType(blockType))
+	case TypeIndexBlockType:
+		return fmt.Sprintf(" (type %d)", blockType.TypeIndex)
+	default:
+		panic(fmt.Errorf("unsupported block type: %T", blockType))
+	}
+}
+
+func disassembleValueType(valueType ValueType) string {
+	switch valueType {
+	case ValueTypeI32:
+		return "i32"
+	case ValueTypeI64:
+		return "i64"
+	case ValueTypeFuncRef:
+		return "funcref"
+	case ValueTypeExternRef:
+		return "externref"
+	default:
+		return fmt.Sprintf("0x%x", byte(valueType))
+	}
+}
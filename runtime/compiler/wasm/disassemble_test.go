@@ -0,0 +1,128 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package wasm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDisassembleFunction(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("nested if/else", func(t *testing.T) {
+
+		t.Parallel()
+
+		lines, err := DisassembleFunction([]byte{
+			// number of locals: 1
+			0x1,
+			// 1 local of type i32
+			0x1, 0x7f,
+			// i32.const 1
+			0x41, 0x1,
+			// if (result i32)
+			0x04, 0x7f,
+			// local.get 0
+			0x20, 0x0,
+			// if
+			0x04, 0x40,
+			// nop
+			0x01,
+			// else
+			0x05,
+			// unreachable
+			0x00,
+			// end
+			0x0b,
+			// else
+			0x05,
+			// i32.const 2
+			0x41, 0x2,
+			// end
+			0x0b,
+			// drop
+			0x1a,
+			// end
+			0x0b,
+		})
+		require.NoError(t, err)
+
+		require.Equal(t,
+			[]string{
+				"0003: i32.const 1",
+				"0005: if (result i32)",
+				"0007:   local.get 0",
+				"0009:   if",
+				"000b:     nop",
+				"000c:   else",
+				"000d:     unreachable",
+				"000e:   end",
+				"000f: else",
+				"0010:   i32.const 2",
+				"0012: end",
+				"0013: drop",
+				"0014: end",
+			},
+			lines,
+		)
+	})
+
+	t.Run("missing end", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := DisassembleFunction([]byte{
+			// number of locals: 0
+			0x0,
+			// block
+			0x02, 0x40,
+			// end
+			0x0b,
+		})
+		require.Equal(t,
+			MissingEndInstructionError{
+				Offset: 4,
+			},
+			err,
+		)
+	})
+
+	t.Run("trailing data", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := DisassembleFunction([]byte{
+			// number of locals: 0
+			0x0,
+			// end
+			0x0b,
+			// nop
+			0x01,
+		})
+		require.Equal(t,
+			InstructionTrailingDataError{
+				Offset: 2,
+			},
+			err,
+		)
+	})
+}
@@ -0,0 +1,47 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package wasm
+
+// Element represents an element segment, which initializes a range of a table,
+// at a given offset, with a static vector of function indices.
+type Element struct {
+	// must be constant, as defined in the spec
+	// (https://webassembly.github.io/spec/core/valid/instructions.html#constant-expressions)
+	Offset          []Instruction
+	FunctionIndices []uint32
+	TableIndex      uint32
+}
+
+// elementSegmentFlags are the flags at the beginning of an element segment,
+// which determine the mode and the encoding of the segment.
+//
+// See https://webassembly.github.io/spec/core/binary/modules.html#element-section
+type elementSegmentFlags uint32
+
+const (
+	// elementSegmentFlagsActive is an active segment for table 0,
+	// with a vector of function indices
+	elementSegmentFlagsActive elementSegmentFlags = 0
+	// elementSegmentFlagsActiveTableIndex is an active segment for an explicit table index,
+	// with an element kind and a vector of function indices
+	elementSegmentFlagsActiveTableIndex elementSegmentFlags = 2
+)
+
+// elementKindFunctionReference is the only element kind, function references
+const elementKindFunctionReference byte = 0x00
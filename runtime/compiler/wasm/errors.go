@@ -96,15 +96,17 @@ func (e InvalidDuplicateSectionError) Error() string {
 // InvalidSectionOrderError is returned when the WASM binary specifies
 // a non-custom section out-of-order
 type InvalidSectionOrderError struct {
-	Offset    int
-	SectionID sectionID
+	Offset            int
+	SectionID         sectionID
+	PreviousSectionID sectionID
 }
 
 func (e InvalidSectionOrderError) Error() string {
 	return fmt.Sprintf(
-		"out-of-order section with ID %d at offset %d",
+		"out-of-order section with ID %d at offset %d: must not follow section with ID %d",
 		e.SectionID,
 		e.Offset,
+		e.PreviousSectionID,
 	)
 }
 
@@ -561,21 +563,19 @@ func (e InvalidCodeSectionLocalTypeError) Unwrap() error {
 	return e.ReadError
 }
 
-// CodeSectionLocalsCountMismatchError is returned when
-// the sum of the compressed locals locals count in the code section does not match
-// the number of locals in the code section of the WASM binary
-type CodeSectionLocalsCountMismatchError struct {
-	Offset   int
-	Expected uint32
-	Actual   uint32
+// CodeSectionLocalsCountLimitError is returned when the WASM binary specifies
+// more locals for a function than supported
+type CodeSectionLocalsCountLimitError struct {
+	Offset int
+	Count  uint64
 }
 
-func (e CodeSectionLocalsCountMismatchError) Error() string {
+func (e CodeSectionLocalsCountLimitError) Error() string {
 	return fmt.Sprintf(
-		"local count mismatch in code section at offset %d: expected %d, got %d",
+		"too many locals in code section at offset %d: %d, maximum is %d",
 		e.Offset,
-		e.Expected,
-		e.Actual,
+		e.Count,
+		maxLocalsCount,
 	)
 }
 
@@ -599,6 +599,28 @@ func (e InvalidOpcodeError) Unwrap() error {
 	return e.ReadError
 }
 
+// InvalidPrefixedOpcodeError is returned when the WASM binary specifies
+// an invalid opcode after a prefix byte in the code section
+type InvalidPrefixedOpcodeError struct {
+	ReadError error
+	Offset    int
+	Prefix    opcode
+	Opcode    prefixedOpcode
+}
+
+func (e InvalidPrefixedOpcodeError) Error() string {
+	return fmt.Sprintf(
+		"invalid opcode in code section at offset %d: %x %x",
+		e.Offset,
+		e.Prefix,
+		e.Opcode,
+	)
+}
+
+func (e InvalidPrefixedOpcodeError) Unwrap() error {
+	return e.ReadError
+}
+
 // InvalidInstructionArgumentError is returned when the WASM binary specifies
 // an invalid argument for an instruction in the code section
 type InvalidInstructionArgumentError struct {
@@ -617,6 +639,21 @@ func (e InvalidInstructionArgumentError) Unwrap() error {
 	return e.ReadError
 }
 
+// InvalidInstructionReservedByteError is returned when the WASM binary specifies
+// a non-zero reserved byte for an instruction in the code section
+type InvalidInstructionReservedByteError struct {
+	Offset int
+	Byte   byte
+}
+
+func (e InvalidInstructionReservedByteError) Error() string {
+	return fmt.Sprintf(
+		"invalid reserved byte in code section at offset %d: expected 0x0, got 0x%x",
+		e.Offset,
+		e.Byte,
+	)
+}
+
 // MissingEndInstructionError is returned when the WASM binary
 // misses an end instruction for a function in the code section
 type MissingEndInstructionError struct {
@@ -781,6 +818,37 @@ func (e InvalidDataSegmentError) Unwrap() error {
 	return e.ReadError
 }
 
+// InvalidDataCountSectionCountError is returned when the WASM binary specifies
+// an invalid count in the data count section
+type InvalidDataCountSectionCountError struct {
+	ReadError error
+	Offset    int
+}
+
+func (e InvalidDataCountSectionCountError) Error() string {
+	return fmt.Sprintf(
+		"invalid count in data count section at offset %d",
+		e.Offset,
+	)
+}
+
+func (e InvalidDataCountSectionCountError) Unwrap() error {
+	return e.ReadError
+}
+
+// DataCountMismatchError is returned when the WASM binary specifies
+// or the writer is given a data count which does not match the number of data segments
+type DataCountMismatchError struct {
+	Offset int
+}
+
+func (e DataCountMismatchError) Error() string {
+	return fmt.Sprintf(
+		"data count mismatch at offset %d",
+		e.Offset,
+	)
+}
+
 // InvalidDataSectionMemoryIndexError is returned when the WASM binary specifies
 // an invalid memory index in the data section
 type InvalidDataSectionMemoryIndexError struct {
@@ -817,6 +885,194 @@ func (e InvalidDataSectionInitByteCountError) Unwrap() error {
 	return e.ReadError
 }
 
+// InvalidElementSectionSegmentCountError is returned when the WASM binary specifies
+// an invalid count in the element section
+type InvalidElementSectionSegmentCountError struct {
+	ReadError error
+	Offset    int
+}
+
+func (e InvalidElementSectionSegmentCountError) Error() string {
+	return fmt.Sprintf(
+		"invalid segment count in element section at offset %d",
+		e.Offset,
+	)
+}
+
+func (e InvalidElementSectionSegmentCountError) Unwrap() error {
+	return e.ReadError
+}
+
+// InvalidElementSegmentError is returned when the WASM binary specifies
+// invalid segment in the element section
+type InvalidElementSegmentError struct {
+	ReadError error
+	Index     int
+}
+
+func (e InvalidElementSegmentError) Error() string {
+	return fmt.Sprintf(
+		"invalid element segment at index %d",
+		e.Index,
+	)
+}
+
+func (e InvalidElementSegmentError) Unwrap() error {
+	return e.ReadError
+}
+
+// InvalidElementSegmentFlagsError is returned when the WASM binary specifies
+// invalid or unsupported flags for a segment in the element section
+type InvalidElementSegmentFlagsError struct {
+	ReadError error
+	Offset    int
+	Flags     uint32
+}
+
+func (e InvalidElementSegmentFlagsError) Error() string {
+	return fmt.Sprintf(
+		"invalid element segment flags in element section at offset %d: %d",
+		e.Offset,
+		e.Flags,
+	)
+}
+
+func (e InvalidElementSegmentFlagsError) Unwrap() error {
+	return e.ReadError
+}
+
+// InvalidElementSegmentElementKindError is returned when the WASM binary specifies
+// an invalid element kind for a segment in the element section
+type InvalidElementSegmentElementKindError struct {
+	ReadError   error
+	Offset      int
+	ElementKind byte
+}
+
+func (e InvalidElementSegmentElementKindError) Error() string {
+	return fmt.Sprintf(
+		"invalid element kind in element section at offset %d: %x",
+		e.Offset,
+		e.ElementKind,
+	)
+}
+
+func (e InvalidElementSegmentElementKindError) Unwrap() error {
+	return e.ReadError
+}
+
+// InvalidElementSectionTableIndexError is returned when the WASM binary specifies
+// an invalid table index in the element section
+type InvalidElementSectionTableIndexError struct {
+	ReadError error
+	Offset    int
+}
+
+func (e InvalidElementSectionTableIndexError) Error() string {
+	return fmt.Sprintf(
+		"invalid table index in element section at offset %d",
+		e.Offset,
+	)
+}
+
+func (e InvalidElementSectionTableIndexError) Unwrap() error {
+	return e.ReadError
+}
+
+// InvalidElementSectionFunctionIndexCountError is returned when the WASM binary specifies
+// an invalid function index count in the element section
+type InvalidElementSectionFunctionIndexCountError struct {
+	ReadError error
+	Offset    int
+}
+
+func (e InvalidElementSectionFunctionIndexCountError) Error() string {
+	return fmt.Sprintf(
+		"invalid function index count in element section at offset %d",
+		e.Offset,
+	)
+}
+
+func (e InvalidElementSectionFunctionIndexCountError) Unwrap() error {
+	return e.ReadError
+}
+
+// InvalidElementSectionFunctionIndexError is returned when the WASM binary specifies
+// an invalid function index in the element section
+type InvalidElementSectionFunctionIndexError struct {
+	ReadError error
+	Offset    int
+	Index     int
+}
+
+func (e InvalidElementSectionFunctionIndexError) Error() string {
+	return fmt.Sprintf(
+		"invalid function index %d in element section at offset %d",
+		e.Index,
+		e.Offset,
+	)
+}
+
+func (e InvalidElementSectionFunctionIndexError) Unwrap() error {
+	return e.ReadError
+}
+
+// InvalidTableSectionTableCountError is returned when the WASM binary specifies
+// an invalid count in the table section
+type InvalidTableSectionTableCountError struct {
+	ReadError error
+	Offset    int
+}
+
+func (e InvalidTableSectionTableCountError) Error() string {
+	return fmt.Sprintf(
+		"invalid tables count in table section at offset %d",
+		e.Offset,
+	)
+}
+
+func (e InvalidTableSectionTableCountError) Unwrap() error {
+	return e.ReadError
+}
+
+// InvalidTableError is returned when the WASM binary specifies
+// invalid table in the table section
+type InvalidTableError struct {
+	ReadError error
+	Index     int
+}
+
+func (e InvalidTableError) Error() string {
+	return fmt.Sprintf(
+		"invalid table at index %d",
+		e.Index,
+	)
+}
+
+func (e InvalidTableError) Unwrap() error {
+	return e.ReadError
+}
+
+// InvalidTableElementTypeError is returned when the WASM binary specifies
+// an invalid element type for a table in the table section
+type InvalidTableElementTypeError struct {
+	ReadError   error
+	Offset      int
+	ElementType byte
+}
+
+func (e InvalidTableElementTypeError) Error() string {
+	return fmt.Sprintf(
+		"invalid table element type in table section at offset %d: %x",
+		e.Offset,
+		e.ElementType,
+	)
+}
+
+func (e InvalidTableElementTypeError) Unwrap() error {
+	return e.ReadError
+}
+
 // InvalidMemorySectionMemoryCountError is returned when the WASM binary specifies
 // an invalid count in the memory section
 type InvalidMemorySectionMemoryCountError struct {
@@ -853,6 +1109,62 @@ func (e InvalidMemoryError) Unwrap() error {
 	return e.ReadError
 }
 
+// InvalidGlobalSectionGlobalCountError is returned when the WASM binary specifies
+// an invalid count in the global section
+type InvalidGlobalSectionGlobalCountError struct {
+	ReadError error
+	Offset    int
+}
+
+func (e InvalidGlobalSectionGlobalCountError) Error() string {
+	return fmt.Sprintf(
+		"invalid globals count in global section at offset %d",
+		e.Offset,
+	)
+}
+
+func (e InvalidGlobalSectionGlobalCountError) Unwrap() error {
+	return e.ReadError
+}
+
+// InvalidGlobalError is returned when the WASM binary specifies
+// an invalid global in the global section
+type InvalidGlobalError struct {
+	ReadError error
+	Index     int
+}
+
+func (e InvalidGlobalError) Error() string {
+	return fmt.Sprintf(
+		"invalid global at index %d",
+		e.Index,
+	)
+}
+
+func (e InvalidGlobalError) Unwrap() error {
+	return e.ReadError
+}
+
+// InvalidGlobalMutabilityError is returned when the WASM binary specifies
+// an invalid global mutability
+type InvalidGlobalMutabilityError struct {
+	ReadError  error
+	Offset     int
+	Mutability byte
+}
+
+func (e InvalidGlobalMutabilityError) Error() string {
+	return fmt.Sprintf(
+		"invalid global mutability at offset %d: %x",
+		e.Offset,
+		e.Mutability,
+	)
+}
+
+func (e InvalidGlobalMutabilityError) Unwrap() error {
+	return e.ReadError
+}
+
 // InvalidLimitIndicatorError is returned when the WASM binary specifies
 // an invalid limit indicator
 type InvalidLimitIndicatorError struct {
@@ -926,3 +1238,194 @@ func (e InvalidStartSectionFunctionIndexError) Error() string {
 func (e InvalidStartSectionFunctionIndexError) Unwrap() error {
 	return e.ReadError
 }
+
+// InvalidConstantExpressionInstructionError is returned when a constant expression
+// contains an instruction which is not allowed in a constant context
+type InvalidConstantExpressionInstructionError struct {
+	Instruction Instruction
+	Index       int
+}
+
+func (e InvalidConstantExpressionInstructionError) Error() string {
+	return fmt.Sprintf(
+		"invalid instruction '%s' at index %d in constant expression",
+		e.Instruction.name(),
+		e.Index,
+	)
+}
+
+// InvalidLEB128Error is returned when the WASM binary specifies
+// a LEB128-encoded integer which exceeds the maximum number of bytes
+// for the integer's width
+type InvalidLEB128Error struct {
+	Offset int
+	Max    int
+}
+
+func (e InvalidLEB128Error) Error() string {
+	return fmt.Sprintf(
+		"invalid LEB128 at offset %d: exceeds maximum length of %d bytes",
+		e.Offset,
+		e.Max,
+	)
+}
+
+// InvalidImportTableElementTypeError is returned when the WASM binary specifies
+// an invalid element type for a table import
+type InvalidImportTableElementTypeError struct {
+	ReadError   error
+	Offset      int
+	ElementType byte
+}
+
+func (e InvalidImportTableElementTypeError) Error() string {
+	return fmt.Sprintf(
+		"invalid table element type in import section at offset %d: %x",
+		e.Offset,
+		e.ElementType,
+	)
+}
+
+func (e InvalidImportTableElementTypeError) Unwrap() error {
+	return e.ReadError
+}
+
+// InstructionTrailingDataError is returned when decoding an encoded instruction
+// does not consume the whole encoding
+type InstructionTrailingDataError struct {
+	Offset int
+}
+
+func (e InstructionTrailingDataError) Error() string {
+	return fmt.Sprintf(
+		"trailing data after instruction at offset %d",
+		e.Offset,
+	)
+}
+
+// ModuleSizeLimitExceededError is returned when the WASM binary exceeds the module size limit
+type ModuleSizeLimitExceededError struct {
+	Size  int
+	Limit uint32
+}
+
+func (e ModuleSizeLimitExceededError) Error() string {
+	return fmt.Sprintf(
+		"module size %d exceeds limit of %d bytes",
+		e.Size,
+		e.Limit,
+	)
+}
+
+// InstructionLimitExceededError is returned when the WASM binary specifies
+// a count for a vector argument of an instruction which exceeds the instruction vector length limit
+type InstructionLimitExceededError struct {
+	Offset int
+	Count  uint32
+	Limit  uint32
+}
+
+func (e InstructionLimitExceededError) Error() string {
+	return fmt.Sprintf(
+		"vector count for argument of instruction at offset %d exceeds limit of %d: %d",
+		e.Offset,
+		e.Limit,
+		e.Count,
+	)
+}
+
+// SectionLimitExceededError is returned when the WASM binary specifies
+// a count for a vector in a section which exceeds the section vector length limit
+type SectionLimitExceededError struct {
+	Offset int
+	Count  uint32
+	Limit  uint32
+}
+
+func (e SectionLimitExceededError) Error() string {
+	return fmt.Sprintf(
+		"vector count in section at offset %d exceeds limit of %d: %d",
+		e.Offset,
+		e.Limit,
+		e.Count,
+	)
+}
+
+// InvalidLocalIndexError is returned when the WASM binary specifies
+// a local index in an instruction which exceeds the number of locals of the function,
+// including its parameters
+type InvalidLocalIndexError struct {
+	Index  uint32
+	Max    uint32
+	Offset int
+}
+
+func (e InvalidLocalIndexError) Error() string {
+	return fmt.Sprintf(
+		"invalid local index in instruction at offset %d: %d, function has %d locals",
+		e.Offset,
+		e.Index,
+		e.Max,
+	)
+}
+
+// InvalidGlobalIndexError is returned when the WASM binary specifies
+// a global index in an instruction which exceeds the number of globals of the module,
+// including imported globals
+type InvalidGlobalIndexError struct {
+	Index  uint32
+	Max    uint32
+	Offset int
+}
+
+func (e InvalidGlobalIndexError) Error() string {
+	return fmt.Sprintf(
+		"invalid global index in instruction at offset %d: %d, module has %d globals",
+		e.Offset,
+		e.Index,
+		e.Max,
+	)
+}
+
+// InvalidExportFunctionIndexError is returned when the writer is given
+// a function export which refers to a function that does not exist
+type InvalidExportFunctionIndexError struct {
+	Name  string
+	Index uint32
+}
+
+func (e InvalidExportFunctionIndexError) Error() string {
+	return fmt.Sprintf(
+		"invalid function index in export %q: %d",
+		e.Name,
+		e.Index,
+	)
+}
+
+// InvalidExportMemoryIndexError is returned when the writer is given
+// a memory export which refers to a memory that does not exist
+type InvalidExportMemoryIndexError struct {
+	Name  string
+	Index uint32
+}
+
+func (e InvalidExportMemoryIndexError) Error() string {
+	return fmt.Sprintf(
+		"invalid memory index in export %q: %d",
+		e.Name,
+		e.Index,
+	)
+}
+
+// InvalidStartFunctionIndexError is returned when the writer is given
+// a start function index which refers to a function that does not exist
+type InvalidStartFunctionIndexError struct {
+	Index uint32
+}
+
+func (e InvalidStartFunctionIndexError) Error() string {
+	return fmt.Sprintf(
+		"invalid start function index: %d",
+		e.Index,
+	)
+}
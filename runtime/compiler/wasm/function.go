@@ -30,3 +30,32 @@ type Code struct {
 	Locals       []ValueType
 	Instructions []Instruction
 }
+
+// maxLocalsCount is the maximum total number of locals of a function.
+// The limit is the same as in common engines, e.g. V8,
+// and prevents excessive allocations when reading compressed locals
+const maxLocalsCount = 50_000
+
+// localsRun is a run of consecutive locals with the same type,
+// as encoded in the code section of the WASM binary
+type localsRun struct {
+	Count uint32
+	Type  ValueType
+}
+
+// compressLocals groups consecutive locals of the same type into runs
+func compressLocals(locals []ValueType) []localsRun {
+	var runs []localsRun
+	for _, local := range locals {
+		lastIndex := len(runs) - 1
+		if lastIndex >= 0 && runs[lastIndex].Type == local {
+			runs[lastIndex].Count++
+			continue
+		}
+		runs = append(runs, localsRun{
+			Count: 1,
+			Type:  local,
+		})
+	}
+	return runs
+}
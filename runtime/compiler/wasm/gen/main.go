@@ -56,21 +56,27 @@ import (
 {{range .Instructions -}}
 // Instruction{{.Identifier}} is the '{{.Name}}' instruction
 //
-type Instruction{{.Identifier}} struct{{if .Arguments}} {
-{{- range .Arguments}}{{- if .Identifier}}
-	{{.Identifier}} {{.Type.FieldType}}{{end}}{{end}}
+type Instruction{{.Identifier}} struct{{if .Fields}} {
+{{- range .Fields}}
+	{{.Identifier}} {{.Type.FieldType}}{{end}}
 }
 {{- else}}{}{{- end}}
 
 func (Instruction{{.Identifier}}) isInstruction() {}
 
+func (Instruction{{.Identifier}}) name() string {
+	return "{{.Name}}"
+}
+
 func (i Instruction{{.Identifier}}) write(w *WASMWriter) error {
-	err := w.writeOpcode({{.OpcodeList}})
+	err := {{.WriteOpcode}}
 	if err != nil {
 		return err
 	}
 {{range .Arguments}}
+{{- if .Identifier}}
 	{{.Variable}} := i.{{.Identifier}}
+{{- end}}
 	{{.Type.Write .Variable}}
 {{end}}
 	return nil
@@ -81,7 +87,7 @@ func (i Instruction{{.Identifier}}) write(w *WASMWriter) error {
 const (
 {{- range .Instructions }}
 	// {{.OpcodeIdentifier}} is the opcode for the '{{.Name}}' instruction
-	{{.OpcodeIdentifier}} opcode = {{.Opcode | printf "0x%x"}}
+	{{.OpcodeIdentifier}} {{.OpcodeType}} = {{.Opcode | printf "0x%x"}}
 {{- end}}
 )
 
@@ -111,22 +117,35 @@ func (r *WASMReader) readInstruction() (Instruction, error) {
 `
 
 const switchTemplate = `
-switch c {
-{{- range $key, $group := . }}
-case {{ $key }}:
-{{- if (eq (len $group.Instructions) 1)}}
-{{- with (index $group.Instructions 0) }}
+{{- define "leaf"}}
+{{- with (index .Instructions 0) }}
 {{- range .Arguments}}
 	{{.Type.Read .Variable}}
 {{end}}
-	return Instruction{{.Identifier}}{{if .Arguments}}{
-{{- range .Arguments}}
+	return Instruction{{.Identifier}}{{if .Fields}}{
+{{- range .Fields}}
 		{{.Identifier}}: {{.Variable}},{{end}}
 	}
 {{- else}}{}{{- end}}, nil
 {{end}}
+{{- end}}
+switch c {
+{{- range $key, $group := . }}
+case {{ $key }}:
+{{- if $group.IsLeaf}}
+{{- template "leaf" $group}}
 {{- else}}
-{{switch $group}}
+	prefixed, err := r.buf.readUint32LEB128()
+	if err != nil {
+		return nil, InvalidPrefixedOpcodeError{
+			Offset:    int(opcodeOffset),
+			Prefix:    c,
+			ReadError: err,
+		}
+	}
+	p := prefixedOpcode(prefixed)
+
+{{prefixedSwitch $group}}
 {{- end}}{{end}}
 default:
 	return nil, InvalidOpcodeError{
@@ -137,6 +156,21 @@ default:
 }
 `
 
+const prefixedSwitchTemplate = `
+switch p {
+{{- range $key, $group := . }}
+case {{ $key }}:
+{{- template "leaf" $group}}
+{{- end}}
+default:
+	return nil, InvalidPrefixedOpcodeError{
+		Offset: int(opcodeOffset),
+		Prefix: c,
+		Opcode: p,
+	}
+}
+`
+
 type opcodes []byte
 
 type argumentType interface {
@@ -287,6 +321,19 @@ func (t ArgumentTypeVector) Read(variable string) string {
 		}
 	}
 
+	err = r.checkInstructionVectorCount(%[1]sCount, %[1]sCountOffset)
+	if err != nil {
+		return nil, err
+	}
+
+	// each element is encoded in at least one byte,
+	// so a count exceeding the remaining data is invalid
+	if %[1]sCount > r.buf.remaining() {
+		return nil, InvalidInstructionVectorArgumentCountError{
+			Offset: int(%[1]sCountOffset),
+		}
+	}
+
 	%[1]s := make(%[2]s, %[1]sCount)
 
 	for i := uint32(0); i < %[1]sCount; i++ {
@@ -322,12 +369,205 @@ func (t ArgumentTypeVector) Write(variable string) string {
 	)
 }
 
+// ArgumentTypeValueType is a value type, e.g. i32
+type ArgumentTypeValueType struct{}
+
+func (t ArgumentTypeValueType) isArgumentType() {}
+
+func (t ArgumentTypeValueType) FieldType() string {
+	return "ValueType"
+}
+
+func (t ArgumentTypeValueType) Read(variable string) string {
+	return fmt.Sprintf(
+		`%s, err := r.readValueTypeInstructionArgument()
+	if err != nil {
+		return nil, err
+	}`,
+		variable,
+	)
+}
+
+func (t ArgumentTypeValueType) Write(variable string) string {
+	return fmt.Sprintf(
+		`err = %s.write(w)
+	if err != nil {
+		return err
+	}`,
+		variable,
+	)
+}
+
+// ArgumentTypeValueTypeVector is a vector of value types,
+// e.g. the result types of a typed select instruction
+type ArgumentTypeValueTypeVector struct{}
+
+var valueTypeVector = ArgumentTypeVector{
+	ArgumentType: ArgumentTypeValueType{},
+}
+
+func (t ArgumentTypeValueTypeVector) isArgumentType() {}
+
+func (t ArgumentTypeValueTypeVector) FieldType() string {
+	return valueTypeVector.FieldType()
+}
+
+func (t ArgumentTypeValueTypeVector) Read(variable string) string {
+	return valueTypeVector.Read(variable)
+}
+
+func (t ArgumentTypeValueTypeVector) Write(variable string) string {
+	return valueTypeVector.Write(variable)
+}
+
+// ArgumentTypeByteVector is a vector of bytes with a fixed length,
+// e.g. the 16 bytes of a v128 constant
+type ArgumentTypeByteVector struct {
+	Length int
+}
+
+func (t ArgumentTypeByteVector) isArgumentType() {}
+
+func (t ArgumentTypeByteVector) FieldType() string {
+	return fmt.Sprintf("[%d]byte", t.Length)
+}
+
+func (t ArgumentTypeByteVector) Read(variable string) string {
+	return fmt.Sprintf(
+		`var %[1]s %[2]s
+	err = r.readBytesInstructionArgument(%[1]s[:])
+	if err != nil {
+		return nil, err
+	}`,
+		variable,
+		t.FieldType(),
+	)
+}
+
+func (t ArgumentTypeByteVector) Write(variable string) string {
+	return fmt.Sprintf(
+		`err = w.buf.WriteBytes(%s[:])
+	if err != nil {
+		return err
+	}`,
+		variable,
+	)
+}
+
+// ArgumentTypeLaneIndex is the lane index of a SIMD lane instruction,
+// which is encoded as a single byte
+type ArgumentTypeLaneIndex struct{}
+
+func (t ArgumentTypeLaneIndex) isArgumentType() {}
+
+func (t ArgumentTypeLaneIndex) FieldType() string {
+	return "byte"
+}
+
+func (t ArgumentTypeLaneIndex) Read(variable string) string {
+	return fmt.Sprintf(
+		`%s, err := r.readByteInstructionArgument()
+	if err != nil {
+		return nil, err
+	}`,
+		variable,
+	)
+}
+
+func (t ArgumentTypeLaneIndex) Write(variable string) string {
+	return fmt.Sprintf(
+		`err = w.buf.WriteByte(%s)
+	if err != nil {
+		return err
+	}`,
+		variable,
+	)
+}
+
+// ArgumentTypeLocalIndex is the index of a local of the current function,
+// which is validated against the number of locals of the function, if known
+type ArgumentTypeLocalIndex struct{}
+
+func (t ArgumentTypeLocalIndex) isArgumentType() {}
+
+func (t ArgumentTypeLocalIndex) FieldType() string {
+	return "uint32"
+}
+
+func (t ArgumentTypeLocalIndex) Read(variable string) string {
+	return fmt.Sprintf(
+		`%s, err := r.readLocalIndexInstructionArgument()
+	if err != nil {
+		return nil, err
+	}`,
+		variable,
+	)
+}
+
+func (t ArgumentTypeLocalIndex) Write(variable string) string {
+	return ArgumentTypeUint32{}.Write(variable)
+}
+
+// ArgumentTypeGlobalIndex is the index of a global of the module,
+// which is validated against the number of globals of the module, if known
+type ArgumentTypeGlobalIndex struct{}
+
+func (t ArgumentTypeGlobalIndex) isArgumentType() {}
+
+func (t ArgumentTypeGlobalIndex) FieldType() string {
+	return "uint32"
+}
+
+func (t ArgumentTypeGlobalIndex) Read(variable string) string {
+	return fmt.Sprintf(
+		`%s, err := r.readGlobalIndexInstructionArgument()
+	if err != nil {
+		return nil, err
+	}`,
+		variable,
+	)
+}
+
+func (t ArgumentTypeGlobalIndex) Write(variable string) string {
+	return ArgumentTypeUint32{}.Write(variable)
+}
+
+// ArgumentTypeReservedByte is a reserved byte, which must be zero,
+// e.g. the memory index of a bulk memory instruction.
+// It is not represented as a field of the instruction
+type ArgumentTypeReservedByte struct{}
+
+func (t ArgumentTypeReservedByte) isArgumentType() {}
+
+func (t ArgumentTypeReservedByte) FieldType() string {
+	return ""
+}
+
+func (t ArgumentTypeReservedByte) Read(_ string) string {
+	return `err = r.readReservedByteInstructionArgument()
+	if err != nil {
+		return nil, err
+	}`
+}
+
+func (t ArgumentTypeReservedByte) Write(_ string) string {
+	return `err = w.buf.WriteByte(0)
+	if err != nil {
+		return err
+	}`
+}
+
 type argument struct {
 	Type       argumentType
 	Identifier string
 }
 
 func (a argument) Variable() string {
+	// arguments without an identifier, e.g. reserved bytes,
+	// are not represented as fields, and have no variable
+	if a.Identifier == "" {
+		return ""
+	}
 	first := strings.ToLower(string(a.Identifier[0]))
 	rest := a.Identifier[1:]
 	return first + rest
@@ -341,6 +581,17 @@ type instruction struct {
 	Arguments arguments
 }
 
+// Fields returns the arguments which are represented as fields of the instruction
+func (ins instruction) Fields() arguments {
+	var fields arguments
+	for _, argument := range ins.Arguments {
+		if argument.Identifier != "" {
+			fields = append(fields, argument)
+		}
+	}
+	return fields
+}
+
 var identifierPartRegexp = regexp.MustCompile("(^|[._])[A-Za-z0-9]")
 
 func (ins instruction) Identifier() string {
@@ -348,60 +599,86 @@ func (ins instruction) Identifier() string {
 		return []byte(strings.ToUpper(string(bytes[len(bytes)-1])))
 	}))
 }
-func (ins instruction) OpcodeList() string {
-	var b strings.Builder
 
-	count := len(ins.Opcodes)
+// IsPrefixed returns true if the opcode of the instruction is preceded by a prefix byte.
+// The opcode following the prefix is a LEB128-encoded uint32
+func (ins instruction) IsPrefixed() bool {
+	return len(ins.Opcodes) > 1
+}
 
-	// prefix
-	for i := 0; i < count-1; i++ {
-		if i > 0 {
-			b.WriteString(", ")
-		}
-		opcode := ins.Opcodes[i]
-		_, err := fmt.Fprintf(&b, "0x%x", opcode)
-		if err != nil {
-			panic(err)
-		}
+// Opcode returns the opcode of the instruction.
+// For prefixed instructions, this is the decoded opcode following the prefix
+func (ins instruction) Opcode() uint32 {
+	if !ins.IsPrefixed() {
+		return uint32(ins.Opcodes[0])
 	}
 
-	// final opcode
-	if count > 1 {
-		b.WriteString(", ")
-	}
-	_, err := b.WriteString(ins.OpcodeIdentifier())
-	if err != nil {
-		panic(err)
+	var result uint32
+	var shift uint
+	for _, b := range ins.Opcodes[1:] {
+		result |= uint32(b&0x7f) << shift
+		shift += 7
 	}
-
-	return b.String()
+	return result
 }
 
-func (ins instruction) Opcode() byte {
-	return ins.Opcodes[len(ins.Opcodes)-1]
+func (ins instruction) OpcodeType() string {
+	if ins.IsPrefixed() {
+		return "prefixedOpcode"
+	}
+	return "opcode"
 }
 
 func (ins instruction) OpcodeIdentifier() string {
 	return fmt.Sprintf("opcode%s", ins.Identifier())
 }
 
+// WriteOpcode returns the code which writes the opcode of the instruction,
+// including the prefix, if any
+func (ins instruction) WriteOpcode() string {
+	if ins.IsPrefixed() {
+		return fmt.Sprintf(
+			"w.writePrefixedOpcode(0x%x, %s)",
+			ins.Opcodes[0],
+			ins.OpcodeIdentifier(),
+		)
+	}
+	return fmt.Sprintf("w.writeOpcode(%s)", ins.OpcodeIdentifier())
+}
+
+// opcodeDepth returns the number of switches needed to read the opcode of the instruction:
+// one for the opcode, and one more for the opcode following the prefix, if any
+func (ins instruction) opcodeDepth() int {
+	if ins.IsPrefixed() {
+		return 2
+	}
+	return 1
+}
+
 type instructionGroup struct {
 	Instructions []instruction
 	Depth        int
 }
 
+// IsLeaf returns true if the group consists of a single instruction,
+// and all opcodes of the instruction have been consumed
+func (group instructionGroup) IsLeaf() bool {
+	return len(group.Instructions) == 1 &&
+		group.Instructions[0].opcodeDepth() <= group.Depth
+}
+
 func (group instructionGroup) GroupByOpcode() map[string]instructionGroup {
 	result := map[string]instructionGroup{}
 
 	for _, ins := range group.Instructions {
 		innerDepth := group.Depth + 1
-		atEnd := len(ins.Opcodes) <= innerDepth
-		opcode := ins.Opcodes[group.Depth]
+		atEnd := ins.opcodeDepth() <= innerDepth
 		var key string
 		if atEnd {
 			key = ins.OpcodeIdentifier()
 		} else {
-			key = fmt.Sprintf("0x%x", opcode)
+			// the prefix
+			key = fmt.Sprintf("0x%x", ins.Opcodes[group.Depth])
 		}
 		innerGroup := result[key]
 		innerGroup.Depth = innerDepth
@@ -418,6 +695,26 @@ const target = "instructions.go"
 
 var indexArgumentType = ArgumentTypeUint32{}
 
+var laneIndexArgumentType = ArgumentTypeLaneIndex{}
+
+var localIndexArgumentType = ArgumentTypeLocalIndex{}
+
+var globalIndexArgumentType = ArgumentTypeGlobalIndex{}
+
+// simdPrefix is the prefix byte of all SIMD instructions.
+//
+// The SIMD opcode following the prefix is a LEB128-encoded uint32,
+// so opcodes larger than 0x7f are encoded as multiple bytes
+const simdPrefix = 0xFD
+
+// miscPrefix is the prefix byte of miscellaneous instructions,
+// e.g. the bulk memory instructions.
+//
+// Like for SIMD instructions, the opcode following the prefix is a LEB128-encoded uint32
+const miscPrefix = 0xFC
+
+var reservedByteArgumentType = ArgumentTypeReservedByte{}
+
 func main() {
 
 	f, err := os.Create(target)
@@ -428,7 +725,16 @@ func main() {
 		_ = f.Close()
 	}()
 
-	var generateSwitch func(group instructionGroup) (string, error)
+	var generateSwitch func(switchTemplate *template.Template, group instructionGroup) (string, error)
+
+	var parsedSwitchTemplate, parsedPrefixedSwitchTemplate *template.Template
+
+	indentSwitch := func(group instructionGroup, res string) string {
+		pad := strings.Repeat("\t", group.Depth+1)
+		padded := pad + strings.ReplaceAll(res, "\n", "\n"+pad)
+		trimmed := trailingWhitespaceRegexp.ReplaceAll([]byte(padded), nil)
+		return string(trimmed)
+	}
 
 	templateFuncs := map[string]any{
 		"goGenerateComment": func() string {
@@ -437,32 +743,44 @@ func main() {
 			return "//go:generate go run ./gen/main.go\n//go:generate go fmt $GOFILE"
 		},
 		"switch": func(group instructionGroup) (string, error) {
-			res, err := generateSwitch(group)
+			res, err := generateSwitch(parsedSwitchTemplate, group)
+			if err != nil {
+				return "", err
+			}
+			return indentSwitch(group, res), nil
+		},
+		"prefixedSwitch": func(group instructionGroup) (string, error) {
+			res, err := generateSwitch(parsedPrefixedSwitchTemplate, group)
 			if err != nil {
 				return "", err
 			}
-			pad := strings.Repeat("\t", group.Depth+1)
-			padded := pad + strings.ReplaceAll(res, "\n", "\n"+pad)
-			trimmed := trailingWhitespaceRegexp.ReplaceAll([]byte(padded), nil)
-			return string(trimmed), nil
+			return indentSwitch(group, res), nil
 		},
 	}
 
-	parsedSwitchTemplate := template.Must(
+	parsedSwitchTemplate = template.Must(
 		template.New("switch").
 			Funcs(templateFuncs).
 			Parse(switchTemplate),
 	)
 
+	// The prefixed switch template is associated with the switch template,
+	// so it can use the templates defined in it
+	parsedPrefixedSwitchTemplate = template.Must(
+		parsedSwitchTemplate.
+			New("prefixedSwitch").
+			Parse(prefixedSwitchTemplate),
+	)
+
 	parsedFileTemplate := template.Must(
 		template.New("instructions").
 			Funcs(templateFuncs).
 			Parse(fileTemplate),
 	)
 
-	generateSwitch = func(instructions instructionGroup) (string, error) {
+	generateSwitch = func(switchTemplate *template.Template, instructions instructionGroup) (string, error) {
 		var b strings.Builder
-		err := parsedSwitchTemplate.Execute(&b, instructions.GroupByOpcode())
+		err := switchTemplate.Execute(&b, instructions.GroupByOpcode())
 		if err != nil {
 			return "", err
 		}
@@ -592,40 +910,63 @@ func main() {
 			Opcodes:   opcodes{0x1B},
 			Arguments: arguments{},
 		},
+		// the typed variant of select, i.e. `select t*`
+		{
+			Name:    "select_t",
+			Opcodes: opcodes{0x1C},
+			Arguments: arguments{
+				{Identifier: "ResultTypes", Type: ArgumentTypeValueTypeVector{}},
+			},
+		},
 		// Variable Instructions
 		{
 			Name:    "local.get",
 			Opcodes: opcodes{0x20},
 			Arguments: arguments{
-				{Identifier: "LocalIndex", Type: indexArgumentType},
+				{Identifier: "LocalIndex", Type: localIndexArgumentType},
 			},
 		},
 		{
 			Name:    "local.set",
 			Opcodes: opcodes{0x21},
 			Arguments: arguments{
-				{Identifier: "LocalIndex", Type: indexArgumentType},
+				{Identifier: "LocalIndex", Type: localIndexArgumentType},
 			},
 		},
 		{
 			Name:    "local.tee",
 			Opcodes: opcodes{0x22},
 			Arguments: arguments{
-				{Identifier: "LocalIndex", Type: indexArgumentType},
+				{Identifier: "LocalIndex", Type: localIndexArgumentType},
 			},
 		},
 		{
 			Name:    "global.get",
 			Opcodes: opcodes{0x23},
 			Arguments: arguments{
-				{Identifier: "GlobalIndex", Type: indexArgumentType},
+				{Identifier: "GlobalIndex", Type: globalIndexArgumentType},
 			},
 		},
 		{
 			Name:    "global.set",
 			Opcodes: opcodes{0x24},
 			Arguments: arguments{
-				{Identifier: "GlobalIndex", Type: indexArgumentType},
+				{Identifier: "GlobalIndex", Type: globalIndexArgumentType},
+			},
+		},
+		// Table Instructions
+		{
+			Name:    "table.get",
+			Opcodes: opcodes{0x25},
+			Arguments: arguments{
+				{Identifier: "TableIndex", Type: indexArgumentType},
+			},
+		},
+		{
+			Name:    "table.set",
+			Opcodes: opcodes{0x26},
+			Arguments: arguments{
+				{Identifier: "TableIndex", Type: indexArgumentType},
 			},
 		},
 		// Numeric Instructions
@@ -955,5 +1296,203 @@ func main() {
 			Opcodes:   opcodes{0xad},
 			Arguments: arguments{},
 		},
+		// Vector Instructions
+		{
+			Name:    "v128.const",
+			Opcodes: opcodes{simdPrefix, 0x0c},
+			Arguments: arguments{
+				{Identifier: "Value", Type: ArgumentTypeByteVector{Length: 16}},
+			},
+		},
+		// lane instructions are followed by the lane index
+		{
+			Name:    "i8x16.extract_lane_s",
+			Opcodes: opcodes{simdPrefix, 0x15},
+			Arguments: arguments{
+				{Identifier: "LaneIndex", Type: laneIndexArgumentType},
+			},
+		},
+		{
+			Name:    "i8x16.extract_lane_u",
+			Opcodes: opcodes{simdPrefix, 0x16},
+			Arguments: arguments{
+				{Identifier: "LaneIndex", Type: laneIndexArgumentType},
+			},
+		},
+		{
+			Name:    "i8x16.replace_lane",
+			Opcodes: opcodes{simdPrefix, 0x17},
+			Arguments: arguments{
+				{Identifier: "LaneIndex", Type: laneIndexArgumentType},
+			},
+		},
+		{
+			Name:    "i16x8.extract_lane_s",
+			Opcodes: opcodes{simdPrefix, 0x18},
+			Arguments: arguments{
+				{Identifier: "LaneIndex", Type: laneIndexArgumentType},
+			},
+		},
+		{
+			Name:    "i16x8.extract_lane_u",
+			Opcodes: opcodes{simdPrefix, 0x19},
+			Arguments: arguments{
+				{Identifier: "LaneIndex", Type: laneIndexArgumentType},
+			},
+		},
+		{
+			Name:    "i16x8.replace_lane",
+			Opcodes: opcodes{simdPrefix, 0x1a},
+			Arguments: arguments{
+				{Identifier: "LaneIndex", Type: laneIndexArgumentType},
+			},
+		},
+		{
+			Name:    "i32x4.extract_lane",
+			Opcodes: opcodes{simdPrefix, 0x1b},
+			Arguments: arguments{
+				{Identifier: "LaneIndex", Type: laneIndexArgumentType},
+			},
+		},
+		{
+			Name:    "i32x4.replace_lane",
+			Opcodes: opcodes{simdPrefix, 0x1c},
+			Arguments: arguments{
+				{Identifier: "LaneIndex", Type: laneIndexArgumentType},
+			},
+		},
+		{
+			Name:    "i64x2.extract_lane",
+			Opcodes: opcodes{simdPrefix, 0x1d},
+			Arguments: arguments{
+				{Identifier: "LaneIndex", Type: laneIndexArgumentType},
+			},
+		},
+		{
+			Name:    "i64x2.replace_lane",
+			Opcodes: opcodes{simdPrefix, 0x1e},
+			Arguments: arguments{
+				{Identifier: "LaneIndex", Type: laneIndexArgumentType},
+			},
+		},
+		{
+			Name:    "f32x4.extract_lane",
+			Opcodes: opcodes{simdPrefix, 0x1f},
+			Arguments: arguments{
+				{Identifier: "LaneIndex", Type: laneIndexArgumentType},
+			},
+		},
+		{
+			Name:    "f32x4.replace_lane",
+			Opcodes: opcodes{simdPrefix, 0x20},
+			Arguments: arguments{
+				{Identifier: "LaneIndex", Type: laneIndexArgumentType},
+			},
+		},
+		{
+			Name:    "f64x2.extract_lane",
+			Opcodes: opcodes{simdPrefix, 0x21},
+			Arguments: arguments{
+				{Identifier: "LaneIndex", Type: laneIndexArgumentType},
+			},
+		},
+		{
+			Name:    "f64x2.replace_lane",
+			Opcodes: opcodes{simdPrefix, 0x22},
+			Arguments: arguments{
+				{Identifier: "LaneIndex", Type: laneIndexArgumentType},
+			},
+		},
+		// opcode 174 (0xae), LEB128-encoded
+		{
+			Name:      "i32x4.add",
+			Opcodes:   opcodes{simdPrefix, 0xae, 0x01},
+			Arguments: arguments{},
+		},
+		// opcode 230 (0xe6), LEB128-encoded
+		{
+			Name:      "f32x4.mul",
+			Opcodes:   opcodes{simdPrefix, 0xe6, 0x01},
+			Arguments: arguments{},
+		},
+		// Bulk Memory Instructions
+		{
+			Name:    "memory.init",
+			Opcodes: opcodes{miscPrefix, 0x08},
+			Arguments: arguments{
+				{Identifier: "DataIndex", Type: indexArgumentType},
+				// memory index
+				{Type: reservedByteArgumentType},
+			},
+		},
+		{
+			Name:    "data.drop",
+			Opcodes: opcodes{miscPrefix, 0x09},
+			Arguments: arguments{
+				{Identifier: "DataIndex", Type: indexArgumentType},
+			},
+		},
+		{
+			Name:    "memory.copy",
+			Opcodes: opcodes{miscPrefix, 0x0a},
+			Arguments: arguments{
+				// destination and source memory indices
+				{Type: reservedByteArgumentType},
+				{Type: reservedByteArgumentType},
+			},
+		},
+		{
+			Name:    "memory.fill",
+			Opcodes: opcodes{miscPrefix, 0x0b},
+			Arguments: arguments{
+				// memory index
+				{Type: reservedByteArgumentType},
+			},
+		},
+		// Table Instructions
+		{
+			Name:    "table.init",
+			Opcodes: opcodes{miscPrefix, 0x0c},
+			Arguments: arguments{
+				{Identifier: "ElementIndex", Type: indexArgumentType},
+				{Identifier: "TableIndex", Type: indexArgumentType},
+			},
+		},
+		{
+			Name:    "elem.drop",
+			Opcodes: opcodes{miscPrefix, 0x0d},
+			Arguments: arguments{
+				{Identifier: "ElementIndex", Type: indexArgumentType},
+			},
+		},
+		{
+			Name:    "table.copy",
+			Opcodes: opcodes{miscPrefix, 0x0e},
+			Arguments: arguments{
+				{Identifier: "DestinationTableIndex", Type: indexArgumentType},
+				{Identifier: "SourceTableIndex", Type: indexArgumentType},
+			},
+		},
+		{
+			Name:    "table.grow",
+			Opcodes: opcodes{miscPrefix, 0x0f},
+			Arguments: arguments{
+				{Identifier: "TableIndex", Type: indexArgumentType},
+			},
+		},
+		{
+			Name:    "table.size",
+			Opcodes: opcodes{miscPrefix, 0x10},
+			Arguments: arguments{
+				{Identifier: "TableIndex", Type: indexArgumentType},
+			},
+		},
+		{
+			Name:    "table.fill",
+			Opcodes: opcodes{miscPrefix, 0x11},
+			Arguments: arguments{
+				{Identifier: "TableIndex", Type: indexArgumentType},
+			},
+		},
 	})
 }
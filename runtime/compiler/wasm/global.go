@@ -0,0 +1,38 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package wasm
+
+// Global represents a global variable
+type Global struct {
+	// must be constant, as defined in the spec
+	// (https://webassembly.github.io/spec/core/valid/instructions.html#constant-expressions)
+	Init    []Instruction
+	Type    ValueType
+	Mutable bool
+}
+
+// globalMutability is the byte used to indicate the mutability of a global in the WASM binary
+type globalMutability byte
+
+const (
+	// globalMutabilityConst is the byte used to indicate an immutable global in the WASM binary
+	globalMutabilityConst globalMutability = 0x0
+	// globalMutabilityVar is the byte used to indicate a mutable global in the WASM binary
+	globalMutabilityVar globalMutability = 0x1
+)
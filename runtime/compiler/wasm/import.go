@@ -22,10 +22,9 @@ import "fmt"
 
 // Import represents an import
 type Import struct {
-	Module string
-	Name   string
-	// TODO: add support for tables, memories, and globals. adjust name section!
-	TypeIndex uint32
+	Descriptor ImportDescriptor
+	Module     string
+	Name       string
 }
 
 func (imp Import) FullName() string {
@@ -38,4 +37,80 @@ type importIndicator byte
 const (
 	// importIndicatorFunction is the byte used to indicate the import of a function in the WASM binary
 	importIndicatorFunction importIndicator = 0x0
+	// importIndicatorTable is the byte used to indicate the import of a table in the WASM binary
+	importIndicatorTable importIndicator = 0x1
+	// importIndicatorMemory is the byte used to indicate the import of a memory in the WASM binary
+	importIndicatorMemory importIndicator = 0x2
+	// importIndicatorGlobal is the byte used to indicate the import of a global in the WASM binary
+	importIndicatorGlobal importIndicator = 0x3
 )
+
+// ImportDescriptor represents an import (e.g. a function, table, memory, etc.)
+type ImportDescriptor interface {
+	isImportDescriptor()
+}
+
+// FunctionImport represents the import of a function
+type FunctionImport struct {
+	TypeIndex uint32
+}
+
+func (FunctionImport) isImportDescriptor() {}
+
+// TableImport represents the import of a table
+type TableImport struct {
+	Max         *uint32
+	Min         uint32
+	ElementType ValueType
+}
+
+func (TableImport) isImportDescriptor() {}
+
+// MemoryImport represents the import of a memory
+type MemoryImport struct {
+	Max *uint32
+	Min uint32
+}
+
+func (MemoryImport) isImportDescriptor() {}
+
+// GlobalImport represents the import of a global
+type GlobalImport struct {
+	Type    ValueType
+	Mutable bool
+}
+
+func (GlobalImport) isImportDescriptor() {}
+
+// functionImportCount returns the number of function imports in the given imports
+func functionImportCount(imports []*Import) int {
+	var count int
+	for _, imp := range imports {
+		if _, ok := imp.Descriptor.(FunctionImport); ok {
+			count++
+		}
+	}
+	return count
+}
+
+// memoryImportCount returns the number of memory imports in the given imports
+func memoryImportCount(imports []*Import) int {
+	var count int
+	for _, imp := range imports {
+		if _, ok := imp.Descriptor.(MemoryImport); ok {
+			count++
+		}
+	}
+	return count
+}
+
+// globalImportCount returns the number of global imports in the given imports
+func globalImportCount(imports []*Import) int {
+	var count int
+	for _, imp := range imports {
+		if _, ok := imp.Descriptor.(GlobalImport); ok {
+			count++
+		}
+	}
+	return count
+}
@@ -21,5 +21,35 @@ package wasm
 // Instruction represents an instruction in the code of a WASM binary
 type Instruction interface {
 	isInstruction()
+	name() string
 	write(*WASMWriter) error
 }
+
+// RoundTrip encodes the given instruction and decodes it again.
+// It returns an error if the instruction cannot be encoded or decoded,
+// or if decoding does not consume the whole encoding
+func RoundTrip(instruction Instruction) (Instruction, error) {
+	var buf Buffer
+
+	w := NewWASMWriter(&buf)
+	err := instruction.write(w)
+	if err != nil {
+		return nil, err
+	}
+
+	buf.offset = 0
+
+	r := NewWASMReader(&buf)
+	result, err := r.readInstruction()
+	if err != nil {
+		return nil, err
+	}
+
+	if buf.offset != offset(len(buf.data)) {
+		return nil, InstructionTrailingDataError{
+			Offset: int(buf.offset),
+		}
+	}
+
+	return result, nil
+}
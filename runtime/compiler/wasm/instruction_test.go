@@ -0,0 +1,118 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package wasm
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+var roundTripSeedInstructions = []Instruction{
+	InstructionBrTable{
+		LabelIndices:      []uint32{0, 1, math.MaxUint32},
+		DefaultLabelIndex: 2,
+	},
+	InstructionIf{
+		Block: Block{
+			BlockType: ValueTypeI32,
+			Instructions1: []Instruction{
+				InstructionI32Const{Value: 1},
+			},
+			Instructions2: []Instruction{
+				InstructionI32Const{Value: 2},
+			},
+		},
+	},
+	InstructionBlock{
+		Block: Block{
+			BlockType: TypeIndexBlockType{TypeIndex: math.MaxUint32},
+			Instructions1: []Instruction{
+				InstructionLoop{
+					Block: Block{
+						Instructions1: []Instruction{
+							InstructionBrIf{LabelIndex: 1},
+						},
+					},
+				},
+			},
+		},
+	},
+	InstructionSelectT{
+		ResultTypes: []ValueType{ValueTypeI32, ValueTypeI64},
+	},
+	InstructionI32Const{Value: math.MinInt32},
+	InstructionI32Const{Value: math.MaxInt32},
+	InstructionI64Const{Value: math.MinInt64},
+	InstructionI64Const{Value: math.MaxInt64},
+	InstructionV128Const{Value: [16]byte{0x0, 0xff, 0x80, 0x7f}},
+	InstructionI8x16ExtractLaneS{LaneIndex: 15},
+	InstructionMemoryInit{DataIndex: math.MaxUint32},
+	InstructionMemoryCopy{},
+	InstructionTableInit{ElementIndex: 1, TableIndex: math.MaxUint32},
+}
+
+func TestRoundTrip(t *testing.T) {
+
+	t.Parallel()
+
+	for _, instruction := range roundTripSeedInstructions {
+
+		instruction := instruction
+
+		t.Run(instruction.name(), func(t *testing.T) {
+
+			t.Parallel()
+
+			result, err := RoundTrip(instruction)
+			require.NoError(t, err)
+			require.Equal(t, instruction, result)
+		})
+	}
+}
+
+func FuzzRoundTrip(f *testing.F) {
+
+	for _, instruction := range roundTripSeedInstructions {
+		var buf Buffer
+		err := instruction.write(NewWASMWriter(&buf))
+		require.NoError(f, err)
+		f.Add(buf.data)
+	}
+
+	// br_table with a label count that exceeds the data
+	f.Add([]byte{byte(opcodeBrTable), 0xff, 0xff, 0xff, 0xff, 0x0f})
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+
+		// decode an arbitrary valid instruction from the fuzzed data,
+		// and ensure it survives being encoded and decoded again
+
+		buf := Buffer{data: data}
+		instruction, err := NewWASMReader(&buf).readInstruction()
+		if err != nil {
+			t.Skip()
+		}
+
+		result, err := RoundTrip(instruction)
+		require.NoError(t, err)
+		require.Equal(t, instruction, result)
+	})
+}
@@ -31,6 +31,10 @@ type InstructionUnreachable struct{}
 
 func (InstructionUnreachable) isInstruction() {}
 
+func (InstructionUnreachable) name() string {
+	return "unreachable"
+}
+
 func (i InstructionUnreachable) write(w *WASMWriter) error {
 	err := w.writeOpcode(opcodeUnreachable)
 	if err != nil {
@@ -45,6 +49,10 @@ type InstructionNop struct{}
 
 func (InstructionNop) isInstruction() {}
 
+func (InstructionNop) name() string {
+	return "nop"
+}
+
 func (i InstructionNop) write(w *WASMWriter) error {
 	err := w.writeOpcode(opcodeNop)
 	if err != nil {
@@ -61,6 +69,10 @@ type InstructionBlock struct {
 
 func (InstructionBlock) isInstruction() {}
 
+func (InstructionBlock) name() string {
+	return "block"
+}
+
 func (i InstructionBlock) write(w *WASMWriter) error {
 	err := w.writeOpcode(opcodeBlock)
 	if err != nil {
@@ -83,6 +95,10 @@ type InstructionLoop struct {
 
 func (InstructionLoop) isInstruction() {}
 
+func (InstructionLoop) name() string {
+	return "loop"
+}
+
 func (i InstructionLoop) write(w *WASMWriter) error {
 	err := w.writeOpcode(opcodeLoop)
 	if err != nil {
@@ -105,6 +121,10 @@ type InstructionIf struct {
 
 func (InstructionIf) isInstruction() {}
 
+func (InstructionIf) name() string {
+	return "if"
+}
+
 func (i InstructionIf) write(w *WASMWriter) error {
 	err := w.writeOpcode(opcodeIf)
 	if err != nil {
@@ -125,6 +145,10 @@ type InstructionEnd struct{}
 
 func (InstructionEnd) isInstruction() {}
 
+func (InstructionEnd) name() string {
+	return "end"
+}
+
 func (i InstructionEnd) write(w *WASMWriter) error {
 	err := w.writeOpcode(opcodeEnd)
 	if err != nil {
@@ -141,6 +165,10 @@ type InstructionBr struct {
 
 func (InstructionBr) isInstruction() {}
 
+func (InstructionBr) name() string {
+	return "br"
+}
+
 func (i InstructionBr) write(w *WASMWriter) error {
 	err := w.writeOpcode(opcodeBr)
 	if err != nil {
@@ -163,6 +191,10 @@ type InstructionBrIf struct {
 
 func (InstructionBrIf) isInstruction() {}
 
+func (InstructionBrIf) name() string {
+	return "br_if"
+}
+
 func (i InstructionBrIf) write(w *WASMWriter) error {
 	err := w.writeOpcode(opcodeBrIf)
 	if err != nil {
@@ -186,6 +218,10 @@ type InstructionBrTable struct {
 
 func (InstructionBrTable) isInstruction() {}
 
+func (InstructionBrTable) name() string {
+	return "br_table"
+}
+
 func (i InstructionBrTable) write(w *WASMWriter) error {
 	err := w.writeOpcode(opcodeBrTable)
 	if err != nil {
@@ -221,6 +257,10 @@ type InstructionReturn struct{}
 
 func (InstructionReturn) isInstruction() {}
 
+func (InstructionReturn) name() string {
+	return "return"
+}
+
 func (i InstructionReturn) write(w *WASMWriter) error {
 	err := w.writeOpcode(opcodeReturn)
 	if err != nil {
@@ -237,6 +277,10 @@ type InstructionCall struct {
 
 func (InstructionCall) isInstruction() {}
 
+func (InstructionCall) name() string {
+	return "call"
+}
+
 func (i InstructionCall) write(w *WASMWriter) error {
 	err := w.writeOpcode(opcodeCall)
 	if err != nil {
@@ -260,6 +304,10 @@ type InstructionCallIndirect struct {
 
 func (InstructionCallIndirect) isInstruction() {}
 
+func (InstructionCallIndirect) name() string {
+	return "call_indirect"
+}
+
 func (i InstructionCallIndirect) write(w *WASMWriter) error {
 	err := w.writeOpcode(opcodeCallIndirect)
 	if err != nil {
@@ -288,6 +336,10 @@ type InstructionRefNull struct {
 
 func (InstructionRefNull) isInstruction() {}
 
+func (InstructionRefNull) name() string {
+	return "ref.null"
+}
+
 func (i InstructionRefNull) write(w *WASMWriter) error {
 	err := w.writeOpcode(opcodeRefNull)
 	if err != nil {
@@ -308,6 +360,10 @@ type InstructionRefIsNull struct{}
 
 func (InstructionRefIsNull) isInstruction() {}
 
+func (InstructionRefIsNull) name() string {
+	return "ref.is_null"
+}
+
 func (i InstructionRefIsNull) write(w *WASMWriter) error {
 	err := w.writeOpcode(opcodeRefIsNull)
 	if err != nil {
@@ -324,6 +380,10 @@ type InstructionRefFunc struct {
 
 func (InstructionRefFunc) isInstruction() {}
 
+func (InstructionRefFunc) name() string {
+	return "ref.func"
+}
+
 func (i InstructionRefFunc) write(w *WASMWriter) error {
 	err := w.writeOpcode(opcodeRefFunc)
 	if err != nil {
@@ -344,6 +404,10 @@ type InstructionDrop struct{}
 
 func (InstructionDrop) isInstruction() {}
 
+func (InstructionDrop) name() string {
+	return "drop"
+}
+
 func (i InstructionDrop) write(w *WASMWriter) error {
 	err := w.writeOpcode(opcodeDrop)
 	if err != nil {
@@ -358,6 +422,10 @@ type InstructionSelect struct{}
 
 func (InstructionSelect) isInstruction() {}
 
+func (InstructionSelect) name() string {
+	return "select"
+}
+
 func (i InstructionSelect) write(w *WASMWriter) error {
 	err := w.writeOpcode(opcodeSelect)
 	if err != nil {
@@ -367,6 +435,41 @@ func (i InstructionSelect) write(w *WASMWriter) error {
 	return nil
 }
 
+// InstructionSelectT is the 'select_t' instruction
+type InstructionSelectT struct {
+	ResultTypes []ValueType
+}
+
+func (InstructionSelectT) isInstruction() {}
+
+func (InstructionSelectT) name() string {
+	return "select_t"
+}
+
+func (i InstructionSelectT) write(w *WASMWriter) error {
+	err := w.writeOpcode(opcodeSelectT)
+	if err != nil {
+		return err
+	}
+
+	resultTypes := i.ResultTypes
+	resultTypesCount := len(resultTypes)
+	err = w.buf.writeUint32LEB128(uint32(resultTypesCount))
+	if err != nil {
+		return err
+	}
+
+	for i := 0; i < resultTypesCount; i++ {
+		resultTypesElement := resultTypes[i]
+		err = resultTypesElement.write(w)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // InstructionLocalGet is the 'local.get' instruction
 type InstructionLocalGet struct {
 	LocalIndex uint32
@@ -374,6 +477,10 @@ type InstructionLocalGet struct {
 
 func (InstructionLocalGet) isInstruction() {}
 
+func (InstructionLocalGet) name() string {
+	return "local.get"
+}
+
 func (i InstructionLocalGet) write(w *WASMWriter) error {
 	err := w.writeOpcode(opcodeLocalGet)
 	if err != nil {
@@ -396,6 +503,10 @@ type InstructionLocalSet struct {
 
 func (InstructionLocalSet) isInstruction() {}
 
+func (InstructionLocalSet) name() string {
+	return "local.set"
+}
+
 func (i InstructionLocalSet) write(w *WASMWriter) error {
 	err := w.writeOpcode(opcodeLocalSet)
 	if err != nil {
@@ -418,6 +529,10 @@ type InstructionLocalTee struct {
 
 func (InstructionLocalTee) isInstruction() {}
 
+func (InstructionLocalTee) name() string {
+	return "local.tee"
+}
+
 func (i InstructionLocalTee) write(w *WASMWriter) error {
 	err := w.writeOpcode(opcodeLocalTee)
 	if err != nil {
@@ -440,6 +555,10 @@ type InstructionGlobalGet struct {
 
 func (InstructionGlobalGet) isInstruction() {}
 
+func (InstructionGlobalGet) name() string {
+	return "global.get"
+}
+
 func (i InstructionGlobalGet) write(w *WASMWriter) error {
 	err := w.writeOpcode(opcodeGlobalGet)
 	if err != nil {
@@ -462,6 +581,10 @@ type InstructionGlobalSet struct {
 
 func (InstructionGlobalSet) isInstruction() {}
 
+func (InstructionGlobalSet) name() string {
+	return "global.set"
+}
+
 func (i InstructionGlobalSet) write(w *WASMWriter) error {
 	err := w.writeOpcode(opcodeGlobalSet)
 	if err != nil {
@@ -477,6 +600,58 @@ func (i InstructionGlobalSet) write(w *WASMWriter) error {
 	return nil
 }
 
+// InstructionTableGet is the 'table.get' instruction
+type InstructionTableGet struct {
+	TableIndex uint32
+}
+
+func (InstructionTableGet) isInstruction() {}
+
+func (InstructionTableGet) name() string {
+	return "table.get"
+}
+
+func (i InstructionTableGet) write(w *WASMWriter) error {
+	err := w.writeOpcode(opcodeTableGet)
+	if err != nil {
+		return err
+	}
+
+	tableIndex := i.TableIndex
+	err = w.buf.writeUint32LEB128(tableIndex)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// InstructionTableSet is the 'table.set' instruction
+type InstructionTableSet struct {
+	TableIndex uint32
+}
+
+func (InstructionTableSet) isInstruction() {}
+
+func (InstructionTableSet) name() string {
+	return "table.set"
+}
+
+func (i InstructionTableSet) write(w *WASMWriter) error {
+	err := w.writeOpcode(opcodeTableSet)
+	if err != nil {
+		return err
+	}
+
+	tableIndex := i.TableIndex
+	err = w.buf.writeUint32LEB128(tableIndex)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // InstructionI32Const is the 'i32.const' instruction
 type InstructionI32Const struct {
 	Value int32
@@ -484,6 +659,10 @@ type InstructionI32Const struct {
 
 func (InstructionI32Const) isInstruction() {}
 
+func (InstructionI32Const) name() string {
+	return "i32.const"
+}
+
 func (i InstructionI32Const) write(w *WASMWriter) error {
 	err := w.writeOpcode(opcodeI32Const)
 	if err != nil {
@@ -506,6 +685,10 @@ type InstructionI64Const struct {
 
 func (InstructionI64Const) isInstruction() {}
 
+func (InstructionI64Const) name() string {
+	return "i64.const"
+}
+
 func (i InstructionI64Const) write(w *WASMWriter) error {
 	err := w.writeOpcode(opcodeI64Const)
 	if err != nil {
@@ -526,6 +709,10 @@ type InstructionI32Eqz struct{}
 
 func (InstructionI32Eqz) isInstruction() {}
 
+func (InstructionI32Eqz) name() string {
+	return "i32.eqz"
+}
+
 func (i InstructionI32Eqz) write(w *WASMWriter) error {
 	err := w.writeOpcode(opcodeI32Eqz)
 	if err != nil {
@@ -540,6 +727,10 @@ type InstructionI32Eq struct{}
 
 func (InstructionI32Eq) isInstruction() {}
 
+func (InstructionI32Eq) name() string {
+	return "i32.eq"
+}
+
 func (i InstructionI32Eq) write(w *WASMWriter) error {
 	err := w.writeOpcode(opcodeI32Eq)
 	if err != nil {
@@ -554,6 +745,10 @@ type InstructionI32Ne struct{}
 
 func (InstructionI32Ne) isInstruction() {}
 
+func (InstructionI32Ne) name() string {
+	return "i32.ne"
+}
+
 func (i InstructionI32Ne) write(w *WASMWriter) error {
 	err := w.writeOpcode(opcodeI32Ne)
 	if err != nil {
@@ -568,6 +763,10 @@ type InstructionI32LtS struct{}
 
 func (InstructionI32LtS) isInstruction() {}
 
+func (InstructionI32LtS) name() string {
+	return "i32.lt_s"
+}
+
 func (i InstructionI32LtS) write(w *WASMWriter) error {
 	err := w.writeOpcode(opcodeI32LtS)
 	if err != nil {
@@ -582,6 +781,10 @@ type InstructionI32LtU struct{}
 
 func (InstructionI32LtU) isInstruction() {}
 
+func (InstructionI32LtU) name() string {
+	return "i32.lt_u"
+}
+
 func (i InstructionI32LtU) write(w *WASMWriter) error {
 	err := w.writeOpcode(opcodeI32LtU)
 	if err != nil {
@@ -596,6 +799,10 @@ type InstructionI32GtS struct{}
 
 func (InstructionI32GtS) isInstruction() {}
 
+func (InstructionI32GtS) name() string {
+	return "i32.gt_s"
+}
+
 func (i InstructionI32GtS) write(w *WASMWriter) error {
 	err := w.writeOpcode(opcodeI32GtS)
 	if err != nil {
@@ -610,6 +817,10 @@ type InstructionI32GtU struct{}
 
 func (InstructionI32GtU) isInstruction() {}
 
+func (InstructionI32GtU) name() string {
+	return "i32.gt_u"
+}
+
 func (i InstructionI32GtU) write(w *WASMWriter) error {
 	err := w.writeOpcode(opcodeI32GtU)
 	if err != nil {
@@ -624,6 +835,10 @@ type InstructionI32LeS struct{}
 
 func (InstructionI32LeS) isInstruction() {}
 
+func (InstructionI32LeS) name() string {
+	return "i32.le_s"
+}
+
 func (i InstructionI32LeS) write(w *WASMWriter) error {
 	err := w.writeOpcode(opcodeI32LeS)
 	if err != nil {
@@ -638,6 +853,10 @@ type InstructionI32LeU struct{}
 
 func (InstructionI32LeU) isInstruction() {}
 
+func (InstructionI32LeU) name() string {
+	return "i32.le_u"
+}
+
 func (i InstructionI32LeU) write(w *WASMWriter) error {
 	err := w.writeOpcode(opcodeI32LeU)
 	if err != nil {
@@ -652,6 +871,10 @@ type InstructionI32GeS struct{}
 
 func (InstructionI32GeS) isInstruction() {}
 
+func (InstructionI32GeS) name() string {
+	return "i32.ge_s"
+}
+
 func (i InstructionI32GeS) write(w *WASMWriter) error {
 	err := w.writeOpcode(opcodeI32GeS)
 	if err != nil {
@@ -666,6 +889,10 @@ type InstructionI32GeU struct{}
 
 func (InstructionI32GeU) isInstruction() {}
 
+func (InstructionI32GeU) name() string {
+	return "i32.ge_u"
+}
+
 func (i InstructionI32GeU) write(w *WASMWriter) error {
 	err := w.writeOpcode(opcodeI32GeU)
 	if err != nil {
@@ -680,6 +907,10 @@ type InstructionI64Eqz struct{}
 
 func (InstructionI64Eqz) isInstruction() {}
 
+func (InstructionI64Eqz) name() string {
+	return "i64.eqz"
+}
+
 func (i InstructionI64Eqz) write(w *WASMWriter) error {
 	err := w.writeOpcode(opcodeI64Eqz)
 	if err != nil {
@@ -694,6 +925,10 @@ type InstructionI64Eq struct{}
 
 func (InstructionI64Eq) isInstruction() {}
 
+func (InstructionI64Eq) name() string {
+	return "i64.eq"
+}
+
 func (i InstructionI64Eq) write(w *WASMWriter) error {
 	err := w.writeOpcode(opcodeI64Eq)
 	if err != nil {
@@ -708,6 +943,10 @@ type InstructionI64Ne struct{}
 
 func (InstructionI64Ne) isInstruction() {}
 
+func (InstructionI64Ne) name() string {
+	return "i64.ne"
+}
+
 func (i InstructionI64Ne) write(w *WASMWriter) error {
 	err := w.writeOpcode(opcodeI64Ne)
 	if err != nil {
@@ -722,6 +961,10 @@ type InstructionI64LtS struct{}
 
 func (InstructionI64LtS) isInstruction() {}
 
+func (InstructionI64LtS) name() string {
+	return "i64.lt_s"
+}
+
 func (i InstructionI64LtS) write(w *WASMWriter) error {
 	err := w.writeOpcode(opcodeI64LtS)
 	if err != nil {
@@ -736,6 +979,10 @@ type InstructionI64LtU struct{}
 
 func (InstructionI64LtU) isInstruction() {}
 
+func (InstructionI64LtU) name() string {
+	return "i64.lt_u"
+}
+
 func (i InstructionI64LtU) write(w *WASMWriter) error {
 	err := w.writeOpcode(opcodeI64LtU)
 	if err != nil {
@@ -750,6 +997,10 @@ type InstructionI64GtS struct{}
 
 func (InstructionI64GtS) isInstruction() {}
 
+func (InstructionI64GtS) name() string {
+	return "i64.gt_s"
+}
+
 func (i InstructionI64GtS) write(w *WASMWriter) error {
 	err := w.writeOpcode(opcodeI64GtS)
 	if err != nil {
@@ -764,6 +1015,10 @@ type InstructionI64GtU struct{}
 
 func (InstructionI64GtU) isInstruction() {}
 
+func (InstructionI64GtU) name() string {
+	return "i64.gt_u"
+}
+
 func (i InstructionI64GtU) write(w *WASMWriter) error {
 	err := w.writeOpcode(opcodeI64GtU)
 	if err != nil {
@@ -778,6 +1033,10 @@ type InstructionI64LeS struct{}
 
 func (InstructionI64LeS) isInstruction() {}
 
+func (InstructionI64LeS) name() string {
+	return "i64.le_s"
+}
+
 func (i InstructionI64LeS) write(w *WASMWriter) error {
 	err := w.writeOpcode(opcodeI64LeS)
 	if err != nil {
@@ -792,6 +1051,10 @@ type InstructionI64LeU struct{}
 
 func (InstructionI64LeU) isInstruction() {}
 
+func (InstructionI64LeU) name() string {
+	return "i64.le_u"
+}
+
 func (i InstructionI64LeU) write(w *WASMWriter) error {
 	err := w.writeOpcode(opcodeI64LeU)
 	if err != nil {
@@ -806,6 +1069,10 @@ type InstructionI64GeS struct{}
 
 func (InstructionI64GeS) isInstruction() {}
 
+func (InstructionI64GeS) name() string {
+	return "i64.ge_s"
+}
+
 func (i InstructionI64GeS) write(w *WASMWriter) error {
 	err := w.writeOpcode(opcodeI64GeS)
 	if err != nil {
@@ -820,6 +1087,10 @@ type InstructionI64GeU struct{}
 
 func (InstructionI64GeU) isInstruction() {}
 
+func (InstructionI64GeU) name() string {
+	return "i64.ge_u"
+}
+
 func (i InstructionI64GeU) write(w *WASMWriter) error {
 	err := w.writeOpcode(opcodeI64GeU)
 	if err != nil {
@@ -834,6 +1105,10 @@ type InstructionI32Clz struct{}
 
 func (InstructionI32Clz) isInstruction() {}
 
+func (InstructionI32Clz) name() string {
+	return "i32.clz"
+}
+
 func (i InstructionI32Clz) write(w *WASMWriter) error {
 	err := w.writeOpcode(opcodeI32Clz)
 	if err != nil {
@@ -848,6 +1123,10 @@ type InstructionI32Ctz struct{}
 
 func (InstructionI32Ctz) isInstruction() {}
 
+func (InstructionI32Ctz) name() string {
+	return "i32.ctz"
+}
+
 func (i InstructionI32Ctz) write(w *WASMWriter) error {
 	err := w.writeOpcode(opcodeI32Ctz)
 	if err != nil {
@@ -862,6 +1141,10 @@ type InstructionI32Popcnt struct{}
 
 func (InstructionI32Popcnt) isInstruction() {}
 
+func (InstructionI32Popcnt) name() string {
+	return "i32.popcnt"
+}
+
 func (i InstructionI32Popcnt) write(w *WASMWriter) error {
 	err := w.writeOpcode(opcodeI32Popcnt)
 	if err != nil {
@@ -876,6 +1159,10 @@ type InstructionI32Add struct{}
 
 func (InstructionI32Add) isInstruction() {}
 
+func (InstructionI32Add) name() string {
+	return "i32.add"
+}
+
 func (i InstructionI32Add) write(w *WASMWriter) error {
 	err := w.writeOpcode(opcodeI32Add)
 	if err != nil {
@@ -890,6 +1177,10 @@ type InstructionI32Sub struct{}
 
 func (InstructionI32Sub) isInstruction() {}
 
+func (InstructionI32Sub) name() string {
+	return "i32.sub"
+}
+
 func (i InstructionI32Sub) write(w *WASMWriter) error {
 	err := w.writeOpcode(opcodeI32Sub)
 	if err != nil {
@@ -904,6 +1195,10 @@ type InstructionI32Mul struct{}
 
 func (InstructionI32Mul) isInstruction() {}
 
+func (InstructionI32Mul) name() string {
+	return "i32.mul"
+}
+
 func (i InstructionI32Mul) write(w *WASMWriter) error {
 	err := w.writeOpcode(opcodeI32Mul)
 	if err != nil {
@@ -918,6 +1213,10 @@ type InstructionI32DivS struct{}
 
 func (InstructionI32DivS) isInstruction() {}
 
+func (InstructionI32DivS) name() string {
+	return "i32.div_s"
+}
+
 func (i InstructionI32DivS) write(w *WASMWriter) error {
 	err := w.writeOpcode(opcodeI32DivS)
 	if err != nil {
@@ -932,6 +1231,10 @@ type InstructionI32DivU struct{}
 
 func (InstructionI32DivU) isInstruction() {}
 
+func (InstructionI32DivU) name() string {
+	return "i32.div_u"
+}
+
 func (i InstructionI32DivU) write(w *WASMWriter) error {
 	err := w.writeOpcode(opcodeI32DivU)
 	if err != nil {
@@ -946,6 +1249,10 @@ type InstructionI32RemS struct{}
 
 func (InstructionI32RemS) isInstruction() {}
 
+func (InstructionI32RemS) name() string {
+	return "i32.rem_s"
+}
+
 func (i InstructionI32RemS) write(w *WASMWriter) error {
 	err := w.writeOpcode(opcodeI32RemS)
 	if err != nil {
@@ -960,6 +1267,10 @@ type InstructionI32RemU struct{}
 
 func (InstructionI32RemU) isInstruction() {}
 
+func (InstructionI32RemU) name() string {
+	return "i32.rem_u"
+}
+
 func (i InstructionI32RemU) write(w *WASMWriter) error {
 	err := w.writeOpcode(opcodeI32RemU)
 	if err != nil {
@@ -974,6 +1285,10 @@ type InstructionI32And struct{}
 
 func (InstructionI32And) isInstruction() {}
 
+func (InstructionI32And) name() string {
+	return "i32.and"
+}
+
 func (i InstructionI32And) write(w *WASMWriter) error {
 	err := w.writeOpcode(opcodeI32And)
 	if err != nil {
@@ -988,6 +1303,10 @@ type InstructionI32Or struct{}
 
 func (InstructionI32Or) isInstruction() {}
 
+func (InstructionI32Or) name() string {
+	return "i32.or"
+}
+
 func (i InstructionI32Or) write(w *WASMWriter) error {
 	err := w.writeOpcode(opcodeI32Or)
 	if err != nil {
@@ -1002,6 +1321,10 @@ type InstructionI32Xor struct{}
 
 func (InstructionI32Xor) isInstruction() {}
 
+func (InstructionI32Xor) name() string {
+	return "i32.xor"
+}
+
 func (i InstructionI32Xor) write(w *WASMWriter) error {
 	err := w.writeOpcode(opcodeI32Xor)
 	if err != nil {
@@ -1016,6 +1339,10 @@ type InstructionI32Shl struct{}
 
 func (InstructionI32Shl) isInstruction() {}
 
+func (InstructionI32Shl) name() string {
+	return "i32.shl"
+}
+
 func (i InstructionI32Shl) write(w *WASMWriter) error {
 	err := w.writeOpcode(opcodeI32Shl)
 	if err != nil {
@@ -1030,6 +1357,10 @@ type InstructionI32ShrS struct{}
 
 func (InstructionI32ShrS) isInstruction() {}
 
+func (InstructionI32ShrS) name() string {
+	return "i32.shr_s"
+}
+
 func (i InstructionI32ShrS) write(w *WASMWriter) error {
 	err := w.writeOpcode(opcodeI32ShrS)
 	if err != nil {
@@ -1044,6 +1375,10 @@ type InstructionI32ShrU struct{}
 
 func (InstructionI32ShrU) isInstruction() {}
 
+func (InstructionI32ShrU) name() string {
+	return "i32.shr_u"
+}
+
 func (i InstructionI32ShrU) write(w *WASMWriter) error {
 	err := w.writeOpcode(opcodeI32ShrU)
 	if err != nil {
@@ -1058,6 +1393,10 @@ type InstructionI32Rotl struct{}
 
 func (InstructionI32Rotl) isInstruction() {}
 
+func (InstructionI32Rotl) name() string {
+	return "i32.rotl"
+}
+
 func (i InstructionI32Rotl) write(w *WASMWriter) error {
 	err := w.writeOpcode(opcodeI32Rotl)
 	if err != nil {
@@ -1072,6 +1411,10 @@ type InstructionI32Rotr struct{}
 
 func (InstructionI32Rotr) isInstruction() {}
 
+func (InstructionI32Rotr) name() string {
+	return "i32.rotr"
+}
+
 func (i InstructionI32Rotr) write(w *WASMWriter) error {
 	err := w.writeOpcode(opcodeI32Rotr)
 	if err != nil {
@@ -1086,6 +1429,10 @@ type InstructionI64Clz struct{}
 
 func (InstructionI64Clz) isInstruction() {}
 
+func (InstructionI64Clz) name() string {
+	return "i64.clz"
+}
+
 func (i InstructionI64Clz) write(w *WASMWriter) error {
 	err := w.writeOpcode(opcodeI64Clz)
 	if err != nil {
@@ -1100,6 +1447,10 @@ type InstructionI64Ctz struct{}
 
 func (InstructionI64Ctz) isInstruction() {}
 
+func (InstructionI64Ctz) name() string {
+	return "i64.ctz"
+}
+
 func (i InstructionI64Ctz) write(w *WASMWriter) error {
 	err := w.writeOpcode(opcodeI64Ctz)
 	if err != nil {
@@ -1114,6 +1465,10 @@ type InstructionI64Popcnt struct{}
 
 func (InstructionI64Popcnt) isInstruction() {}
 
+func (InstructionI64Popcnt) name() string {
+	return "i64.popcnt"
+}
+
 func (i InstructionI64Popcnt) write(w *WASMWriter) error {
 	err := w.writeOpcode(opcodeI64Popcnt)
 	if err != nil {
@@ -1128,6 +1483,10 @@ type InstructionI64Add struct{}
 
 func (InstructionI64Add) isInstruction() {}
 
+func (InstructionI64Add) name() string {
+	return "i64.add"
+}
+
 func (i InstructionI64Add) write(w *WASMWriter) error {
 	err := w.writeOpcode(opcodeI64Add)
 	if err != nil {
@@ -1142,6 +1501,10 @@ type InstructionI64Sub struct{}
 
 func (InstructionI64Sub) isInstruction() {}
 
+func (InstructionI64Sub) name() string {
+	return "i64.sub"
+}
+
 func (i InstructionI64Sub) write(w *WASMWriter) error {
 	err := w.writeOpcode(opcodeI64Sub)
 	if err != nil {
@@ -1156,6 +1519,10 @@ type InstructionI64Mul struct{}
 
 func (InstructionI64Mul) isInstruction() {}
 
+func (InstructionI64Mul) name() string {
+	return "i64.mul"
+}
+
 func (i InstructionI64Mul) write(w *WASMWriter) error {
 	err := w.writeOpcode(opcodeI64Mul)
 	if err != nil {
@@ -1170,6 +1537,10 @@ type InstructionI64DivS struct{}
 
 func (InstructionI64DivS) isInstruction() {}
 
+func (InstructionI64DivS) name() string {
+	return "i64.div_s"
+}
+
 func (i InstructionI64DivS) write(w *WASMWriter) error {
 	err := w.writeOpcode(opcodeI64DivS)
 	if err != nil {
@@ -1184,6 +1555,10 @@ type InstructionI64DivU struct{}
 
 func (InstructionI64DivU) isInstruction() {}
 
+func (InstructionI64DivU) name() string {
+	return "i64.div_u"
+}
+
 func (i InstructionI64DivU) write(w *WASMWriter) error {
 	err := w.writeOpcode(opcodeI64DivU)
 	if err != nil {
@@ -1198,6 +1573,10 @@ type InstructionI64RemS struct{}
 
 func (InstructionI64RemS) isInstruction() {}
 
+func (InstructionI64RemS) name() string {
+	return "i64.rem_s"
+}
+
 func (i InstructionI64RemS) write(w *WASMWriter) error {
 	err := w.writeOpcode(opcodeI64RemS)
 	if err != nil {
@@ -1212,6 +1591,10 @@ type InstructionI64RemU struct{}
 
 func (InstructionI64RemU) isInstruction() {}
 
+func (InstructionI64RemU) name() string {
+	return "i64.rem_u"
+}
+
 func (i InstructionI64RemU) write(w *WASMWriter) error {
 	err := w.writeOpcode(opcodeI64RemU)
 	if err != nil {
@@ -1226,6 +1609,10 @@ type InstructionI64And struct{}
 
 func (InstructionI64And) isInstruction() {}
 
+func (InstructionI64And) name() string {
+	return "i64.and"
+}
+
 func (i InstructionI64And) write(w *WASMWriter) error {
 	err := w.writeOpcode(opcodeI64And)
 	if err != nil {
@@ -1240,6 +1627,10 @@ type InstructionI64Or struct{}
 
 func (InstructionI64Or) isInstruction() {}
 
+func (InstructionI64Or) name() string {
+	return "i64.or"
+}
+
 func (i InstructionI64Or) write(w *WASMWriter) error {
 	err := w.writeOpcode(opcodeI64Or)
 	if err != nil {
@@ -1254,6 +1645,10 @@ type InstructionI64Xor struct{}
 
 func (InstructionI64Xor) isInstruction() {}
 
+func (InstructionI64Xor) name() string {
+	return "i64.xor"
+}
+
 func (i InstructionI64Xor) write(w *WASMWriter) error {
 	err := w.writeOpcode(opcodeI64Xor)
 	if err != nil {
@@ -1268,6 +1663,10 @@ type InstructionI64Shl struct{}
 
 func (InstructionI64Shl) isInstruction() {}
 
+func (InstructionI64Shl) name() string {
+	return "i64.shl"
+}
+
 func (i InstructionI64Shl) write(w *WASMWriter) error {
 	err := w.writeOpcode(opcodeI64Shl)
 	if err != nil {
@@ -1282,6 +1681,10 @@ type InstructionI64ShrS struct{}
 
 func (InstructionI64ShrS) isInstruction() {}
 
+func (InstructionI64ShrS) name() string {
+	return "i64.shr_s"
+}
+
 func (i InstructionI64ShrS) write(w *WASMWriter) error {
 	err := w.writeOpcode(opcodeI64ShrS)
 	if err != nil {
@@ -1296,6 +1699,10 @@ type InstructionI64ShrU struct{}
 
 func (InstructionI64ShrU) isInstruction() {}
 
+func (InstructionI64ShrU) name() string {
+	return "i64.shr_u"
+}
+
 func (i InstructionI64ShrU) write(w *WASMWriter) error {
 	err := w.writeOpcode(opcodeI64ShrU)
 	if err != nil {
@@ -1310,6 +1717,10 @@ type InstructionI64Rotl struct{}
 
 func (InstructionI64Rotl) isInstruction() {}
 
+func (InstructionI64Rotl) name() string {
+	return "i64.rotl"
+}
+
 func (i InstructionI64Rotl) write(w *WASMWriter) error {
 	err := w.writeOpcode(opcodeI64Rotl)
 	if err != nil {
@@ -1324,6 +1735,10 @@ type InstructionI64Rotr struct{}
 
 func (InstructionI64Rotr) isInstruction() {}
 
+func (InstructionI64Rotr) name() string {
+	return "i64.rotr"
+}
+
 func (i InstructionI64Rotr) write(w *WASMWriter) error {
 	err := w.writeOpcode(opcodeI64Rotr)
 	if err != nil {
@@ -1338,6 +1753,10 @@ type InstructionI32WrapI64 struct{}
 
 func (InstructionI32WrapI64) isInstruction() {}
 
+func (InstructionI32WrapI64) name() string {
+	return "i32.wrap_i64"
+}
+
 func (i InstructionI32WrapI64) write(w *WASMWriter) error {
 	err := w.writeOpcode(opcodeI32WrapI64)
 	if err != nil {
@@ -1352,6 +1771,10 @@ type InstructionI64ExtendI32S struct{}
 
 func (InstructionI64ExtendI32S) isInstruction() {}
 
+func (InstructionI64ExtendI32S) name() string {
+	return "i64.extend_i32_s"
+}
+
 func (i InstructionI64ExtendI32S) write(w *WASMWriter) error {
 	err := w.writeOpcode(opcodeI64ExtendI32S)
 	if err != nil {
@@ -1366,6 +1789,10 @@ type InstructionI64ExtendI32U struct{}
 
 func (InstructionI64ExtendI32U) isInstruction() {}
 
+func (InstructionI64ExtendI32U) name() string {
+	return "i64.extend_i32_u"
+}
+
 func (i InstructionI64ExtendI32U) write(w *WASMWriter) error {
 	err := w.writeOpcode(opcodeI64ExtendI32U)
 	if err != nil {
@@ -1375,33 +1802,737 @@ func (i InstructionI64ExtendI32U) write(w *WASMWriter) error {
 	return nil
 }
 
-const (
-	// opcodeUnreachable is the opcode for the 'unreachable' instruction
-	opcodeUnreachable opcode = 0x0
-	// opcodeNop is the opcode for the 'nop' instruction
-	opcodeNop opcode = 0x1
-	// opcodeBlock is the opcode for the 'block' instruction
-	opcodeBlock opcode = 0x2
-	// opcodeLoop is the opcode for the 'loop' instruction
-	opcodeLoop opcode = 0x3
-	// opcodeIf is the opcode for the 'if' instruction
-	opcodeIf opcode = 0x4
-	// opcodeEnd is the opcode for the 'end' instruction
-	opcodeEnd opcode = 0xb
-	// opcodeBr is the opcode for the 'br' instruction
-	opcodeBr opcode = 0xc
-	// opcodeBrIf is the opcode for the 'br_if' instruction
-	opcodeBrIf opcode = 0xd
-	// opcodeBrTable is the opcode for the 'br_table' instruction
-	opcodeBrTable opcode = 0xe
-	// opcodeReturn is the opcode for the 'return' instruction
-	opcodeReturn opcode = 0xf
-	// opcodeCall is the opcode for the 'call' instruction
-	opcodeCall opcode = 0x10
-	// opcodeCallIndirect is the opcode for the 'call_indirect' instruction
-	opcodeCallIndirect opcode = 0x11
-	// opcodeRefNull is the opcode for the 'ref.null' instruction
-	opcodeRefNull opcode = 0xd0
+// InstructionV128Const is the 'v128.const' instruction
+type InstructionV128Const struct {
+	Value [16]byte
+}
+
+func (InstructionV128Const) isInstruction() {}
+
+func (InstructionV128Const) name() string {
+	return "v128.const"
+}
+
+func (i InstructionV128Const) write(w *WASMWriter) error {
+	err := w.writePrefixedOpcode(0xfd, opcodeV128Const)
+	if err != nil {
+		return err
+	}
+
+	value := i.Value
+	err = w.buf.WriteBytes(value[:])
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// InstructionI8x16ExtractLaneS is the 'i8x16.extract_lane_s' instruction
+type InstructionI8x16ExtractLaneS struct {
+	LaneIndex byte
+}
+
+func (InstructionI8x16ExtractLaneS) isInstruction() {}
+
+func (InstructionI8x16ExtractLaneS) name() string {
+	return "i8x16.extract_lane_s"
+}
+
+func (i InstructionI8x16ExtractLaneS) write(w *WASMWriter) error {
+	err := w.writePrefixedOpcode(0xfd, opcodeI8x16ExtractLaneS)
+	if err != nil {
+		return err
+	}
+
+	laneIndex := i.LaneIndex
+	err = w.buf.WriteByte(laneIndex)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// InstructionI8x16ExtractLaneU is the 'i8x16.extract_lane_u' instruction
+type InstructionI8x16ExtractLaneU struct {
+	LaneIndex byte
+}
+
+func (InstructionI8x16ExtractLaneU) isInstruction() {}
+
+func (InstructionI8x16ExtractLaneU) name() string {
+	return "i8x16.extract_lane_u"
+}
+
+func (i InstructionI8x16ExtractLaneU) write(w *WASMWriter) error {
+	err := w.writePrefixedOpcode(0xfd, opcodeI8x16ExtractLaneU)
+	if err != nil {
+		return err
+	}
+
+	laneIndex := i.LaneIndex
+	err = w.buf.WriteByte(laneIndex)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// InstructionI8x16ReplaceLane is the 'i8x16.replace_lane' instruction
+type InstructionI8x16ReplaceLane struct {
+	LaneIndex byte
+}
+
+func (InstructionI8x16ReplaceLane) isInstruction() {}
+
+func (InstructionI8x16ReplaceLane) name() string {
+	return "i8x16.replace_lane"
+}
+
+func (i InstructionI8x16ReplaceLane) write(w *WASMWriter) error {
+	err := w.writePrefixedOpcode(0xfd, opcodeI8x16ReplaceLane)
+	if err != nil {
+		return err
+	}
+
+	laneIndex := i.LaneIndex
+	err = w.buf.WriteByte(laneIndex)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// InstructionI16x8ExtractLaneS is the 'i16x8.extract_lane_s' instruction
+type InstructionI16x8ExtractLaneS struct {
+	LaneIndex byte
+}
+
+func (InstructionI16x8ExtractLaneS) isInstruction() {}
+
+func (InstructionI16x8ExtractLaneS) name() string {
+	return "i16x8.extract_lane_s"
+}
+
+func (i InstructionI16x8ExtractLaneS) write(w *WASMWriter) error {
+	err := w.writePrefixedOpcode(0xfd, opcodeI16x8ExtractLaneS)
+	if err != nil {
+		return err
+	}
+
+	laneIndex := i.LaneIndex
+	err = w.buf.WriteByte(laneIndex)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// InstructionI16x8ExtractLaneU is the 'i16x8.extract_lane_u' instruction
+type InstructionI16x8ExtractLaneU struct {
+	LaneIndex byte
+}
+
+func (InstructionI16x8ExtractLaneU) isInstruction() {}
+
+func (InstructionI16x8ExtractLaneU) name() string {
+	return "i16x8.extract_lane_u"
+}
+
+func (i InstructionI16x8ExtractLaneU) write(w *WASMWriter) error {
+	err := w.writePrefixedOpcode(0xfd, opcodeI16x8ExtractLaneU)
+	if err != nil {
+		return err
+	}
+
+	laneIndex := i.LaneIndex
+	err = w.buf.WriteByte(laneIndex)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// InstructionI16x8ReplaceLane is the 'i16x8.replace_lane' instruction
+type InstructionI16x8ReplaceLane struct {
+	LaneIndex byte
+}
+
+func (InstructionI16x8ReplaceLane) isInstruction() {}
+
+func (InstructionI16x8ReplaceLane) name() string {
+	return "i16x8.replace_lane"
+}
+
+func (i InstructionI16x8ReplaceLane) write(w *WASMWriter) error {
+	err := w.writePrefixedOpcode(0xfd, opcodeI16x8ReplaceLane)
+	if err != nil {
+		return err
+	}
+
+	laneIndex := i.LaneIndex
+	err = w.buf.WriteByte(laneIndex)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// InstructionI32x4ExtractLane is the 'i32x4.extract_lane' instruction
+type InstructionI32x4ExtractLane struct {
+	LaneIndex byte
+}
+
+func (InstructionI32x4ExtractLane) isInstruction() {}
+
+func (InstructionI32x4ExtractLane) name() string {
+	return "i32x4.extract_lane"
+}
+
+func (i InstructionI32x4ExtractLane) write(w *WASMWriter) error {
+	err := w.writePrefixedOpcode(0xfd, opcodeI32x4ExtractLane)
+	if err != nil {
+		return err
+	}
+
+	laneIndex := i.LaneIndex
+	err = w.buf.WriteByte(laneIndex)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// InstructionI32x4ReplaceLane is the 'i32x4.replace_lane' instruction
+type InstructionI32x4ReplaceLane struct {
+	LaneIndex byte
+}
+
+func (InstructionI32x4ReplaceLane) isInstruction() {}
+
+func (InstructionI32x4ReplaceLane) name() string {
+	return "i32x4.replace_lane"
+}
+
+func (i InstructionI32x4ReplaceLane) write(w *WASMWriter) error {
+	err := w.writePrefixedOpcode(0xfd, opcodeI32x4ReplaceLane)
+	if err != nil {
+		return err
+	}
+
+	laneIndex := i.LaneIndex
+	err = w.buf.WriteByte(laneIndex)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// InstructionI64x2ExtractLane is the 'i64x2.extract_lane' instruction
+type InstructionI64x2ExtractLane struct {
+	LaneIndex byte
+}
+
+func (InstructionI64x2ExtractLane) isInstruction() {}
+
+func (InstructionI64x2ExtractLane) name() string {
+	return "i64x2.extract_lane"
+}
+
+func (i InstructionI64x2ExtractLane) write(w *WASMWriter) error {
+	err := w.writePrefixedOpcode(0xfd, opcodeI64x2ExtractLane)
+	if err != nil {
+		return err
+	}
+
+	laneIndex := i.LaneIndex
+	err = w.buf.WriteByte(laneIndex)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// InstructionI64x2ReplaceLane is the 'i64x2.replace_lane' instruction
+type InstructionI64x2ReplaceLane struct {
+	LaneIndex byte
+}
+
+func (InstructionI64x2ReplaceLane) isInstruction() {}
+
+func (InstructionI64x2ReplaceLane) name() string {
+	return "i64x2.replace_lane"
+}
+
+func (i InstructionI64x2ReplaceLane) write(w *WASMWriter) error {
+	err := w.writePrefixedOpcode(0xfd, opcodeI64x2ReplaceLane)
+	if err != nil {
+		return err
+	}
+
+	laneIndex := i.LaneIndex
+	err = w.buf.WriteByte(laneIndex)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// InstructionF32x4ExtractLane is the 'f32x4.extract_lane' instruction
+type InstructionF32x4ExtractLane struct {
+	LaneIndex byte
+}
+
+func (InstructionF32x4ExtractLane) isInstruction() {}
+
+func (InstructionF32x4ExtractLane) name() string {
+	return "f32x4.extract_lane"
+}
+
+func (i InstructionF32x4ExtractLane) write(w *WASMWriter) error {
+	err := w.writePrefixedOpcode(0xfd, opcodeF32x4ExtractLane)
+	if err != nil {
+		return err
+	}
+
+	laneIndex := i.LaneIndex
+	err = w.buf.WriteByte(laneIndex)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// InstructionF32x4ReplaceLane is the 'f32x4.replace_lane' instruction
+type InstructionF32x4ReplaceLane struct {
+	LaneIndex byte
+}
+
+func (InstructionF32x4ReplaceLane) isInstruction() {}
+
+func (InstructionF32x4ReplaceLane) name() string {
+	return "f32x4.replace_lane"
+}
+
+func (i InstructionF32x4ReplaceLane) write(w *WASMWriter) error {
+	err := w.writePrefixedOpcode(0xfd, opcodeF32x4ReplaceLane)
+	if err != nil {
+		return err
+	}
+
+	laneIndex := i.LaneIndex
+	err = w.buf.WriteByte(laneIndex)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// InstructionF64x2ExtractLane is the 'f64x2.extract_lane' instruction
+type InstructionF64x2ExtractLane struct {
+	LaneIndex byte
+}
+
+func (InstructionF64x2ExtractLane) isInstruction() {}
+
+func (InstructionF64x2ExtractLane) name() string {
+	return "f64x2.extract_lane"
+}
+
+func (i InstructionF64x2ExtractLane) write(w *WASMWriter) error {
+	err := w.writePrefixedOpcode(0xfd, opcodeF64x2ExtractLane)
+	if err != nil {
+		return err
+	}
+
+	laneIndex := i.LaneIndex
+	err = w.buf.WriteByte(laneIndex)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// InstructionF64x2ReplaceLane is the 'f64x2.replace_lane' instruction
+type InstructionF64x2ReplaceLane struct {
+	LaneIndex byte
+}
+
+func (InstructionF64x2ReplaceLane) isInstruction() {}
+
+func (InstructionF64x2ReplaceLane) name() string {
+	return "f64x2.replace_lane"
+}
+
+func (i InstructionF64x2ReplaceLane) write(w *WASMWriter) error {
+	err := w.writePrefixedOpcode(0xfd, opcodeF64x2ReplaceLane)
+	if err != nil {
+		return err
+	}
+
+	laneIndex := i.LaneIndex
+	err = w.buf.WriteByte(laneIndex)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// InstructionI32x4Add is the 'i32x4.add' instruction
+type InstructionI32x4Add struct{}
+
+func (InstructionI32x4Add) isInstruction() {}
+
+func (InstructionI32x4Add) name() string {
+	return "i32x4.add"
+}
+
+func (i InstructionI32x4Add) write(w *WASMWriter) error {
+	err := w.writePrefixedOpcode(0xfd, opcodeI32x4Add)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// InstructionF32x4Mul is the 'f32x4.mul' instruction
+type InstructionF32x4Mul struct{}
+
+func (InstructionF32x4Mul) isInstruction() {}
+
+func (InstructionF32x4Mul) name() string {
+	return "f32x4.mul"
+}
+
+func (i InstructionF32x4Mul) write(w *WASMWriter) error {
+	err := w.writePrefixedOpcode(0xfd, opcodeF32x4Mul)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// InstructionMemoryInit is the 'memory.init' instruction
+type InstructionMemoryInit struct {
+	DataIndex uint32
+}
+
+func (InstructionMemoryInit) isInstruction() {}
+
+func (InstructionMemoryInit) name() string {
+	return "memory.init"
+}
+
+func (i InstructionMemoryInit) write(w *WASMWriter) error {
+	err := w.writePrefixedOpcode(0xfc, opcodeMemoryInit)
+	if err != nil {
+		return err
+	}
+
+	dataIndex := i.DataIndex
+	err = w.buf.writeUint32LEB128(dataIndex)
+	if err != nil {
+		return err
+	}
+
+	err = w.buf.WriteByte(0)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// InstructionDataDrop is the 'data.drop' instruction
+type InstructionDataDrop struct {
+	DataIndex uint32
+}
+
+func (InstructionDataDrop) isInstruction() {}
+
+func (InstructionDataDrop) name() string {
+	return "data.drop"
+}
+
+func (i InstructionDataDrop) write(w *WASMWriter) error {
+	err := w.writePrefixedOpcode(0xfc, opcodeDataDrop)
+	if err != nil {
+		return err
+	}
+
+	dataIndex := i.DataIndex
+	err = w.buf.writeUint32LEB128(dataIndex)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// InstructionMemoryCopy is the 'memory.copy' instruction
+type InstructionMemoryCopy struct{}
+
+func (InstructionMemoryCopy) isInstruction() {}
+
+func (InstructionMemoryCopy) name() string {
+	return "memory.copy"
+}
+
+func (i InstructionMemoryCopy) write(w *WASMWriter) error {
+	err := w.writePrefixedOpcode(0xfc, opcodeMemoryCopy)
+	if err != nil {
+		return err
+	}
+
+	err = w.buf.WriteByte(0)
+	if err != nil {
+		return err
+	}
+
+	err = w.buf.WriteByte(0)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// InstructionMemoryFill is the 'memory.fill' instruction
+type InstructionMemoryFill struct{}
+
+func (InstructionMemoryFill) isInstruction() {}
+
+func (InstructionMemoryFill) name() string {
+	return "memory.fill"
+}
+
+func (i InstructionMemoryFill) write(w *WASMWriter) error {
+	err := w.writePrefixedOpcode(0xfc, opcodeMemoryFill)
+	if err != nil {
+		return err
+	}
+
+	err = w.buf.WriteByte(0)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// InstructionTableInit is the 'table.init' instruction
+type InstructionTableInit struct {
+	ElementIndex uint32
+	TableIndex   uint32
+}
+
+func (InstructionTableInit) isInstruction() {}
+
+func (InstructionTableInit) name() string {
+	return "table.init"
+}
+
+func (i InstructionTableInit) write(w *WASMWriter) error {
+	err := w.writePrefixedOpcode(0xfc, opcodeTableInit)
+	if err != nil {
+		return err
+	}
+
+	elementIndex := i.ElementIndex
+	err = w.buf.writeUint32LEB128(elementIndex)
+	if err != nil {
+		return err
+	}
+
+	tableIndex := i.TableIndex
+	err = w.buf.writeUint32LEB128(tableIndex)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// InstructionElemDrop is the 'elem.drop' instruction
+type InstructionElemDrop struct {
+	ElementIndex uint32
+}
+
+func (InstructionElemDrop) isInstruction() {}
+
+func (InstructionElemDrop) name() string {
+	return "elem.drop"
+}
+
+func (i InstructionElemDrop) write(w *WASMWriter) error {
+	err := w.writePrefixedOpcode(0xfc, opcodeElemDrop)
+	if err != nil {
+		return err
+	}
+
+	elementIndex := i.ElementIndex
+	err = w.buf.writeUint32LEB128(elementIndex)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// InstructionTableCopy is the 'table.copy' instruction
+type InstructionTableCopy struct {
+	DestinationTableIndex uint32
+	SourceTableIndex      uint32
+}
+
+func (InstructionTableCopy) isInstruction() {}
+
+func (InstructionTableCopy) name() string {
+	return "table.copy"
+}
+
+func (i InstructionTableCopy) write(w *WASMWriter) error {
+	err := w.writePrefixedOpcode(0xfc, opcodeTableCopy)
+	if err != nil {
+		return err
+	}
+
+	destinationTableIndex := i.DestinationTableIndex
+	err = w.buf.writeUint32LEB128(destinationTableIndex)
+	if err != nil {
+		return err
+	}
+
+	sourceTableIndex := i.SourceTableIndex
+	err = w.buf.writeUint32LEB128(sourceTableIndex)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// InstructionTableGrow is the 'table.grow' instruction
+type InstructionTableGrow struct {
+	TableIndex uint32
+}
+
+func (InstructionTableGrow) isInstruction() {}
+
+func (InstructionTableGrow) name() string {
+	return "table.grow"
+}
+
+func (i InstructionTableGrow) write(w *WASMWriter) error {
+	err := w.writePrefixedOpcode(0xfc, opcodeTableGrow)
+	if err != nil {
+		return err
+	}
+
+	tableIndex := i.TableIndex
+	err = w.buf.writeUint32LEB128(tableIndex)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// InstructionTableSize is the 'table.size' instruction
+type InstructionTableSize struct {
+	TableIndex uint32
+}
+
+func (InstructionTableSize) isInstruction() {}
+
+func (InstructionTableSize) name() string {
+	return "table.size"
+}
+
+func (i InstructionTableSize) write(w *WASMWriter) error {
+	err := w.writePrefixedOpcode(0xfc, opcodeTableSize)
+	if err != nil {
+		return err
+	}
+
+	tableIndex := i.TableIndex
+	err = w.buf.writeUint32LEB128(tableIndex)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// InstructionTableFill is the 'table.fill' instruction
+type InstructionTableFill struct {
+	TableIndex uint32
+}
+
+func (InstructionTableFill) isInstruction() {}
+
+func (InstructionTableFill) name() string {
+	return "table.fill"
+}
+
+func (i InstructionTableFill) write(w *WASMWriter) error {
+	err := w.writePrefixedOpcode(0xfc, opcodeTableFill)
+	if err != nil {
+		return err
+	}
+
+	tableIndex := i.TableIndex
+	err = w.buf.writeUint32LEB128(tableIndex)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+const (
+	// opcodeUnreachable is the opcode for the 'unreachable' instruction
+	opcodeUnreachable opcode = 0x0
+	// opcodeNop is the opcode for the 'nop' instruction
+	opcodeNop opcode = 0x1
+	// opcodeBlock is the opcode for the 'block' instruction
+	opcodeBlock opcode = 0x2
+	// opcodeLoop is the opcode for the 'loop' instruction
+	opcodeLoop opcode = 0x3
+	// opcodeIf is the opcode for the 'if' instruction
+	opcodeIf opcode = 0x4
+	// opcodeEnd is the opcode for the 'end' instruction
+	opcodeEnd opcode = 0xb
+	// opcodeBr is the opcode for the 'br' instruction
+	opcodeBr opcode = 0xc
+	// opcodeBrIf is the opcode for the 'br_if' instruction
+	opcodeBrIf opcode = 0xd
+	// opcodeBrTable is the opcode for the 'br_table' instruction
+	opcodeBrTable opcode = 0xe
+	// opcodeReturn is the opcode for the 'return' instruction
+	opcodeReturn opcode = 0xf
+	// opcodeCall is the opcode for the 'call' instruction
+	opcodeCall opcode = 0x10
+	// opcodeCallIndirect is the opcode for the 'call_indirect' instruction
+	opcodeCallIndirect opcode = 0x11
+	// opcodeRefNull is the opcode for the 'ref.null' instruction
+	opcodeRefNull opcode = 0xd0
 	// opcodeRefIsNull is the opcode for the 'ref.is_null' instruction
 	opcodeRefIsNull opcode = 0xd1
 	// opcodeRefFunc is the opcode for the 'ref.func' instruction
@@ -1410,6 +2541,8 @@ const (
 	opcodeDrop opcode = 0x1a
 	// opcodeSelect is the opcode for the 'select' instruction
 	opcodeSelect opcode = 0x1b
+	// opcodeSelectT is the opcode for the 'select_t' instruction
+	opcodeSelectT opcode = 0x1c
 	// opcodeLocalGet is the opcode for the 'local.get' instruction
 	opcodeLocalGet opcode = 0x20
 	// opcodeLocalSet is the opcode for the 'local.set' instruction
@@ -1420,6 +2553,10 @@ const (
 	opcodeGlobalGet opcode = 0x23
 	// opcodeGlobalSet is the opcode for the 'global.set' instruction
 	opcodeGlobalSet opcode = 0x24
+	// opcodeTableGet is the opcode for the 'table.get' instruction
+	opcodeTableGet opcode = 0x25
+	// opcodeTableSet is the opcode for the 'table.set' instruction
+	opcodeTableSet opcode = 0x26
 	// opcodeI32Const is the opcode for the 'i32.const' instruction
 	opcodeI32Const opcode = 0x41
 	// opcodeI64Const is the opcode for the 'i64.const' instruction
@@ -1546,6 +2683,60 @@ const (
 	opcodeI64ExtendI32S opcode = 0xac
 	// opcodeI64ExtendI32U is the opcode for the 'i64.extend_i32_u' instruction
 	opcodeI64ExtendI32U opcode = 0xad
+	// opcodeV128Const is the opcode for the 'v128.const' instruction
+	opcodeV128Const prefixedOpcode = 0xc
+	// opcodeI8x16ExtractLaneS is the opcode for the 'i8x16.extract_lane_s' instruction
+	opcodeI8x16ExtractLaneS prefixedOpcode = 0x15
+	// opcodeI8x16ExtractLaneU is the opcode for the 'i8x16.extract_lane_u' instruction
+	opcodeI8x16ExtractLaneU prefixedOpcode = 0x16
+	// opcodeI8x16ReplaceLane is the opcode for the 'i8x16.replace_lane' instruction
+	opcodeI8x16ReplaceLane prefixedOpcode = 0x17
+	// opcodeI16x8ExtractLaneS is the opcode for the 'i16x8.extract_lane_s' instruction
+	opcodeI16x8ExtractLaneS prefixedOpcode = 0x18
+	// opcodeI16x8ExtractLaneU is the opcode for the 'i16x8.extract_lane_u' instruction
+	opcodeI16x8ExtractLaneU prefixedOpcode = 0x19
+	// opcodeI16x8ReplaceLane is the opcode for the 'i16x8.replace_lane' instruction
+	opcodeI16x8ReplaceLane prefixedOpcode = 0x1a
+	// opcodeI32x4ExtractLane is the opcode for the 'i32x4.extract_lane' instruction
+	opcodeI32x4ExtractLane prefixedOpcode = 0x1b
+	// opcodeI32x4ReplaceLane is the opcode for the 'i32x4.replace_lane' instruction
+	opcodeI32x4ReplaceLane prefixedOpcode = 0x1c
+	// opcodeI64x2ExtractLane is the opcode for the 'i64x2.extract_lane' instruction
+	opcodeI64x2ExtractLane prefixedOpcode = 0x1d
+	// opcodeI64x2ReplaceLane is the opcode for the 'i64x2.replace_lane' instruction
+	opcodeI64x2ReplaceLane prefixedOpcode = 0x1e
+	// opcodeF32x4ExtractLane is the opcode for the 'f32x4.extract_lane' instruction
+	opcodeF32x4ExtractLane prefixedOpcode = 0x1f
+	// opcodeF32x4ReplaceLane is the opcode for the 'f32x4.replace_lane' instruction
+	opcodeF32x4ReplaceLane prefixedOpcode = 0x20
+	// opcodeF64x2ExtractLane is the opcode for the 'f64x2.extract_lane' instruction
+	opcodeF64x2ExtractLane prefixedOpcode = 0x21
+	// opcodeF64x2ReplaceLane is the opcode for the 'f64x2.replace_lane' instruction
+	opcodeF64x2ReplaceLane prefixedOpcode = 0x22
+	// opcodeI32x4Add is the opcode for the 'i32x4.add' instruction
+	opcodeI32x4Add prefixedOpcode = 0xae
+	// opcodeF32x4Mul is the opcode for the 'f32x4.mul' instruction
+	opcodeF32x4Mul prefixedOpcode = 0xe6
+	// opcodeMemoryInit is the opcode for the 'memory.init' instruction
+	opcodeMemoryInit prefixedOpcode = 0x8
+	// opcodeDataDrop is the opcode for the 'data.drop' instruction
+	opcodeDataDrop prefixedOpcode = 0x9
+	// opcodeMemoryCopy is the opcode for the 'memory.copy' instruction
+	opcodeMemoryCopy prefixedOpcode = 0xa
+	// opcodeMemoryFill is the opcode for the 'memory.fill' instruction
+	opcodeMemoryFill prefixedOpcode = 0xb
+	// opcodeTableInit is the opcode for the 'table.init' instruction
+	opcodeTableInit prefixedOpcode = 0xc
+	// opcodeElemDrop is the opcode for the 'elem.drop' instruction
+	opcodeElemDrop prefixedOpcode = 0xd
+	// opcodeTableCopy is the opcode for the 'table.copy' instruction
+	opcodeTableCopy prefixedOpcode = 0xe
+	// opcodeTableGrow is the opcode for the 'table.grow' instruction
+	opcodeTableGrow prefixedOpcode = 0xf
+	// opcodeTableSize is the opcode for the 'table.size' instruction
+	opcodeTableSize prefixedOpcode = 0x10
+	// opcodeTableFill is the opcode for the 'table.fill' instruction
+	opcodeTableFill prefixedOpcode = 0x11
 )
 
 // readInstruction reads an instruction in the WASM binary
@@ -1570,6 +2761,321 @@ func (r *WASMReader) readInstruction() (Instruction, error) {
 	}
 
 	switch c {
+	case 0xfc:
+		prefixed, err := r.buf.readUint32LEB128()
+		if err != nil {
+			return nil, InvalidPrefixedOpcodeError{
+				Offset:    int(opcodeOffset),
+				Prefix:    c,
+				ReadError: err,
+			}
+		}
+		p := prefixedOpcode(prefixed)
+
+		switch p {
+		case opcodeDataDrop:
+			dataIndex, err := r.readUint32LEB128InstructionArgument()
+			if err != nil {
+				return nil, err
+			}
+
+			return InstructionDataDrop{
+				DataIndex: dataIndex,
+			}, nil
+
+		case opcodeElemDrop:
+			elementIndex, err := r.readUint32LEB128InstructionArgument()
+			if err != nil {
+				return nil, err
+			}
+
+			return InstructionElemDrop{
+				ElementIndex: elementIndex,
+			}, nil
+
+		case opcodeMemoryCopy:
+			err = r.readReservedByteInstructionArgument()
+			if err != nil {
+				return nil, err
+			}
+
+			err = r.readReservedByteInstructionArgument()
+			if err != nil {
+				return nil, err
+			}
+
+			return InstructionMemoryCopy{}, nil
+
+		case opcodeMemoryFill:
+			err = r.readReservedByteInstructionArgument()
+			if err != nil {
+				return nil, err
+			}
+
+			return InstructionMemoryFill{}, nil
+
+		case opcodeMemoryInit:
+			dataIndex, err := r.readUint32LEB128InstructionArgument()
+			if err != nil {
+				return nil, err
+			}
+
+			err = r.readReservedByteInstructionArgument()
+			if err != nil {
+				return nil, err
+			}
+
+			return InstructionMemoryInit{
+				DataIndex: dataIndex,
+			}, nil
+
+		case opcodeTableCopy:
+			destinationTableIndex, err := r.readUint32LEB128InstructionArgument()
+			if err != nil {
+				return nil, err
+			}
+
+			sourceTableIndex, err := r.readUint32LEB128InstructionArgument()
+			if err != nil {
+				return nil, err
+			}
+
+			return InstructionTableCopy{
+				DestinationTableIndex: destinationTableIndex,
+				SourceTableIndex:      sourceTableIndex,
+			}, nil
+
+		case opcodeTableFill:
+			tableIndex, err := r.readUint32LEB128InstructionArgument()
+			if err != nil {
+				return nil, err
+			}
+
+			return InstructionTableFill{
+				TableIndex: tableIndex,
+			}, nil
+
+		case opcodeTableGrow:
+			tableIndex, err := r.readUint32LEB128InstructionArgument()
+			if err != nil {
+				return nil, err
+			}
+
+			return InstructionTableGrow{
+				TableIndex: tableIndex,
+			}, nil
+
+		case opcodeTableInit:
+			elementIndex, err := r.readUint32LEB128InstructionArgument()
+			if err != nil {
+				return nil, err
+			}
+
+			tableIndex, err := r.readUint32LEB128InstructionArgument()
+			if err != nil {
+				return nil, err
+			}
+
+			return InstructionTableInit{
+				ElementIndex: elementIndex,
+				TableIndex:   tableIndex,
+			}, nil
+
+		case opcodeTableSize:
+			tableIndex, err := r.readUint32LEB128InstructionArgument()
+			if err != nil {
+				return nil, err
+			}
+
+			return InstructionTableSize{
+				TableIndex: tableIndex,
+			}, nil
+
+		default:
+			return nil, InvalidPrefixedOpcodeError{
+				Offset: int(opcodeOffset),
+				Prefix: c,
+				Opcode: p,
+			}
+		}
+
+	case 0xfd:
+		prefixed, err := r.buf.readUint32LEB128()
+		if err != nil {
+			return nil, InvalidPrefixedOpcodeError{
+				Offset:    int(opcodeOffset),
+				Prefix:    c,
+				ReadError: err,
+			}
+		}
+		p := prefixedOpcode(prefixed)
+
+		switch p {
+		case opcodeF32x4ExtractLane:
+			laneIndex, err := r.readByteInstructionArgument()
+			if err != nil {
+				return nil, err
+			}
+
+			return InstructionF32x4ExtractLane{
+				LaneIndex: laneIndex,
+			}, nil
+
+		case opcodeF32x4Mul:
+			return InstructionF32x4Mul{}, nil
+
+		case opcodeF32x4ReplaceLane:
+			laneIndex, err := r.readByteInstructionArgument()
+			if err != nil {
+				return nil, err
+			}
+
+			return InstructionF32x4ReplaceLane{
+				LaneIndex: laneIndex,
+			}, nil
+
+		case opcodeF64x2ExtractLane:
+			laneIndex, err := r.readByteInstructionArgument()
+			if err != nil {
+				return nil, err
+			}
+
+			return InstructionF64x2ExtractLane{
+				LaneIndex: laneIndex,
+			}, nil
+
+		case opcodeF64x2ReplaceLane:
+			laneIndex, err := r.readByteInstructionArgument()
+			if err != nil {
+				return nil, err
+			}
+
+			return InstructionF64x2ReplaceLane{
+				LaneIndex: laneIndex,
+			}, nil
+
+		case opcodeI16x8ExtractLaneS:
+			laneIndex, err := r.readByteInstructionArgument()
+			if err != nil {
+				return nil, err
+			}
+
+			return InstructionI16x8ExtractLaneS{
+				LaneIndex: laneIndex,
+			}, nil
+
+		case opcodeI16x8ExtractLaneU:
+			laneIndex, err := r.readByteInstructionArgument()
+			if err != nil {
+				return nil, err
+			}
+
+			return InstructionI16x8ExtractLaneU{
+				LaneIndex: laneIndex,
+			}, nil
+
+		case opcodeI16x8ReplaceLane:
+			laneIndex, err := r.readByteInstructionArgument()
+			if err != nil {
+				return nil, err
+			}
+
+			return InstructionI16x8ReplaceLane{
+				LaneIndex: laneIndex,
+			}, nil
+
+		case opcodeI32x4Add:
+			return InstructionI32x4Add{}, nil
+
+		case opcodeI32x4ExtractLane:
+			laneIndex, err := r.readByteInstructionArgument()
+			if err != nil {
+				return nil, err
+			}
+
+			return InstructionI32x4ExtractLane{
+				LaneIndex: laneIndex,
+			}, nil
+
+		case opcodeI32x4ReplaceLane:
+			laneIndex, err := r.readByteInstructionArgument()
+			if err != nil {
+				return nil, err
+			}
+
+			return InstructionI32x4ReplaceLane{
+				LaneIndex: laneIndex,
+			}, nil
+
+		case opcodeI64x2ExtractLane:
+			laneIndex, err := r.readByteInstructionArgument()
+			if err != nil {
+				return nil, err
+			}
+
+			return InstructionI64x2ExtractLane{
+				LaneIndex: laneIndex,
+			}, nil
+
+		case opcodeI64x2ReplaceLane:
+			laneIndex, err := r.readByteInstructionArgument()
+			if err != nil {
+				return nil, err
+			}
+
+			return InstructionI64x2ReplaceLane{
+				LaneIndex: laneIndex,
+			}, nil
+
+		case opcodeI8x16ExtractLaneS:
+			laneIndex, err := r.readByteInstructionArgument()
+			if err != nil {
+				return nil, err
+			}
+
+			return InstructionI8x16ExtractLaneS{
+				LaneIndex: laneIndex,
+			}, nil
+
+		case opcodeI8x16ExtractLaneU:
+			laneIndex, err := r.readByteInstructionArgument()
+			if err != nil {
+				return nil, err
+			}
+
+			return InstructionI8x16ExtractLaneU{
+				LaneIndex: laneIndex,
+			}, nil
+
+		case opcodeI8x16ReplaceLane:
+			laneIndex, err := r.readByteInstructionArgument()
+			if err != nil {
+				return nil, err
+			}
+
+			return InstructionI8x16ReplaceLane{
+				LaneIndex: laneIndex,
+			}, nil
+
+		case opcodeV128Const:
+			var value [16]byte
+			err = r.readBytesInstructionArgument(value[:])
+			if err != nil {
+				return nil, err
+			}
+
+			return InstructionV128Const{
+				Value: value,
+			}, nil
+
+		default:
+			return nil, InvalidPrefixedOpcodeError{
+				Offset: int(opcodeOffset),
+				Prefix: c,
+				Opcode: p,
+			}
+		}
+
 	case opcodeBlock:
 		block, err := r.readBlockInstructionArgument(false)
 		if err != nil {
@@ -1610,6 +3116,19 @@ func (r *WASMReader) readInstruction() (Instruction, error) {
 			}
 		}
 
+		err = r.checkInstructionVectorCount(labelIndicesCount, labelIndicesCountOffset)
+		if err != nil {
+			return nil, err
+		}
+
+		// each element is encoded in at least one byte,
+		// so a count exceeding the remaining data is invalid
+		if labelIndicesCount > r.buf.remaining() {
+			return nil, InvalidInstructionVectorArgumentCountError{
+				Offset: int(labelIndicesCountOffset),
+			}
+		}
+
 		labelIndices := make([]uint32, labelIndicesCount)
 
 		for i := uint32(0); i < labelIndicesCount; i++ {
@@ -1663,7 +3182,7 @@ func (r *WASMReader) readInstruction() (Instruction, error) {
 		return InstructionEnd{}, nil
 
 	case opcodeGlobalGet:
-		globalIndex, err := r.readUint32LEB128InstructionArgument()
+		globalIndex, err := r.readGlobalIndexInstructionArgument()
 		if err != nil {
 			return nil, err
 		}
@@ -1673,7 +3192,7 @@ func (r *WASMReader) readInstruction() (Instruction, error) {
 		}, nil
 
 	case opcodeGlobalSet:
-		globalIndex, err := r.readUint32LEB128InstructionArgument()
+		globalIndex, err := r.readGlobalIndexInstructionArgument()
 		if err != nil {
 			return nil, err
 		}
@@ -1896,7 +3415,7 @@ func (r *WASMReader) readInstruction() (Instruction, error) {
 		}, nil
 
 	case opcodeLocalGet:
-		localIndex, err := r.readUint32LEB128InstructionArgument()
+		localIndex, err := r.readLocalIndexInstructionArgument()
 		if err != nil {
 			return nil, err
 		}
@@ -1906,7 +3425,7 @@ func (r *WASMReader) readInstruction() (Instruction, error) {
 		}, nil
 
 	case opcodeLocalSet:
-		localIndex, err := r.readUint32LEB128InstructionArgument()
+		localIndex, err := r.readLocalIndexInstructionArgument()
 		if err != nil {
 			return nil, err
 		}
@@ -1916,7 +3435,7 @@ func (r *WASMReader) readInstruction() (Instruction, error) {
 		}, nil
 
 	case opcodeLocalTee:
-		localIndex, err := r.readUint32LEB128InstructionArgument()
+		localIndex, err := r.readLocalIndexInstructionArgument()
 		if err != nil {
 			return nil, err
 		}
@@ -1967,6 +3486,63 @@ func (r *WASMReader) readInstruction() (Instruction, error) {
 	case opcodeSelect:
 		return InstructionSelect{}, nil
 
+	case opcodeSelectT:
+		resultTypesCountOffset := r.buf.offset
+		resultTypesCount, err := r.buf.readUint32LEB128()
+		if err != nil {
+			return nil, InvalidInstructionVectorArgumentCountError{
+				Offset:    int(resultTypesCountOffset),
+				ReadError: err,
+			}
+		}
+
+		err = r.checkInstructionVectorCount(resultTypesCount, resultTypesCountOffset)
+		if err != nil {
+			return nil, err
+		}
+
+		// each element is encoded in at least one byte,
+		// so a count exceeding the remaining data is invalid
+		if resultTypesCount > r.buf.remaining() {
+			return nil, InvalidInstructionVectorArgumentCountError{
+				Offset: int(resultTypesCountOffset),
+			}
+		}
+
+		resultTypes := make([]ValueType, resultTypesCount)
+
+		for i := uint32(0); i < resultTypesCount; i++ {
+			resultTypesElement, err := r.readValueTypeInstructionArgument()
+			if err != nil {
+				return nil, err
+			}
+			resultTypes[i] = resultTypesElement
+		}
+
+		return InstructionSelectT{
+			ResultTypes: resultTypes,
+		}, nil
+
+	case opcodeTableGet:
+		tableIndex, err := r.readUint32LEB128InstructionArgument()
+		if err != nil {
+			return nil, err
+		}
+
+		return InstructionTableGet{
+			TableIndex: tableIndex,
+		}, nil
+
+	case opcodeTableSet:
+		tableIndex, err := r.readUint32LEB128InstructionArgument()
+		if err != nil {
+			return nil, err
+		}
+
+		return InstructionTableSet{
+			TableIndex: tableIndex,
+		}, nil
+
 	case opcodeUnreachable:
 		return InstructionUnreachable{}, nil
 
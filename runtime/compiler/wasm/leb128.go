@@ -121,6 +121,7 @@ func (buf *Buffer) writeUint32LEB128FixedLength(v uint32, length int) error {
 
 // readUint32LEB128 reads and decodes an unsigned 32-bit integer
 func (buf *Buffer) readUint32LEB128() (uint32, error) {
+	off := buf.offset
 	var result uint32
 	var shift, i uint
 	// only read up to maximum number of bytes
@@ -137,11 +138,18 @@ func (buf *Buffer) readUint32LEB128() (uint32, error) {
 		shift += 7
 		i++
 	}
+	if i == max32bitLEB128ByteCount {
+		return 0, InvalidLEB128Error{
+			Offset: int(off),
+			Max:    max32bitLEB128ByteCount,
+		}
+	}
 	return result, nil
 }
 
 // readUint64LEB128 reads and decodes an unsigned 64-bit integer
 func (buf *Buffer) readUint64LEB128() (uint64, error) {
+	off := buf.offset
 	var result uint64
 	var shift, i uint
 	// only read up to maximum number of bytes
@@ -158,6 +166,12 @@ func (buf *Buffer) readUint64LEB128() (uint64, error) {
 		shift += 7
 		i++
 	}
+	if i == max64bitLEB128ByteCount {
+		return 0, InvalidLEB128Error{
+			Offset: int(off),
+			Max:    max64bitLEB128ByteCount,
+		}
+	}
 	return result, nil
 }
 
@@ -205,6 +219,7 @@ func (buf *Buffer) writeInt64LEB128(v int64) error {
 
 // readInt32LEB128 reads and decodes a signed 32-bit integer
 func (buf *Buffer) readInt32LEB128() (int32, error) {
+	off := buf.offset
 	var result int32
 	var i uint
 	var b byte = 0x80
@@ -219,6 +234,12 @@ func (buf *Buffer) readInt32LEB128() (int32, error) {
 		signBits <<= 7
 		i++
 	}
+	if b&0x80 == 0x80 {
+		return 0, InvalidLEB128Error{
+			Offset: int(off),
+			Max:    max32bitLEB128ByteCount,
+		}
+	}
 	if ((signBits >> 1) & result) != 0 {
 		result += signBits
 	}
@@ -227,6 +248,7 @@ func (buf *Buffer) readInt32LEB128() (int32, error) {
 
 // readInt64LEB128 reads and decodes a signed 64-bit integer
 func (buf *Buffer) readInt64LEB128() (int64, error) {
+	off := buf.offset
 	var result int64
 	var i uint
 	var b byte = 0x80
@@ -241,6 +263,12 @@ func (buf *Buffer) readInt64LEB128() (int64, error) {
 		signBits <<= 7
 		i++
 	}
+	if b&0x80 == 0x80 {
+		return 0, InvalidLEB128Error{
+			Offset: int(off),
+			Max:    max64bitLEB128ByteCount,
+		}
+	}
 	if ((signBits >> 1) & result) != 0 {
 		result += signBits
 	}
@@ -89,9 +89,32 @@ func TestBuf_Uint32LEB128(t *testing.T) {
 
 		b := Buffer{data: []byte{0x81, 0x82, 0x83, 0x84, 0x85, 0x86, 0x87, 0x88}}
 		_, err := b.readUint32LEB128()
-		require.NoError(t, err)
+		require.Equal(t,
+			InvalidLEB128Error{
+				Offset: 0,
+				Max:    max32bitLEB128ByteCount,
+			},
+			err,
+		)
 		require.Equal(t, offset(max32bitLEB128ByteCount), b.offset)
 	})
+
+	t.Run("read: too many bytes", func(t *testing.T) {
+
+		t.Parallel()
+
+		// 6 bytes, all but the last with the continuation bit set
+
+		b := Buffer{data: []byte{0x80, 0x80, 0x80, 0x80, 0x80, 0x00}}
+		_, err := b.readUint32LEB128()
+		require.Equal(t,
+			InvalidLEB128Error{
+				Offset: 0,
+				Max:    5,
+			},
+			err,
+		)
+	})
 }
 
 func TestBuf_Uint64LEB128(t *testing.T) {
@@ -152,7 +175,13 @@ func TestBuf_Uint64LEB128(t *testing.T) {
 			0x89, 0x8a, 0x8b, 0x8c, 0x8d, 0x8e, 0x8f, 0x90,
 		}}
 		_, err := b.readUint64LEB128()
-		require.NoError(t, err)
+		require.Equal(t,
+			InvalidLEB128Error{
+				Offset: 0,
+				Max:    max64bitLEB128ByteCount,
+			},
+			err,
+		)
 		require.Equal(t, offset(max64bitLEB128ByteCount), b.offset)
 	})
 }
@@ -229,7 +258,13 @@ func TestBuf_Int32LEB128(t *testing.T) {
 
 		b := Buffer{data: []byte{0x81, 0x82, 0x83, 0x84, 0x85, 0x86, 0x87, 0x88}}
 		_, err := b.readInt32LEB128()
-		require.NoError(t, err)
+		require.Equal(t,
+			InvalidLEB128Error{
+				Offset: 0,
+				Max:    max32bitLEB128ByteCount,
+			},
+			err,
+		)
 		require.Equal(t, offset(max32bitLEB128ByteCount), b.offset)
 	})
 }
@@ -300,9 +335,35 @@ func TestBuf_Int64LEB128(t *testing.T) {
 			0x89, 0x8a, 0x8b, 0x8c, 0x8d, 0x8e, 0x8f, 0x90,
 		}}
 		_, err := b.readInt64LEB128()
-		require.NoError(t, err)
+		require.Equal(t,
+			InvalidLEB128Error{
+				Offset: 0,
+				Max:    max64bitLEB128ByteCount,
+			},
+			err,
+		)
 		require.Equal(t, offset(max64bitLEB128ByteCount), b.offset)
 	})
+
+	t.Run("read: too many bytes", func(t *testing.T) {
+
+		t.Parallel()
+
+		// 11 bytes, all but the last with the continuation bit set
+
+		b := Buffer{data: []byte{
+			0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+			0x80, 0x80, 0x80, 0x80, 0x00,
+		}}
+		_, err := b.readInt64LEB128()
+		require.Equal(t,
+			InvalidLEB128Error{
+				Offset: 0,
+				Max:    10,
+			},
+			err,
+		)
+	})
 }
 
 func TestBuf_WriteSpaceAndSize(t *testing.T) {
@@ -24,8 +24,29 @@ type Module struct {
 	Types              []*FunctionType
 	Imports            []*Import
 	Functions          []*Function
+	Tables             []*Table
 	Memories           []*Memory
+	Globals            []*Global
 	Exports            []*Export
 	StartFunctionIndex *uint32
-	Data               []*Data
+	Elements           []*Element
+	// DataCount is the number of data segments, declared in the data count section.
+	// It must be declared if the memory.init or data.drop instructions are used,
+	// and must match the number of data segments
+	DataCount *uint32
+	Data      []*Data
+}
+
+// isValidFunctionIndex returns true if the given function index
+// refers to an imported function or a function of the module.
+// Function indices include function imports
+func (m *Module) isValidFunctionIndex(index uint32) bool {
+	return index < uint32(functionImportCount(m.Imports)+len(m.Functions))
+}
+
+// isValidMemoryIndex returns true if the given memory index
+// refers to an imported memory or a memory of the module.
+// Memory indices include memory imports
+func (m *Module) isValidMemoryIndex(index uint32) bool {
+	return index < uint32(memoryImportCount(m.Imports)+len(m.Memories))
 }
@@ -19,6 +19,7 @@
 package wasm
 
 import (
+	"crypto/sha256"
 	"errors"
 	"math"
 )
@@ -60,9 +61,11 @@ func (b *ModuleBuilder) AddFunctionImport(module string, name string, functionTy
 	b.functionImports = append(
 		b.functionImports,
 		&Import{
-			Module:    module,
-			Name:      name,
-			TypeIndex: typeIndex,
+			Module: module,
+			Name:   name,
+			Descriptor: FunctionImport{
+				TypeIndex: typeIndex,
+			},
 		},
 	)
 
@@ -105,6 +108,26 @@ func (b *ModuleBuilder) Build() *Module {
 	}
 }
 
+// BuildWithHash builds the module, encodes it in the binary format,
+// and returns the encoded module and its SHA-256 hash.
+//
+// NOTE: the writer back-patches section sizes after writing section contents,
+// so the hash is computed over the final encoding, not streamed
+func (b *ModuleBuilder) BuildWithHash() ([]byte, [sha256.Size]byte, error) {
+	module := b.Build()
+
+	var buf Buffer
+	w := NewWASMWriter(&buf)
+	err := w.WriteModule(module)
+	if err != nil {
+		return nil, [sha256.Size]byte{}, err
+	}
+
+	encoded := buf.Bytes()
+
+	return encoded, sha256.Sum256(encoded), nil
+}
+
 func (b *ModuleBuilder) ExportMemory(name string) {
 	b.AddExport(&Export{
 		Name: name,
@@ -0,0 +1,70 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package wasm
+
+import (
+	"crypto/sha256"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestModuleBuilder_BuildWithHash(t *testing.T) {
+
+	t.Parallel()
+
+	newModuleBuilder := func() *ModuleBuilder {
+		var b ModuleBuilder
+
+		b.AddFunction(
+			"add",
+			&FunctionType{
+				Params:  []ValueType{ValueTypeI32, ValueTypeI32},
+				Results: []ValueType{ValueTypeI32},
+			},
+			&Code{
+				Instructions: []Instruction{
+					InstructionLocalGet{LocalIndex: 0},
+					InstructionLocalGet{LocalIndex: 1},
+					InstructionI32Add{},
+				},
+			},
+		)
+
+		b.AddData(b.RequireMemory(3), []byte{0x1, 0x2, 0x3})
+
+		b.ExportMemory("mem")
+
+		return &b
+	}
+
+	encoded, hash, err := newModuleBuilder().BuildWithHash()
+	require.NoError(t, err)
+
+	require.NotEmpty(t, encoded)
+	require.Equal(t, sha256.Sum256(encoded), hash)
+
+	// Building the same module again results in the same encoding and hash
+
+	encoded2, hash2, err := newModuleBuilder().BuildWithHash()
+	require.NoError(t, err)
+
+	require.Equal(t, encoded, encoded2)
+	require.Equal(t, hash, hash2)
+}
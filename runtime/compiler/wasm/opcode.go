@@ -22,3 +22,7 @@ package wasm
 type opcode byte
 
 const opcodeElse opcode = 0x05
+
+// prefixedOpcode is the LEB128-encoded uint32 following a prefix byte (e.g. 0xFC or 0xFD),
+// used to indicate a certain prefixed instruction in the WASM binary
+type prefixedOpcode uint32
@@ -28,9 +28,37 @@ import (
 type WASMReader struct {
 	buf              *Buffer
 	Module           Module
+	Limits           ReaderLimits
 	lastSectionID    sectionID
 	didReadFunctions bool
 	didReadCode      bool
+	// sectionEnd is the offset of the end of the section which is currently read.
+	// It is zero if no section is currently read
+	sectionEnd offset
+	// indexLimits are the limits of the local and global indices
+	// of the instructions of the function body which is currently read.
+	// It is nil if the limits are unknown, in which case the indices are not validated
+	indexLimits *instructionIndexLimits
+}
+
+// instructionIndexLimits are the number of locals and globals which may be referred to by instructions
+type instructionIndexLimits struct {
+	localCount  uint32
+	globalCount uint32
+}
+
+// ReaderLimits are the limits enforced when reading a module,
+// which guard against inputs that would result in large allocations.
+// A limit of zero is not enforced
+type ReaderLimits struct {
+	// MaxModuleSize is the maximum size of the module, in bytes
+	MaxModuleSize uint32
+	// MaxInstructionVectorLength is the maximum number of elements
+	// of a vector argument of an instruction, e.g. the label indices of br_table
+	MaxInstructionVectorLength uint32
+	// MaxSectionVectorLength is the maximum number of elements of a vector in a section,
+	// e.g. the types of the type section, the bytes of a name, or the locals of a function
+	MaxSectionVectorLength uint32
 }
 
 func NewWASMReader(buf *Buffer) *WASMReader {
@@ -39,6 +67,19 @@ func NewWASMReader(buf *Buffer) *WASMReader {
 	}
 }
 
+// NewWASMReaderFromReader returns a reader which reads from the given reader.
+//
+// All data of the given reader is read into a buffer,
+// as reading a module requires backtracking
+func NewWASMReaderFromReader(reader io.Reader) (*WASMReader, error) {
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewWASMReader(&Buffer{data: data}), nil
+}
+
 // readMagicAndVersion reads the magic byte sequence and version at the beginning of the WASM binary
 //
 // See https://webassembly.github.io/spec/core/binary/modules.html#binary-module:
@@ -69,6 +110,12 @@ func (r *WASMReader) readMagicAndVersion() error {
 
 // readSection reads a section in the WASM binary
 func (r *WASMReader) readSection() error {
+	// the section end is set when the section size is read,
+	// and is only valid while the section is read
+	defer func() {
+		r.sectionEnd = 0
+	}()
+
 	// read the section ID
 	sectionIDOffset := r.buf.offset
 	b, err := r.buf.ReadByte()
@@ -93,10 +140,11 @@ func (r *WASMReader) readSection() error {
 	// "Custom sections may be inserted at any place in this sequence,
 	// while other sections must occur at most once and in the prescribed order."
 
-	if sectionID > 0 && sectionID <= r.lastSectionID {
+	if sectionID > 0 && sectionID.order() <= r.lastSectionID.order() {
 		return InvalidSectionOrderError{
-			SectionID: sectionID,
-			Offset:    int(sectionIDOffset),
+			SectionID:         sectionID,
+			PreviousSectionID: r.lastSectionID,
+			Offset:            int(sectionIDOffset),
 		}
 	}
 
@@ -139,6 +187,16 @@ func (r *WASMReader) readSection() error {
 
 		r.didReadFunctions = true
 
+	case sectionIDTable:
+		if r.Module.Tables != nil {
+			return invalidDuplicateSectionError()
+		}
+
+		err = r.readTableSection()
+		if err != nil {
+			return err
+		}
+
 	case sectionIDMemory:
 		if r.Module.Memories != nil {
 			return invalidDuplicateSectionError()
@@ -149,6 +207,16 @@ func (r *WASMReader) readSection() error {
 			return err
 		}
 
+	case sectionIDGlobal:
+		if r.Module.Globals != nil {
+			return invalidDuplicateSectionError()
+		}
+
+		err = r.readGlobalSection()
+		if err != nil {
+			return err
+		}
+
 	case sectionIDExport:
 		if r.Module.Exports != nil {
 			return invalidDuplicateSectionError()
@@ -169,6 +237,26 @@ func (r *WASMReader) readSection() error {
 			return err
 		}
 
+	case sectionIDElement:
+		if r.Module.Elements != nil {
+			return invalidDuplicateSectionError()
+		}
+
+		err = r.readElementSection()
+		if err != nil {
+			return err
+		}
+
+	case sectionIDDataCount:
+		if r.Module.DataCount != nil {
+			return invalidDuplicateSectionError()
+		}
+
+		err = r.readDataCountSection()
+		if err != nil {
+			return err
+		}
+
 	case sectionIDCode:
 		if r.didReadCode {
 			return invalidDuplicateSectionError()
@@ -220,6 +308,8 @@ func (r *WASMReader) readSectionSize() (uint32, error) {
 		}
 	}
 
+	r.sectionEnd = r.buf.offset + offset(size)
+
 	return size, nil
 }
 
@@ -242,7 +332,12 @@ func (r *WASMReader) readTypeSection() error {
 		}
 	}
 
-	funcTypes := make([]*FunctionType, count)
+	err = r.checkSectionVectorLimit(count, countOffset)
+	if err != nil {
+		return err
+	}
+
+	funcTypes := make([]*FunctionType, 0, r.sectionVectorCapacity(count))
 
 	// read each type
 	for i := uint32(0); i < count; i++ {
@@ -250,7 +345,7 @@ func (r *WASMReader) readTypeSection() error {
 		if err != nil {
 			return err
 		}
-		funcTypes[i] = funcType
+		funcTypes = append(funcTypes, funcType)
 	}
 
 	r.Module.Types = funcTypes
@@ -285,7 +380,12 @@ func (r *WASMReader) readFuncType() (*FunctionType, error) {
 
 	var parameterTypes []ValueType
 	if parameterCount > 0 {
-		parameterTypes = make([]ValueType, parameterCount)
+		err = r.checkSectionVectorLimit(parameterCount, parameterCountOffset)
+		if err != nil {
+			return nil, err
+		}
+
+		parameterTypes = make([]ValueType, 0, r.sectionVectorCapacity(parameterCount))
 
 		for i := uint32(0); i < parameterCount; i++ {
 			parameterType, err := r.readValType()
@@ -295,7 +395,7 @@ func (r *WASMReader) readFuncType() (*FunctionType, error) {
 					ReadError: err,
 				}
 			}
-			parameterTypes[i] = parameterType
+			parameterTypes = append(parameterTypes, parameterType)
 		}
 	}
 
@@ -313,7 +413,12 @@ func (r *WASMReader) readFuncType() (*FunctionType, error) {
 
 	var resultTypes []ValueType
 	if resultCount > 0 {
-		resultTypes = make([]ValueType, resultCount)
+		err = r.checkSectionVectorLimit(resultCount, resultCountOffset)
+		if err != nil {
+			return nil, err
+		}
+
+		resultTypes = make([]ValueType, 0, r.sectionVectorCapacity(resultCount))
 		for i := uint32(0); i < resultCount; i++ {
 			resultType, err := r.readValType()
 			if err != nil {
@@ -322,7 +427,7 @@ func (r *WASMReader) readFuncType() (*FunctionType, error) {
 					ReadError: err,
 				}
 			}
-			resultTypes[i] = resultType
+			resultTypes = append(resultTypes, resultType)
 		}
 	}
 
@@ -376,7 +481,12 @@ func (r *WASMReader) readImportSection() error {
 		}
 	}
 
-	imports := make([]*Import, count)
+	err = r.checkSectionVectorLimit(count, countOffset)
+	if err != nil {
+		return err
+	}
+
+	imports := make([]*Import, 0, r.sectionVectorCapacity(count))
 
 	// read each import
 	for i := uint32(0); i < count; i++ {
@@ -387,7 +497,7 @@ func (r *WASMReader) readImportSection() error {
 				ReadError: err,
 			}
 		}
-		imports[i] = im
+		imports = append(imports, im)
 	}
 
 	r.Module.Imports = imports
@@ -416,8 +526,7 @@ func (r *WASMReader) readImport() (*Import, error) {
 
 	indicator := importIndicator(b)
 
-	// TODO: add support for tables, memories, and globals. adjust name section!
-	if err != nil || indicator != importIndicatorFunction {
+	if err != nil {
 		return nil, InvalidImportIndicatorError{
 			ImportIndicator: indicator,
 			Offset:          int(indicatorOffset),
@@ -425,23 +534,127 @@ func (r *WASMReader) readImport() (*Import, error) {
 		}
 	}
 
-	// read the type index
+	var descriptor ImportDescriptor
+
+	switch indicator {
+	case importIndicatorFunction:
+		descriptor, err = r.readFunctionImport()
+
+	case importIndicatorTable:
+		descriptor, err = r.readTableImport()
+
+	case importIndicatorMemory:
+		descriptor, err = r.readMemoryImport()
+
+	case importIndicatorGlobal:
+		descriptor, err = r.readGlobalImport()
+
+	default:
+		return nil, InvalidImportIndicatorError{
+			ImportIndicator: indicator,
+			Offset:          int(indicatorOffset),
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &Import{
+		Module:     module,
+		Name:       name,
+		Descriptor: descriptor,
+	}, nil
+}
+
+// readFunctionImport reads the descriptor of a function import.
+// The type index must refer to a type declared in the type section
+func (r *WASMReader) readFunctionImport() (FunctionImport, error) {
 	typeIndexOffset := r.buf.offset
 	typeIndex, err := r.buf.readUint32LEB128()
 	if err != nil {
-		return nil, InvalidImportSectionTypeIndexError{
+		return FunctionImport{}, InvalidImportSectionTypeIndexError{
 			Offset:    int(typeIndexOffset),
 			ReadError: err,
 		}
 	}
 
-	return &Import{
-		Module:    module,
-		Name:      name,
+	if typeIndex >= uint32(len(r.Module.Types)) {
+		return FunctionImport{}, InvalidImportSectionTypeIndexError{
+			Offset: int(typeIndexOffset),
+		}
+	}
+
+	return FunctionImport{
 		TypeIndex: typeIndex,
 	}, nil
 }
 
+// readTableImport reads the descriptor of a table import
+func (r *WASMReader) readTableImport() (TableImport, error) {
+
+	// read the element type
+	elementTypeOffset := r.buf.offset
+	b, err := r.buf.ReadByte()
+	if err != nil {
+		return TableImport{}, InvalidImportTableElementTypeError{
+			Offset:      int(elementTypeOffset),
+			ElementType: b,
+			ReadError:   err,
+		}
+	}
+
+	elementType := ValueType(b)
+
+	switch elementType {
+	case ValueTypeFuncRef, ValueTypeExternRef:
+		break
+	default:
+		return TableImport{}, InvalidImportTableElementTypeError{
+			Offset:      int(elementTypeOffset),
+			ElementType: b,
+		}
+	}
+
+	// read the limit
+	min, max, err := r.readLimit()
+	if err != nil {
+		return TableImport{}, err
+	}
+
+	return TableImport{
+		ElementType: elementType,
+		Min:         min,
+		Max:         max,
+	}, nil
+}
+
+// readMemoryImport reads the descriptor of a memory import
+func (r *WASMReader) readMemoryImport() (MemoryImport, error) {
+	min, max, err := r.readLimit()
+	if err != nil {
+		return MemoryImport{}, err
+	}
+
+	return MemoryImport{
+		Min: min,
+		Max: max,
+	}, nil
+}
+
+// readGlobalImport reads the descriptor of a global import
+func (r *WASMReader) readGlobalImport() (GlobalImport, error) {
+	valueType, mutable, err := r.readGlobalType()
+	if err != nil {
+		return GlobalImport{}, err
+	}
+
+	return GlobalImport{
+		Type:    valueType,
+		Mutable: mutable,
+	}, nil
+}
+
 // readFunctionSection reads the section that declares the types of functions.
 // The bodies of these functions will later be provided in the code section
 func (r *WASMReader) readFunctionSection() error {
@@ -461,7 +674,12 @@ func (r *WASMReader) readFunctionSection() error {
 		}
 	}
 
-	functionTypeIndices := make([]uint32, count)
+	err = r.checkSectionVectorLimit(count, countOffset)
+	if err != nil {
+		return err
+	}
+
+	functionTypeIndices := make([]uint32, 0, r.sectionVectorCapacity(count))
 
 	// read the type index for each function
 	for i := uint32(0); i < count; i++ {
@@ -474,7 +692,7 @@ func (r *WASMReader) readFunctionSection() error {
 				ReadError: err,
 			}
 		}
-		functionTypeIndices[i] = typeIndex
+		functionTypeIndices = append(functionTypeIndices, typeIndex)
 	}
 
 	if !r.ensureModuleFunctions(len(functionTypeIndices)) {
@@ -503,6 +721,87 @@ func (r *WASMReader) ensureModuleFunctions(count int) bool {
 	return true
 }
 
+// readTableSection reads the section that declares the tables
+func (r *WASMReader) readTableSection() error {
+
+	_, err := r.readSectionSize()
+	if err != nil {
+		return err
+	}
+
+	// read the number of tables
+	countOffset := r.buf.offset
+	count, err := r.buf.readUint32LEB128()
+	if err != nil {
+		return InvalidTableSectionTableCountError{
+			Offset:    int(countOffset),
+			ReadError: err,
+		}
+	}
+
+	err = r.checkSectionVectorLimit(count, countOffset)
+	if err != nil {
+		return err
+	}
+
+	tables := make([]*Table, 0, r.sectionVectorCapacity(count))
+
+	// read each table
+	for i := uint32(0); i < count; i++ {
+		table, err := r.readTable()
+		if err != nil {
+			return InvalidTableError{
+				Index:     int(i),
+				ReadError: err,
+			}
+		}
+		tables = append(tables, table)
+	}
+
+	r.Module.Tables = tables
+
+	return nil
+}
+
+// readTable reads a table in the table section
+func (r *WASMReader) readTable() (*Table, error) {
+
+	// read the element type
+	elementTypeOffset := r.buf.offset
+	b, err := r.buf.ReadByte()
+	if err != nil {
+		return nil, InvalidTableElementTypeError{
+			Offset:      int(elementTypeOffset),
+			ElementType: b,
+			ReadError:   err,
+		}
+	}
+
+	elementType := ValueType(b)
+
+	switch elementType {
+	case ValueTypeFuncRef, ValueTypeExternRef:
+		break
+	default:
+		return nil, InvalidTableElementTypeError{
+			Offset:      int(elementTypeOffset),
+			ElementType: b,
+		}
+	}
+
+	// read the limit
+	min, max, err := r.readLimit()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Table{
+		ElementType: elementType,
+		Min:         min,
+		Max:         max,
+	}, nil
+}
+
 // readMemorySection reads the section that declares the memories
 func (r *WASMReader) readMemorySection() error {
 
@@ -521,7 +820,12 @@ func (r *WASMReader) readMemorySection() error {
 		}
 	}
 
-	memories := make([]*Memory, count)
+	err = r.checkSectionVectorLimit(count, countOffset)
+	if err != nil {
+		return err
+	}
+
+	memories := make([]*Memory, 0, r.sectionVectorCapacity(count))
 
 	// read each memory
 	for i := uint32(0); i < count; i++ {
@@ -532,7 +836,7 @@ func (r *WASMReader) readMemorySection() error {
 				ReadError: err,
 			}
 		}
-		memories[i] = im
+		memories = append(memories, im)
 	}
 
 	r.Module.Memories = memories
@@ -553,6 +857,110 @@ func (r *WASMReader) readMemory() (*Memory, error) {
 	}, nil
 }
 
+// readGlobalSection reads the section that declares the globals
+func (r *WASMReader) readGlobalSection() error {
+
+	_, err := r.readSectionSize()
+	if err != nil {
+		return err
+	}
+
+	// read the number of globals
+	countOffset := r.buf.offset
+	count, err := r.buf.readUint32LEB128()
+	if err != nil {
+		return InvalidGlobalSectionGlobalCountError{
+			Offset:    int(countOffset),
+			ReadError: err,
+		}
+	}
+
+	err = r.checkSectionVectorLimit(count, countOffset)
+	if err != nil {
+		return err
+	}
+
+	globals := make([]*Global, 0, r.sectionVectorCapacity(count))
+
+	// read each global
+	for i := uint32(0); i < count; i++ {
+		global, err := r.readGlobal()
+		if err != nil {
+			return InvalidGlobalError{
+				Index:     int(i),
+				ReadError: err,
+			}
+		}
+		globals = append(globals, global)
+	}
+
+	r.Module.Globals = globals
+
+	return nil
+}
+
+// readGlobal reads a global in the global section
+func (r *WASMReader) readGlobal() (*Global, error) {
+
+	// read the global type
+	valueType, mutable, err := r.readGlobalType()
+	if err != nil {
+		return nil, err
+	}
+
+	// read the init instructions
+	instructions, err := r.readInstructions()
+	if err != nil {
+		return nil, err
+	}
+
+	err = ValidateConstExpr(instructions)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Global{
+		Type:    valueType,
+		Mutable: mutable,
+		Init:    instructions,
+	}, nil
+}
+
+// readGlobalType reads the value type and mutability of a global
+func (r *WASMReader) readGlobalType() (valueType ValueType, mutable bool, err error) {
+
+	// read the type
+	valueType, err = r.readValType()
+	if err != nil {
+		return 0, false, err
+	}
+
+	// read the mutability
+	mutabilityOffset := r.buf.offset
+	b, err := r.buf.ReadByte()
+	if err != nil {
+		return 0, false, InvalidGlobalMutabilityError{
+			Offset:     int(mutabilityOffset),
+			Mutability: b,
+			ReadError:  err,
+		}
+	}
+
+	switch globalMutability(b) {
+	case globalMutabilityConst:
+		mutable = false
+	case globalMutabilityVar:
+		mutable = true
+	default:
+		return 0, false, InvalidGlobalMutabilityError{
+			Offset:     int(mutabilityOffset),
+			Mutability: b,
+		}
+	}
+
+	return valueType, mutable, nil
+}
+
 // readLimit reads a limit
 func (r *WASMReader) readLimit() (min uint32, max *uint32, err error) {
 	// read the limit indicator
@@ -626,7 +1034,12 @@ func (r *WASMReader) readExportSection() error {
 		}
 	}
 
-	exports := make([]*Export, count)
+	err = r.checkSectionVectorLimit(count, countOffset)
+	if err != nil {
+		return err
+	}
+
+	exports := make([]*Export, 0, r.sectionVectorCapacity(count))
 
 	// read each export
 	for i := uint32(0); i < count; i++ {
@@ -637,7 +1050,7 @@ func (r *WASMReader) readExportSection() error {
 				ReadError: err,
 			}
 		}
-		exports[i] = im
+		exports = append(exports, im)
 	}
 
 	r.Module.Exports = exports
@@ -683,11 +1096,23 @@ func (r *WASMReader) readExport() (*Export, error) {
 
 	switch indicator {
 	case exportIndicatorFunction:
+		if !r.Module.isValidFunctionIndex(index) {
+			return nil, InvalidExportSectionIndexError{
+				Offset: int(indexOffset),
+			}
+		}
+
 		descriptor = FunctionExport{
 			FunctionIndex: index,
 		}
 
 	case exportIndicatorMemory:
+		if !r.Module.isValidMemoryIndex(index) {
+			return nil, InvalidExportSectionIndexError{
+				Offset: int(indexOffset),
+			}
+		}
+
 		descriptor = MemoryExport{
 			MemoryIndex: index,
 		}
@@ -724,6 +1149,12 @@ func (r *WASMReader) readStartSection() error {
 		}
 	}
 
+	if !r.Module.isValidFunctionIndex(functionIndex) {
+		return InvalidStartSectionFunctionIndexError{
+			Offset: int(functionIndexOffset),
+		}
+	}
+
 	r.Module.StartFunctionIndex = &functionIndex
 
 	return nil
@@ -750,17 +1181,22 @@ func (r *WASMReader) readCodeSection() error {
 
 	// read the code of each function
 
-	functionBodies := make([]*Code, count)
+	err = r.checkSectionVectorLimit(count, countOffset)
+	if err != nil {
+		return err
+	}
+
+	functionBodies := make([]*Code, 0, r.sectionVectorCapacity(count))
 
 	for i := uint32(0); i < count; i++ {
-		functionBody, err := r.readFunctionBody()
+		functionBody, err := r.readFunctionBody(i)
 		if err != nil {
 			return InvalidFunctionCodeError{
 				Index:     int(i),
 				ReadError: err,
 			}
 		}
-		functionBodies[i] = functionBody
+		functionBodies = append(functionBodies, functionBody)
 	}
 
 	if !r.ensureModuleFunctions(len(functionBodies)) {
@@ -777,7 +1213,7 @@ func (r *WASMReader) readCodeSection() error {
 }
 
 // readFunctionBody reads the body (locals and instruction) of one function in the code section
-func (r *WASMReader) readFunctionBody() (*Code, error) {
+func (r *WASMReader) readFunctionBody(index uint32) (*Code, error) {
 
 	// read the size
 	sizeOffset := r.buf.offset
@@ -796,7 +1232,14 @@ func (r *WASMReader) readFunctionBody() (*Code, error) {
 		return nil, err
 	}
 
-	// read the instructions
+	// read the instructions,
+	// validating the local and global indices, if the function type is known
+
+	r.indexLimits = r.functionIndexLimits(index, locals)
+	defer func() {
+		r.indexLimits = nil
+	}()
+
 	instructions, err := r.readInstructions()
 	if err != nil {
 		return nil, err
@@ -808,26 +1251,55 @@ func (r *WASMReader) readFunctionBody() (*Code, error) {
 	}, nil
 }
 
+// functionIndexLimits returns the limits of the local and global indices
+// for the instructions of the function with the given index and locals,
+// or nil if the function or its type is unknown
+func (r *WASMReader) functionIndexLimits(index uint32, locals []ValueType) *instructionIndexLimits {
+	functions := r.Module.Functions
+	if index >= uint32(len(functions)) {
+		return nil
+	}
+
+	typeIndex := functions[index].TypeIndex
+	if typeIndex >= uint32(len(r.Module.Types)) {
+		return nil
+	}
+
+	functionType := r.Module.Types[typeIndex]
+
+	return &instructionIndexLimits{
+		localCount:  uint32(len(functionType.Params) + len(locals)),
+		globalCount: uint32(globalImportCount(r.Module.Imports) + len(r.Module.Globals)),
+	}
+}
+
 // readLocals reads the locals for one function in the code sections
 func (r *WASMReader) readLocals() ([]ValueType, error) {
-	// read the number of locals
-	localsCountOffset := r.buf.offset
-	localsCount, err := r.buf.readUint32LEB128()
+	// read the number of runs of locals.
+	// consecutive locals of the same type are compressed into runs
+	localsRunsCountOffset := r.buf.offset
+	localsRunsCount, err := r.buf.readUint32LEB128()
 	if err != nil {
 		return nil, InvalidCodeSectionLocalsCountError{
-			Offset:    int(localsCountOffset),
+			Offset:    int(localsRunsCountOffset),
 			ReadError: err,
 		}
 	}
 
-	if localsCount == 0 {
+	if localsRunsCount == 0 {
 		return nil, nil
 	}
 
-	locals := make([]ValueType, localsCount)
+	err = r.checkSectionVectorLimit(localsRunsCount, localsRunsCountOffset)
+	if err != nil {
+		return nil, err
+	}
 
-	// read each local
-	for i := uint32(0); i < localsCount; {
+	var locals []ValueType
+	var localsCount uint64
+
+	// read each run of locals, and expand it
+	for i := uint32(0); i < localsRunsCount; i++ {
 		compressedLocalsCountOffset := r.buf.offset
 		compressedLocalsCount, err := r.buf.readUint32LEB128()
 		if err != nil {
@@ -837,6 +1309,20 @@ func (r *WASMReader) readLocals() ([]ValueType, error) {
 			}
 		}
 
+		localsCount += uint64(compressedLocalsCount)
+		if localsCount > maxLocalsCount {
+			return nil, CodeSectionLocalsCountLimitError{
+				Offset: int(compressedLocalsCountOffset),
+				Count:  localsCount,
+			}
+		}
+
+		// the locals of a run are expanded, so check the total number of locals
+		err = r.checkSectionVectorLimit(uint32(localsCount), compressedLocalsCountOffset)
+		if err != nil {
+			return nil, err
+		}
+
 		localTypeOffset := r.buf.offset
 		localType, err := r.readValType()
 		if err != nil {
@@ -846,15 +1332,8 @@ func (r *WASMReader) readLocals() ([]ValueType, error) {
 			}
 		}
 
-		locals[i] = localType
-
-		i += compressedLocalsCount
-
-		if i > localsCount {
-			return nil, CodeSectionLocalsCountMismatchError{
-				Actual:   i,
-				Expected: localsCount,
-			}
+		for j := uint32(0); j < compressedLocalsCount; j++ {
+			locals = append(locals, localType)
 		}
 	}
 
@@ -890,9 +1369,15 @@ func (r *WASMReader) readName() (string, error) {
 		}
 	}
 
-	// read the name
+	err = r.checkSectionVectorLimit(length, lengthOffset)
+	if err != nil {
+		return "", err
+	}
+
+	// read the name.
+	// a name longer than the remaining bytes is incomplete, see below
 	nameOffset := r.buf.offset
-	name := make([]byte, length)
+	name := make([]byte, r.sectionVectorCapacity(length))
 	n, err := r.buf.Read(name)
 	if err != nil {
 		return "", InvalidNameError{
@@ -937,6 +1422,46 @@ func (r *WASMReader) readUint32LEB128InstructionArgument() (uint32, error) {
 	return v, nil
 }
 
+// readLocalIndexInstructionArgument reads a local index instruction argument
+// (in LEB128 format), and validates it, if the number of locals is known
+func (r *WASMReader) readLocalIndexInstructionArgument() (uint32, error) {
+	offset := r.buf.offset
+	index, err := r.readUint32LEB128InstructionArgument()
+	if err != nil {
+		return 0, err
+	}
+
+	if r.indexLimits != nil && index >= r.indexLimits.localCount {
+		return 0, InvalidLocalIndexError{
+			Index:  index,
+			Max:    r.indexLimits.localCount,
+			Offset: int(offset),
+		}
+	}
+
+	return index, nil
+}
+
+// readGlobalIndexInstructionArgument reads a global index instruction argument
+// (in LEB128 format), and validates it, if the number of globals is known
+func (r *WASMReader) readGlobalIndexInstructionArgument() (uint32, error) {
+	offset := r.buf.offset
+	index, err := r.readUint32LEB128InstructionArgument()
+	if err != nil {
+		return 0, err
+	}
+
+	if r.indexLimits != nil && index >= r.indexLimits.globalCount {
+		return 0, InvalidGlobalIndexError{
+			Index:  index,
+			Max:    r.indexLimits.globalCount,
+			Offset: int(offset),
+		}
+	}
+
+	return index, nil
+}
+
 // readInt32LEB128InstructionArgument reads an int32 instruction argument
 // (in LEB128 format)
 func (r *WASMReader) readInt32LEB128InstructionArgument() (int32, error) {
@@ -965,6 +1490,65 @@ func (r *WASMReader) readInt64LEB128InstructionArgument() (int64, error) {
 	return v, nil
 }
 
+// readValueTypeInstructionArgument reads a value type instruction argument
+func (r *WASMReader) readValueTypeInstructionArgument() (ValueType, error) {
+	offset := r.buf.offset
+	valueType, err := r.readValType()
+	if err != nil {
+		return 0, InvalidInstructionArgumentError{
+			Offset:    int(offset),
+			ReadError: err,
+		}
+	}
+	return valueType, nil
+}
+
+// readByteInstructionArgument reads a byte instruction argument
+func (r *WASMReader) readByteInstructionArgument() (byte, error) {
+	offset := r.buf.offset
+	b, err := r.buf.ReadByte()
+	if err != nil {
+		return 0, InvalidInstructionArgumentError{
+			Offset:    int(offset),
+			ReadError: err,
+		}
+	}
+	return b, nil
+}
+
+// readReservedByteInstructionArgument reads a reserved byte instruction argument,
+// which must be zero
+func (r *WASMReader) readReservedByteInstructionArgument() error {
+	offset := r.buf.offset
+	b, err := r.buf.ReadByte()
+	if err != nil {
+		return InvalidInstructionArgumentError{
+			Offset:    int(offset),
+			ReadError: err,
+		}
+	}
+	if b != 0 {
+		return InvalidInstructionReservedByteError{
+			Offset: int(offset),
+			Byte:   b,
+		}
+	}
+	return nil
+}
+
+// readBytesInstructionArgument reads a fixed-length byte vector instruction argument
+func (r *WASMReader) readBytesInstructionArgument(data []byte) error {
+	offset := r.buf.offset
+	_, err := io.ReadFull(r.buf, data)
+	if err != nil {
+		return InvalidInstructionArgumentError{
+			Offset:    int(offset),
+			ReadError: err,
+		}
+	}
+	return nil
+}
+
 // readBlockInstructionArgument reads a block instruction argument
 func (r *WASMReader) readBlockInstructionArgument(allowElse bool) (Block, error) {
 	// read the block type.
@@ -1121,6 +1705,29 @@ func (r *WASMReader) readCustomSection() error {
 	return nil
 }
 
+// readDataCountSection reads the section that declares the number of data segments
+func (r *WASMReader) readDataCountSection() error {
+
+	_, err := r.readSectionSize()
+	if err != nil {
+		return err
+	}
+
+	// read the number of data segments
+	countOffset := r.buf.offset
+	count, err := r.buf.readUint32LEB128()
+	if err != nil {
+		return InvalidDataCountSectionCountError{
+			Offset:    int(countOffset),
+			ReadError: err,
+		}
+	}
+
+	r.Module.DataCount = &count
+
+	return nil
+}
+
 // readDataSection reads the section that declares the data segments
 func (r *WASMReader) readDataSection() error {
 
@@ -1139,7 +1746,19 @@ func (r *WASMReader) readDataSection() error {
 		}
 	}
 
-	segments := make([]*Data, count)
+	// the data count section, if any, must match the number of data segments
+	if r.Module.DataCount != nil && count != *r.Module.DataCount {
+		return DataCountMismatchError{
+			Offset: int(countOffset),
+		}
+	}
+
+	err = r.checkSectionVectorLimit(count, countOffset)
+	if err != nil {
+		return err
+	}
+
+	segments := make([]*Data, 0, r.sectionVectorCapacity(count))
 
 	// read each data segment
 	for i := uint32(0); i < count; i++ {
@@ -1150,7 +1769,7 @@ func (r *WASMReader) readDataSection() error {
 				ReadError: err,
 			}
 		}
-		segments[i] = segment
+		segments = append(segments, segment)
 	}
 
 	r.Module.Data = segments
@@ -1177,6 +1796,11 @@ func (r *WASMReader) readDataSegment() (*Data, error) {
 		return nil, err
 	}
 
+	err = ValidateConstExpr(instructions)
+	if err != nil {
+		return nil, err
+	}
+
 	// read the number of init bytes
 	countOffset := r.buf.offset
 	count, err := r.buf.readUint32LEB128()
@@ -1187,7 +1811,12 @@ func (r *WASMReader) readDataSegment() (*Data, error) {
 		}
 	}
 
-	init := make([]byte, count)
+	err = r.checkSectionVectorLimit(count, countOffset)
+	if err != nil {
+		return nil, err
+	}
+
+	init := make([]byte, 0, r.sectionVectorCapacity(count))
 
 	// read each init byte
 	for i := uint32(0); i < count; i++ {
@@ -1195,7 +1824,7 @@ func (r *WASMReader) readDataSegment() (*Data, error) {
 		if err != nil {
 			return nil, err
 		}
-		init[i] = b
+		init = append(init, b)
 	}
 
 	return &Data{
@@ -1205,6 +1834,150 @@ func (r *WASMReader) readDataSegment() (*Data, error) {
 	}, nil
 }
 
+// readElementSection reads the section that declares the element segments
+func (r *WASMReader) readElementSection() error {
+
+	_, err := r.readSectionSize()
+	if err != nil {
+		return err
+	}
+
+	// read the number of element segments
+	countOffset := r.buf.offset
+	count, err := r.buf.readUint32LEB128()
+	if err != nil {
+		return InvalidElementSectionSegmentCountError{
+			Offset:    int(countOffset),
+			ReadError: err,
+		}
+	}
+
+	err = r.checkSectionVectorLimit(count, countOffset)
+	if err != nil {
+		return err
+	}
+
+	segments := make([]*Element, 0, r.sectionVectorCapacity(count))
+
+	// read each element segment
+	for i := uint32(0); i < count; i++ {
+		segment, err := r.readElementSegment()
+		if err != nil {
+			return InvalidElementSegmentError{
+				Index:     int(i),
+				ReadError: err,
+			}
+		}
+		segments = append(segments, segment)
+	}
+
+	r.Module.Elements = segments
+
+	return nil
+}
+
+// readElementSegment reads a segment in the element section
+func (r *WASMReader) readElementSegment() (*Element, error) {
+
+	// read the flags.
+	// only active segments with function indices are supported
+	flagsOffset := r.buf.offset
+	rawFlags, err := r.buf.readUint32LEB128()
+	if err != nil {
+		return nil, InvalidElementSegmentFlagsError{
+			Offset:    int(flagsOffset),
+			ReadError: err,
+		}
+	}
+
+	flags := elementSegmentFlags(rawFlags)
+
+	var tableIndex uint32
+
+	switch flags {
+	case elementSegmentFlagsActive:
+		// the table index is implicitly 0
+
+	case elementSegmentFlagsActiveTableIndex:
+		// read the table index
+		tableIndexOffset := r.buf.offset
+		tableIndex, err = r.buf.readUint32LEB128()
+		if err != nil {
+			return nil, InvalidElementSectionTableIndexError{
+				Offset:    int(tableIndexOffset),
+				ReadError: err,
+			}
+		}
+
+	default:
+		return nil, InvalidElementSegmentFlagsError{
+			Offset: int(flagsOffset),
+			Flags:  rawFlags,
+		}
+	}
+
+	// read the offset instructions
+	instructions, err := r.readInstructions()
+	if err != nil {
+		return nil, err
+	}
+
+	err = ValidateConstExpr(instructions)
+	if err != nil {
+		return nil, err
+	}
+
+	if flags == elementSegmentFlagsActiveTableIndex {
+		// read the element kind
+		elementKindOffset := r.buf.offset
+		elementKind, err := r.buf.ReadByte()
+		if err != nil || elementKind != elementKindFunctionReference {
+			return nil, InvalidElementSegmentElementKindError{
+				Offset:      int(elementKindOffset),
+				ElementKind: elementKind,
+				ReadError:   err,
+			}
+		}
+	}
+
+	// read the number of function indices
+	countOffset := r.buf.offset
+	count, err := r.buf.readUint32LEB128()
+	if err != nil {
+		return nil, InvalidElementSectionFunctionIndexCountError{
+			Offset:    int(countOffset),
+			ReadError: err,
+		}
+	}
+
+	err = r.checkSectionVectorLimit(count, countOffset)
+	if err != nil {
+		return nil, err
+	}
+
+	functionIndices := make([]uint32, 0, r.sectionVectorCapacity(count))
+
+	// read each function index
+	for i := uint32(0); i < count; i++ {
+		functionIndexOffset := r.buf.offset
+		functionIndex, err := r.buf.readUint32LEB128()
+		if err != nil {
+			return nil, InvalidElementSectionFunctionIndexError{
+				Offset:    int(functionIndexOffset),
+				Index:     int(i),
+				ReadError: err,
+			}
+		}
+		functionIndices = append(functionIndices, functionIndex)
+	}
+
+	return &Element{
+		TableIndex:      tableIndex,
+		Offset:          instructions,
+		FunctionIndices: functionIndices,
+	}, nil
+}
+
 // readNameSection reads the section that provides names
 func (r *WASMReader) readNameSection(size uint32) error {
 
@@ -1215,6 +1988,10 @@ func (r *WASMReader) readNameSection(size uint32) error {
 }
 
 func (r *WASMReader) ReadModule() error {
+	if err := r.checkModuleSize(); err != nil {
+		return err
+	}
+
 	if err := r.readMagicAndVersion(); err != nil {
 		return err
 	}
@@ -1223,6 +2000,14 @@ func (r *WASMReader) ReadModule() error {
 		_, err := r.buf.PeekByte()
 		if err != nil {
 			if err == io.EOF {
+				// the data count section, if any, must match the number of data segments,
+				// even if the data section is absent
+				if r.Module.DataCount != nil && r.Module.Data == nil && *r.Module.DataCount != 0 {
+					return DataCountMismatchError{
+						Offset: int(r.buf.offset),
+					}
+				}
+
 				return nil
 			}
 
@@ -1234,3 +2019,69 @@ func (r *WASMReader) ReadModule() error {
 		}
 	}
 }
+
+// checkModuleSize checks that the size of the module does not exceed the module size limit
+func (r *WASMReader) checkModuleSize() error {
+	limit := r.Limits.MaxModuleSize
+	size := len(r.buf.data)
+	if limit > 0 && size > int(limit) {
+		return ModuleSizeLimitExceededError{
+			Size:  size,
+			Limit: limit,
+		}
+	}
+	return nil
+}
+
+// checkSectionVectorLimit checks that the given count of a vector in a section
+// does not exceed the section vector length limit.
+// The check must be performed before allocating the vector
+func (r *WASMReader) checkSectionVectorLimit(count uint32, countOffset offset) error {
+	limit := r.Limits.MaxSectionVectorLength
+	if limit > 0 && count > limit {
+		return SectionLimitExceededError{
+			Offset: int(countOffset),
+			Count:  count,
+			Limit:  limit,
+		}
+	}
+	return nil
+}
+
+// sectionVectorCapacity returns the capacity to allocate for a vector in a section with the given count.
+//
+// Each element of a vector occupies at least one byte,
+// so a count exceeding the remaining bytes of the section which is currently read (or the buffer)
+// is invalid, and reading the elements will fail.
+// The capacity is bounded by the remaining bytes, so an invalid count does not result in a large allocation
+func (r *WASMReader) sectionVectorCapacity(count uint32) int {
+	end := offset(len(r.buf.data))
+	if r.sectionEnd > 0 && r.sectionEnd < end {
+		end = r.sectionEnd
+	}
+
+	var remaining offset
+	if r.buf.offset < end {
+		remaining = end - r.buf.offset
+	}
+
+	if offset(count) > remaining {
+		return int(remaining)
+	}
+	return int(count)
+}
+
+// checkInstructionVectorCount checks that the given count of a vector argument of an instruction
+// does not exceed the instruction vector length limit.
+// The check must be performed before allocating the vector
+func (r *WASMReader) checkInstructionVectorCount(count uint32, countOffset offset) error {
+	limit := r.Limits.MaxInstructionVectorLength
+	if limit > 0 && count > limit {
+		return InstructionLimitExceededError{
+			Offset: int(countOffset),
+			Count:  count,
+			Limit:  limit,
+		}
+	}
+	return nil
+}
@@ -470,6 +470,8 @@ func TestWASMReader_readImportSection(t *testing.T) {
 	read := func(data []byte) ([]*Import, error) {
 		b := Buffer{data: data}
 		r := NewWASMReader(&b)
+		// function imports must refer to declared types
+		r.Module.Types = []*FunctionType{{}, {}}
 		err := r.readImportSection()
 		if err != nil {
 			return nil, err
@@ -504,9 +506,11 @@ func TestWASMReader_readImportSection(t *testing.T) {
 		assert.Equal(t,
 			[]*Import{
 				{
-					Module:    "foo",
-					Name:      "bar",
-					TypeIndex: 1,
+					Module: "foo",
+					Name:   "bar",
+					Descriptor: FunctionImport{
+						TypeIndex: 1,
+					},
 				},
 			},
 			typeIndices,
@@ -652,7 +656,7 @@ func TestWASMReader_readImportSection(t *testing.T) {
 			// name = "bar"
 			0x62, 0x61, 0x72,
 			// indicator
-			0x1,
+			0x4,
 		})
 		require.Error(t, err)
 		assert.Equal(t,
@@ -660,7 +664,7 @@ func TestWASMReader_readImportSection(t *testing.T) {
 				Index: 0,
 				ReadError: InvalidImportIndicatorError{
 					Offset:          14,
-					ImportIndicator: 0x1,
+					ImportIndicator: 0x4,
 					ReadError:       nil,
 				},
 			},
@@ -702,6 +706,41 @@ func TestWASMReader_readImportSection(t *testing.T) {
 		)
 		assert.Nil(t, funcTypes)
 	})
+
+	t.Run("unknown type index", func(t *testing.T) {
+
+		t.Parallel()
+
+		funcTypes, err := read([]byte{
+			// section size: 11 (LEB128)
+			0x8b, 0x80, 0x80, 0x80, 0x0,
+			// import count
+			0x1,
+			// module length
+			0x3,
+			// module = "foo"
+			0x66, 0x6f, 0x6f,
+			// name length
+			0x3,
+			// name = "bar"
+			0x62, 0x61, 0x72,
+			// indicator: function = 0
+			0x0,
+			// type index of function: 2
+			0x2,
+		})
+		require.Error(t, err)
+		assert.Equal(t,
+			InvalidImportError{
+				Index: 0,
+				ReadError: InvalidImportSectionTypeIndexError{
+					Offset: 15,
+				},
+			},
+			err,
+		)
+		assert.Nil(t, funcTypes)
+	})
 }
 
 func TestWASMReader_readFunctionSection(t *testing.T) {
@@ -1005,6 +1044,8 @@ func TestWASMReader_readExportSection(t *testing.T) {
 	read := func(data []byte) ([]*Export, error) {
 		b := Buffer{data: data}
 		r := NewWASMReader(&b)
+		// declare two functions, so function indices 0 and 1 are valid
+		r.Module.Functions = []*Function{{}, {}}
 		err := r.readExportSection()
 		if err != nil {
 			return nil, err
@@ -1045,6 +1086,66 @@ func TestWASMReader_readExportSection(t *testing.T) {
 		)
 	})
 
+	t.Run("invalid function index", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := read([]byte{
+			// section size: 7 (LEB128)
+			0x87, 0x80, 0x80, 0x80, 0x0,
+			// export count: 1
+			0x1,
+			// name length
+			0x3,
+			// name = "foo"
+			0x66, 0x6f, 0x6f,
+			// indicator: function = 0
+			0x0,
+			// index of function: 2
+			0x2,
+		})
+		require.Error(t, err)
+		assert.Equal(t,
+			InvalidExportError{
+				Index: 0,
+				ReadError: InvalidExportSectionIndexError{
+					Offset: 11,
+				},
+			},
+			err,
+		)
+	})
+
+	t.Run("invalid memory index", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := read([]byte{
+			// section size: 7 (LEB128)
+			0x87, 0x80, 0x80, 0x80, 0x0,
+			// export count: 1
+			0x1,
+			// name length
+			0x3,
+			// name = "foo"
+			0x66, 0x6f, 0x6f,
+			// indicator: memory = 2
+			0x2,
+			// index of memory: 0
+			0x0,
+		})
+		require.Error(t, err)
+		assert.Equal(t,
+			InvalidExportError{
+				Index: 0,
+				ReadError: InvalidExportSectionIndexError{
+					Offset: 11,
+				},
+			},
+			err,
+		)
+	})
+
 	t.Run("invalid size", func(t *testing.T) {
 
 		t.Parallel()
@@ -1205,6 +1306,8 @@ func TestWASMReader_readStartSection(t *testing.T) {
 	read := func(data []byte) (*uint32, error) {
 		b := Buffer{data: data}
 		r := NewWASMReader(&b)
+		// declare two functions, so function indices 0 and 1 are valid
+		r.Module.Functions = []*Function{{}, {}}
 		err := r.readStartSection()
 		if err != nil {
 			return nil, err
@@ -1233,6 +1336,25 @@ func TestWASMReader_readStartSection(t *testing.T) {
 		)
 	})
 
+	t.Run("invalid function index", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := read([]byte{
+			// section size: 1 (LEB128)
+			0x81, 0x80, 0x80, 0x80, 0x0,
+			// function index: 2
+			0x2,
+		})
+		require.Error(t, err)
+		assert.Equal(t,
+			InvalidStartSectionFunctionIndexError{
+				Offset: 5,
+			},
+			err,
+		)
+	})
+
 	t.Run("invalid size", func(t *testing.T) {
 
 		t.Parallel()
@@ -1332,6 +1454,145 @@ func TestWASMReader_readCodeSection(t *testing.T) {
 		)
 	})
 
+	readWithFunctionType := func(functionType *FunctionType, data []byte) ([]*Function, error) {
+		b := Buffer{data: data}
+		r := NewWASMReader(&b)
+		r.Module.Types = []*FunctionType{functionType}
+		r.Module.Functions = []*Function{
+			{TypeIndex: 0},
+		}
+		err := r.readCodeSection()
+		if err != nil {
+			return nil, err
+		}
+		require.Equal(t, offset(len(b.data)), b.offset)
+		return r.Module.Functions, nil
+	}
+
+	t.Run("valid local index", func(t *testing.T) {
+
+		t.Parallel()
+
+		codes, err := readWithFunctionType(
+			&FunctionType{
+				Params: []ValueType{ValueTypeI32},
+			},
+			[]byte{
+				// section size: 15 (LEB128)
+				0x8f, 0x80, 0x80, 0x80, 0x0,
+				// function count: 1
+				0x1,
+				// code size: 9 (LEB128)
+				0x89, 0x80, 0x80, 0x80, 0x0,
+				// number of locals: 1
+				0x1,
+				// number of locals with this type: 1
+				0x1,
+				// local type: i32
+				0x7f,
+				// opcode: local.get, 0 (parameter)
+				0x20, 0x0,
+				// opcode: local.get 1 (local)
+				0x20, 0x1,
+				// opcode: i32.add
+				0x6a,
+				// opcode: end
+				0xb,
+			},
+		)
+		require.NoError(t, err)
+		assert.Equal(t,
+			[]Instruction{
+				InstructionLocalGet{LocalIndex: 0},
+				InstructionLocalGet{LocalIndex: 1},
+				InstructionI32Add{},
+			},
+			codes[0].Code.Instructions,
+		)
+	})
+
+	t.Run("invalid local index", func(t *testing.T) {
+
+		t.Parallel()
+
+		codes, err := readWithFunctionType(
+			&FunctionType{
+				Params: []ValueType{ValueTypeI32},
+			},
+			[]byte{
+				// section size: 15 (LEB128)
+				0x8f, 0x80, 0x80, 0x80, 0x0,
+				// function count: 1
+				0x1,
+				// code size: 9 (LEB128)
+				0x89, 0x80, 0x80, 0x80, 0x0,
+				// number of locals: 1
+				0x1,
+				// number of locals with this type: 1
+				0x1,
+				// local type: i32
+				0x7f,
+				// opcode: local.get, 0 (parameter)
+				0x20, 0x0,
+				// opcode: local.get 2 (invalid)
+				0x20, 0x2,
+				// opcode: i32.add
+				0x6a,
+				// opcode: end
+				0xb,
+			},
+		)
+		require.Error(t, err)
+		assert.Equal(t,
+			InvalidFunctionCodeError{
+				Index: 0,
+				ReadError: InvalidLocalIndexError{
+					Index:  2,
+					Max:    2,
+					Offset: 17,
+				},
+			},
+			err,
+		)
+		assert.Nil(t, codes)
+	})
+
+	t.Run("invalid global index", func(t *testing.T) {
+
+		t.Parallel()
+
+		codes, err := readWithFunctionType(
+			&FunctionType{},
+			[]byte{
+				// section size: 10 (LEB128)
+				0x8a, 0x80, 0x80, 0x80, 0x0,
+				// function count: 1
+				0x1,
+				// code size: 4 (LEB128)
+				0x84, 0x80, 0x80, 0x80, 0x0,
+				// number of locals: 0
+				0x0,
+				// opcode: global.get, 0 (invalid)
+				0x23, 0x0,
+				// opcode: end
+				0xb,
+			},
+		)
+		require.Error(t, err)
+		assert.Equal(t,
+			InvalidFunctionCodeError{
+				Index: 0,
+				ReadError: InvalidGlobalIndexError{
+					Index:  0,
+					Max:    0,
+					Offset: 13,
+				},
+			},
+			err,
+		)
+		assert.Nil(t, codes)
+	})
+
 	t.Run("invalid size", func(t *testing.T) {
 
 		t.Parallel()
@@ -1447,6 +1708,44 @@ func TestWASMReader_readCodeSection(t *testing.T) {
 		assert.Nil(t, funcTypes)
 	})
 
+	t.Run("locals count exceeds limit", func(t *testing.T) {
+
+		t.Parallel()
+
+		b := Buffer{
+			data: []byte{
+				// section size: 12 (LEB128)
+				0x8c, 0x80, 0x80, 0x80, 0x0,
+				// function count: 1
+				0x1,
+				// code size: 6 (LEB128)
+				0x86, 0x80, 0x80, 0x80, 0x0,
+				// number of locals: 1
+				0x1,
+				// number of locals with this type: 3
+				0x3,
+				// type of local: i32
+				0x7f,
+			},
+		}
+		r := NewWASMReader(&b)
+		r.Limits.MaxSectionVectorLength = 2
+
+		err := r.readCodeSection()
+		require.Error(t, err)
+		assert.Equal(t,
+			InvalidFunctionCodeError{
+				Index: 0,
+				ReadError: SectionLimitExceededError{
+					Offset: 12,
+					Count:  3,
+					Limit:  2,
+				},
+			},
+			err,
+		)
+	})
+
 	t.Run("invalid local type", func(t *testing.T) {
 
 		t.Parallel()
@@ -2190,6 +2489,69 @@ func TestWASMReader_readInstruction(t *testing.T) {
 		require.Equal(t, expected, actual)
 		require.Equal(t, offset(len(b.data)), b.offset)
 	})
+
+	t.Run("br_table, count exceeds remaining data", func(t *testing.T) {
+
+		t.Parallel()
+
+		b := Buffer{
+			data: []byte{
+				// br_table
+				0x0e,
+				// number of branch depths: 0xFFFFFFFF (LEB128)
+				0xff, 0xff, 0xff, 0xff, 0x0f,
+				// 1. branch depth
+				0x00,
+				// default branch depth
+				0x00,
+			},
+			offset: 0,
+		}
+		r := NewWASMReader(&b)
+
+		_, err := r.readInstruction()
+		require.Equal(t,
+			InvalidInstructionVectorArgumentCountError{
+				Offset: 1,
+			},
+			err,
+		)
+	})
+
+	t.Run("br_table, count exceeds limit", func(t *testing.T) {
+
+		t.Parallel()
+
+		b := Buffer{
+			data: []byte{
+				// br_table
+				0x0e,
+				// number of branch depths
+				0x03,
+				// 1. branch depth
+				0x02,
+				// 2. branch depth
+				0x01,
+				// 3. branch depth
+				0x00,
+				// default branch depth
+				0x03,
+			},
+			offset: 0,
+		}
+		r := NewWASMReader(&b)
+		r.Limits.MaxInstructionVectorLength = 2
+
+		_, err := r.readInstruction()
+		require.Equal(t,
+			InstructionLimitExceededError{
+				Offset: 1,
+				Count:  3,
+				Limit:  2,
+			},
+			err,
+		)
+	})
 }
 
 func TestWASMReader_readNameSection(t *testing.T) {
@@ -2241,3 +2603,224 @@ func TestWASMReader_readNameSection(t *testing.T) {
 
 	require.Equal(t, offset(len(b.data)), b.offset)
 }
+
+func TestWASMReader_ReadModule(t *testing.T) {
+
+	t.Parallel()
+
+	read := func(sections ...byte) (Module, error) {
+		data := append(
+			[]byte{
+				// magic
+				0x0, 0x61, 0x73, 0x6d,
+				// version
+				0x1, 0x0, 0x0, 0x0,
+			},
+			sections...,
+		)
+		b := Buffer{data: data}
+		r := NewWASMReader(&b)
+		err := r.ReadModule()
+		return r.Module, err
+	}
+
+	typeSection := []byte{
+		// section ID: type = 1
+		0x1,
+		// section size: 4 (LEB128)
+		0x84, 0x80, 0x80, 0x80, 0x0,
+		// type count: 1
+		0x1,
+		// function type indicator
+		0x60,
+		// parameter count: 0
+		0x0,
+		// result count: 0
+		0x0,
+	}
+
+	functionSection := []byte{
+		// section ID: function = 3
+		0x3,
+		// section size: 2 (LEB128)
+		0x82, 0x80, 0x80, 0x80, 0x0,
+		// function count: 1
+		0x1,
+		// type index of function: 0
+		0x0,
+	}
+
+	customSection := []byte{
+		// section ID: custom = 0
+		0x0,
+		// section size: 4 (LEB128)
+		0x84, 0x80, 0x80, 0x80, 0x0,
+		// name length
+		0x3,
+		// name = "foo"
+		0x66, 0x6f, 0x6f,
+	}
+
+	concat := func(sections ...[]byte) (result []byte) {
+		for _, section := range sections {
+			result = append(result, section...)
+		}
+		return
+	}
+
+	t.Run("sections in order", func(t *testing.T) {
+
+		t.Parallel()
+
+		module, err := read(concat(typeSection, functionSection)...)
+		require.NoError(t, err)
+
+		assert.Len(t, module.Types, 1)
+		assert.Len(t, module.Functions, 1)
+	})
+
+	t.Run("section end is reset after section", func(t *testing.T) {
+
+		t.Parallel()
+
+		b := Buffer{data: typeSection}
+		r := NewWASMReader(&b)
+
+		err := r.readSection()
+		require.NoError(t, err)
+
+		assert.Equal(t, offset(0), r.sectionEnd)
+	})
+
+	t.Run("repeated custom sections anywhere", func(t *testing.T) {
+
+		t.Parallel()
+
+		module, err := read(
+			concat(
+				customSection,
+				typeSection,
+				customSection,
+				customSection,
+				functionSection,
+				customSection,
+			)...,
+		)
+		require.NoError(t, err)
+
+		assert.Len(t, module.Types, 1)
+		assert.Len(t, module.Functions, 1)
+	})
+
+	t.Run("function section before type section", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := read(concat(functionSection, typeSection)...)
+		require.Error(t, err)
+		assert.Equal(t,
+			InvalidSectionOrderError{
+				SectionID:         sectionIDType,
+				PreviousSectionID: sectionIDFunction,
+				Offset:            16,
+			},
+			err,
+		)
+		assert.EqualError(t,
+			err,
+			"out-of-order section with ID 1 at offset 16: must not follow section with ID 3",
+		)
+	})
+
+	t.Run("function section before type section, separated by custom section", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := read(concat(functionSection, customSection, typeSection)...)
+		require.Error(t, err)
+		assert.Equal(t,
+			InvalidSectionOrderError{
+				SectionID:         sectionIDType,
+				PreviousSectionID: sectionIDFunction,
+				Offset:            26,
+			},
+			err,
+		)
+	})
+
+	t.Run("module size exceeds limit", func(t *testing.T) {
+
+		t.Parallel()
+
+		b := Buffer{
+			data: []byte{
+				// magic
+				0x0, 0x61, 0x73, 0x6d,
+				// version
+				0x1, 0x0, 0x0, 0x0,
+			},
+		}
+		r := NewWASMReader(&b)
+		r.Limits.MaxModuleSize = 7
+
+		err := r.ReadModule()
+		require.Equal(t,
+			ModuleSizeLimitExceededError{
+				Size:  8,
+				Limit: 7,
+			},
+			err,
+		)
+	})
+
+	hugeTypeCountModule := []byte{
+		// magic
+		0x0, 0x61, 0x73, 0x6d,
+		// version
+		0x1, 0x0, 0x0, 0x0,
+		// type section
+		0x01,
+		// section size: 5 (LEB128)
+		0x05,
+		// type count: 0xFFFFFFFF (LEB128)
+		0xff, 0xff, 0xff, 0xff, 0x0f,
+	}
+
+	t.Run("huge section vector count", func(t *testing.T) {
+
+		t.Parallel()
+
+		b := Buffer{data: hugeTypeCountModule}
+		r := NewWASMReader(&b)
+		r.Limits.MaxModuleSize = 64
+		r.Limits.MaxInstructionVectorLength = 16
+
+		err := r.ReadModule()
+		require.Equal(t,
+			InvalidFuncTypeIndicatorError{
+				Offset:    15,
+				ReadError: io.EOF,
+			},
+			err,
+		)
+	})
+
+	t.Run("section vector count exceeds limit", func(t *testing.T) {
+
+		t.Parallel()
+
+		b := Buffer{data: hugeTypeCountModule}
+		r := NewWASMReader(&b)
+		r.Limits.MaxSectionVectorLength = 16
+
+		err := r.ReadModule()
+		require.Equal(t,
+			SectionLimitExceededError{
+				Offset: 10,
+				Count:  0xFFFFFFFF,
+				Limit:  16,
+			},
+			err,
+		)
+	})
+}
@@ -36,16 +36,38 @@ package wasm
 // 9 = element section
 // 10 = code section
 // 11 = data section
+// 12 = data count section
 type sectionID byte
 
 const (
-	sectionIDCustom   sectionID = 0
-	sectionIDType     sectionID = 1
-	sectionIDImport   sectionID = 2
-	sectionIDFunction sectionID = 3
-	sectionIDMemory   sectionID = 5
-	sectionIDExport   sectionID = 7
-	sectionIDStart    sectionID = 8
-	sectionIDCode     sectionID = 10
-	sectionIDData     sectionID = 11
+	sectionIDCustom    sectionID = 0
+	sectionIDType      sectionID = 1
+	sectionIDImport    sectionID = 2
+	sectionIDFunction  sectionID = 3
+	sectionIDTable     sectionID = 4
+	sectionIDMemory    sectionID = 5
+	sectionIDGlobal    sectionID = 6
+	sectionIDExport    sectionID = 7
+	sectionIDStart     sectionID = 8
+	sectionIDElement   sectionID = 9
+	sectionIDCode      sectionID = 10
+	sectionIDData      sectionID = 11
+	sectionIDDataCount sectionID = 12
 )
+
+// order returns the position of the section in the prescribed order of sections.
+//
+// See https://webassembly.github.io/spec/core/binary/modules.html#binary-module:
+//
+// The data count section has the highest ID,
+// but must occur between the element section and the code section
+func (id sectionID) order() int {
+	switch {
+	case id == sectionIDDataCount:
+		return int(sectionIDCode)
+	case id >= sectionIDCode:
+		return int(id) + 1
+	default:
+		return int(id)
+	}
+}
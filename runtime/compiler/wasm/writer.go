@@ -20,12 +20,15 @@ package wasm
 
 import (
 	"fmt"
+	"io"
 	"unicode/utf8"
 )
 
 // WASMWriter allows writing WASM binaries
 type WASMWriter struct {
-	buf        *Buffer
+	buf *Buffer
+	// writer is the optional underlying writer, see Flush
+	writer     io.Writer
 	WriteNames bool
 }
 
@@ -35,6 +38,37 @@ func NewWASMWriter(buf *Buffer) *WASMWriter {
 	}
 }
 
+// NewWASMWriterToWriter returns a writer which writes to the given writer.
+//
+// The written data is buffered internally, as section sizes are only known
+// after the section contents have been written.
+// Flush must be called to write the buffered data to the underlying writer,
+// e.g. after writing the module
+func NewWASMWriterToWriter(writer io.Writer) *WASMWriter {
+	return &WASMWriter{
+		buf:    &Buffer{},
+		writer: writer,
+	}
+}
+
+// Flush writes the buffered data to the underlying writer, and resets the buffer.
+// It has no effect if the writer has no underlying writer, i.e. it writes to a buffer
+func (w *WASMWriter) Flush() error {
+	if w.writer == nil {
+		return nil
+	}
+
+	_, err := w.writer.Write(w.buf.data)
+	if err != nil {
+		return err
+	}
+
+	w.buf.data = w.buf.data[:0]
+	w.buf.offset = 0
+
+	return nil
+}
+
 // writeMagicAndVersion writes the magic byte sequence and version at the beginning of the WASM binary
 func (w *WASMWriter) writeMagicAndVersion() error {
 	err := w.buf.WriteBytes(wasmMagic)
@@ -188,15 +222,56 @@ func (w *WASMWriter) writeImport(im *Import) error {
 		return err
 	}
 
-	// TODO: add support for tables, memories, and globals. adjust name section!
-	// write the type indicator
-	err = w.buf.WriteByte(byte(importIndicatorFunction))
-	if err != nil {
-		return err
-	}
+	switch descriptor := im.Descriptor.(type) {
+	case FunctionImport:
+		// write the type indicator
+		err = w.buf.WriteByte(byte(importIndicatorFunction))
+		if err != nil {
+			return err
+		}
+
+		// write the type index
+		return w.buf.writeUint32LEB128(descriptor.TypeIndex)
+
+	case TableImport:
+		// write the type indicator
+		err = w.buf.WriteByte(byte(importIndicatorTable))
+		if err != nil {
+			return err
+		}
 
-	// write the type index
-	return w.buf.writeUint32LEB128(im.TypeIndex)
+		// write the element type
+		err = w.buf.WriteByte(byte(descriptor.ElementType))
+		if err != nil {
+			return err
+		}
+
+		// write the limit
+		return w.writeLimit(descriptor.Max, descriptor.Min)
+
+	case MemoryImport:
+		// write the type indicator
+		err = w.buf.WriteByte(byte(importIndicatorMemory))
+		if err != nil {
+			return err
+		}
+
+		// write the limit
+		return w.writeLimit(descriptor.Max, descriptor.Min)
+
+	case GlobalImport:
+		// write the type indicator
+		err = w.buf.WriteByte(byte(importIndicatorGlobal))
+		if err != nil {
+			return err
+		}
+
+		// write the global type
+		return w.writeGlobalType(descriptor.Type, descriptor.Mutable)
+
+	default:
+		return fmt.Errorf("unsupported import descriptor: %T", descriptor)
+	}
 }
 
 // writeFunctionSection writes the section that declares the types of functions.
@@ -221,6 +296,39 @@ func (w *WASMWriter) writeFunctionSection(functions []*Function) error {
 	})
 }
 
+// writeTableSection writes the section that declares all tables
+func (w *WASMWriter) writeTableSection(tables []*Table) error {
+	return w.writeSection(sectionIDTable, func() error {
+		// write the number of tables
+		err := w.buf.writeUint32LEB128(uint32(len(tables)))
+		if err != nil {
+			return err
+		}
+
+		// write each table
+		for _, table := range tables {
+			err = w.writeTable(table)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// writeTable writes the table
+func (w *WASMWriter) writeTable(table *Table) error {
+	// write the element type
+	err := w.buf.WriteByte(byte(table.ElementType))
+	if err != nil {
+		return err
+	}
+
+	// write the limit
+	return w.writeLimit(table.Max, table.Min)
+}
+
 // writeMemorySection writes the section that declares all memories
 func (w *WASMWriter) writeMemorySection(memories []*Memory) error {
 	return w.writeSection(sectionIDMemory, func() error {
@@ -278,6 +386,69 @@ func (w *WASMWriter) writeLimit(max *uint32, min uint32) error {
 	return nil
 }
 
+// writeGlobalSection writes the section that declares all globals
+func (w *WASMWriter) writeGlobalSection(globals []*Global) error {
+	return w.writeSection(sectionIDGlobal, func() error {
+
+		// write the number of globals
+		err := w.buf.writeUint32LEB128(uint32(len(globals)))
+		if err != nil {
+			return err
+		}
+
+		// write each global
+		for _, global := range globals {
+			err = w.writeGlobal(global)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// writeGlobal writes the global
+func (w *WASMWriter) writeGlobal(global *Global) error {
+
+	err := ValidateConstExpr(global.Init)
+	if err != nil {
+		return err
+	}
+
+	// write the global type
+	err = w.writeGlobalType(global.Type, global.Mutable)
+	if err != nil {
+		return err
+	}
+
+	// write the init instructions
+	err = w.writeInstructions(global.Init)
+	if err != nil {
+		return err
+	}
+
+	return w.writeOpcode(opcodeEnd)
+}
+
+// writeGlobalType writes the value type and mutability of a global
+func (w *WASMWriter) writeGlobalType(valueType ValueType, mutable bool) error {
+
+	// write the type
+	err := w.buf.WriteByte(byte(valueType))
+	if err != nil {
+		return err
+	}
+
+	// write the mutability
+	mutability := globalMutabilityConst
+	if mutable {
+		mutability = globalMutabilityVar
+	}
+
+	return w.buf.WriteByte(byte(mutability))
+}
+
 // writeExportSection writes the section that declares all exports
 func (w *WASMWriter) writeExportSection(exports []*Export) error {
 	return w.writeSection(sectionIDExport, func() error {
@@ -335,6 +506,29 @@ func (w *WASMWriter) writeExport(export *Export) error {
 	return w.buf.writeUint32LEB128(index)
 }
 
+// validateExports ensures that the indices of the exports of the given module are in range
+func validateExports(module *Module) error {
+	for _, export := range module.Exports {
+		switch descriptor := export.Descriptor.(type) {
+		case FunctionExport:
+			if !module.isValidFunctionIndex(descriptor.FunctionIndex) {
+				return InvalidExportFunctionIndexError{
+					Name:  export.Name,
+					Index: descriptor.FunctionIndex,
+				}
+			}
+		case MemoryExport:
+			if !module.isValidMemoryIndex(descriptor.MemoryIndex) {
+				return InvalidExportMemoryIndexError{
+					Name:  export.Name,
+					Index: descriptor.MemoryIndex,
+				}
+			}
+		}
+	}
+	return nil
+}
+
 // writeStartSection writes the section that declares the start function
 func (w *WASMWriter) writeStartSection(funcIndex uint32) error {
 	return w.writeSection(sectionIDStart, func() error {
@@ -370,21 +564,23 @@ func (w *WASMWriter) writeCodeSection(functions []*Function) error {
 func (w *WASMWriter) writeFunctionBody(code *Code) error {
 	return w.writeContentWithSize(func() error {
 
-		// write the number of locals
-		err := w.buf.writeUint32LEB128(uint32(len(code.Locals)))
+		// consecutive locals of the same type are compressed into runs
+		localsRuns := compressLocals(code.Locals)
+
+		// write the number of runs of locals
+		err := w.buf.writeUint32LEB128(uint32(len(localsRuns)))
 		if err != nil {
 			return err
 		}
 
-		// TODO: run-length encode
-		// write each local
-		for _, localValType := range code.Locals {
-			err = w.buf.writeUint32LEB128(1)
+		// write each run of locals
+		for _, localsRun := range localsRuns {
+			err = w.buf.writeUint32LEB128(localsRun.Count)
 			if err != nil {
 				return err
 			}
 
-			err = w.buf.WriteByte(byte(localValType))
+			err = w.buf.WriteByte(byte(localsRun.Type))
 			if err != nil {
 				return err
 			}
@@ -421,6 +617,16 @@ func (w *WASMWriter) writeOpcode(opcodes ...opcode) error {
 	return nil
 }
 
+// writePrefixedOpcode writes the given prefix byte,
+// followed by the given opcode as a LEB128-encoded uint32
+func (w *WASMWriter) writePrefixedOpcode(prefix opcode, c prefixedOpcode) error {
+	err := w.buf.WriteByte(byte(prefix))
+	if err != nil {
+		return err
+	}
+	return w.buf.writeUint32LEB128(uint32(c))
+}
+
 // writeName writes a name, a UTF-8 byte sequence
 func (w *WASMWriter) writeName(name string) error {
 
@@ -545,7 +751,7 @@ func (w *WASMWriter) writeNameSectionFunctionNamesSubSection(imports []*Import,
 	return w.writeNameSubSection(nameSubSectionIDFunctionNames, func() error {
 
 		// write the number of function names
-		count := len(imports) + len(functions)
+		count := functionImportCount(imports) + len(functions)
 
 		err := w.buf.writeUint32LEB128(uint32(count))
 		if err != nil {
@@ -558,6 +764,12 @@ func (w *WASMWriter) writeNameSectionFunctionNamesSubSection(imports []*Import,
 
 		for _, imp := range imports {
 
+			// only functions are named in the function name sub-section,
+			// and function indices only include function imports
+			if _, ok := imp.Descriptor.(FunctionImport); !ok {
+				continue
+			}
+
 			// write the index
 			err := w.buf.writeUint32LEB128(index)
 			if err != nil {
@@ -598,6 +810,14 @@ func (w *WASMWriter) writeNameSectionFunctionNamesSubSection(imports []*Import,
 	})
 }
 
+// writeDataCountSection writes the section that declares the number of data segments
+func (w *WASMWriter) writeDataCountSection(count uint32) error {
+	return w.writeSection(sectionIDDataCount, func() error {
+		// write the number of data segments
+		return w.buf.writeUint32LEB128(count)
+	})
+}
+
 // writeDataSection writes the section that declares the data segments
 func (w *WASMWriter) writeDataSection(segments []*Data) error {
 	return w.writeSection(sectionIDData, func() error {
@@ -622,8 +842,13 @@ func (w *WASMWriter) writeDataSection(segments []*Data) error {
 // writeDataSegment writes the data segment
 func (w *WASMWriter) writeDataSegment(segment *Data) error {
 
+	err := ValidateConstExpr(segment.Offset)
+	if err != nil {
+		return err
+	}
+
 	// write the memory index
-	err := w.buf.writeUint32LEB128(segment.MemoryIndex)
+	err = w.buf.writeUint32LEB128(segment.MemoryIndex)
 	if err != nil {
 		return err
 	}
@@ -656,6 +881,95 @@ func (w *WASMWriter) writeDataSegment(segment *Data) error {
 	return nil
 }
 
+// writeElementSection writes the section that declares the element segments
+func (w *WASMWriter) writeElementSection(segments []*Element) error {
+	return w.writeSection(sectionIDElement, func() error {
+		// write the number of element segments
+		err := w.buf.writeUint32LEB128(uint32(len(segments)))
+		if err != nil {
+			return err
+		}
+
+		// write each element segment
+		for _, segment := range segments {
+			err = w.writeElementSegment(segment)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// writeElementSegment writes the element segment
+func (w *WASMWriter) writeElementSegment(segment *Element) error {
+
+	err := ValidateConstExpr(segment.Offset)
+	if err != nil {
+		return err
+	}
+
+	// segments for table 0 use the original encoding without a table index,
+	// segments for other tables have an explicit table index and element kind
+
+	flags := elementSegmentFlagsActive
+	if segment.TableIndex != 0 {
+		flags = elementSegmentFlagsActiveTableIndex
+	}
+
+	// write the flags
+	err = w.buf.writeUint32LEB128(uint32(flags))
+	if err != nil {
+		return err
+	}
+
+	if flags == elementSegmentFlagsActiveTableIndex {
+		// write the table index
+		err = w.buf.writeUint32LEB128(segment.TableIndex)
+		if err != nil {
+			return err
+		}
+	}
+
+	// write the offset instructions
+	err = w.writeInstructions(segment.Offset)
+	if err != nil {
+		return err
+	}
+
+	err = w.writeOpcode(opcodeEnd)
+	if err != nil {
+		return err
+	}
+
+	if flags == elementSegmentFlagsActiveTableIndex {
+		// write the element kind
+		err = w.buf.WriteByte(elementKindFunctionReference)
+		if err != nil {
+			return err
+		}
+	}
+
+	// write the number of function indices
+	err = w.buf.writeUint32LEB128(uint32(len(segment.FunctionIndices)))
+	if err != nil {
+		return err
+	}
+
+	// write each function index
+	for _, functionIndex := range segment.FunctionIndices {
+		err = w.buf.writeUint32LEB128(functionIndex)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// WriteModule writes the given module, i.e. the magic and version,
+// followed by a section for each non-empty part of the module, in the order required by the spec
 func (w *WASMWriter) WriteModule(module *Module) error {
 	if err := w.writeMagicAndVersion(); err != nil {
 		return err
@@ -675,21 +989,54 @@ func (w *WASMWriter) WriteModule(module *Module) error {
 			return err
 		}
 	}
+	if len(module.Tables) > 0 {
+		if err := w.writeTableSection(module.Tables); err != nil {
+			return err
+		}
+	}
 	if len(module.Memories) > 0 {
 		if err := w.writeMemorySection(module.Memories); err != nil {
 			return err
 		}
 	}
+	if len(module.Globals) > 0 {
+		if err := w.writeGlobalSection(module.Globals); err != nil {
+			return err
+		}
+	}
 	if len(module.Exports) > 0 {
+		if err := validateExports(module); err != nil {
+			return err
+		}
 		if err := w.writeExportSection(module.Exports); err != nil {
 			return err
 		}
 	}
 	if module.StartFunctionIndex != nil {
+		if !module.isValidFunctionIndex(*module.StartFunctionIndex) {
+			return InvalidStartFunctionIndexError{
+				Index: *module.StartFunctionIndex,
+			}
+		}
 		if err := w.writeStartSection(*module.StartFunctionIndex); err != nil {
 			return err
 		}
 	}
+	if len(module.Elements) > 0 {
+		if err := w.writeElementSection(module.Elements); err != nil {
+			return err
+		}
+	}
+	if module.DataCount != nil {
+		if *module.DataCount != uint32(len(module.Data)) {
+			return DataCountMismatchError{
+				Offset: int(w.buf.offset),
+			}
+		}
+		if err := w.writeDataCountSection(*module.DataCount); err != nil {
+			return err
+		}
+	}
 	if len(module.Functions) > 0 {
 		if err := w.writeCodeSection(module.Functions); err != nil {
 			return err
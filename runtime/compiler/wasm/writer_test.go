@@ -19,6 +19,8 @@
 package wasm
 
 import (
+	"bytes"
+	"math"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -95,9 +97,11 @@ func TestWASMWriter_writeImportSection(t *testing.T) {
 
 	imports := []*Import{
 		{
-			Module:    "foo",
-			Name:      "bar",
-			TypeIndex: 1,
+			Module: "foo",
+			Name:   "bar",
+			Descriptor: FunctionImport{
+				TypeIndex: 1,
+			},
 		},
 	}
 
@@ -441,8 +445,9 @@ func TestWASMWriter_writeNameSection(t *testing.T) {
 
 	imports := []*Import{
 		{
-			Module: "foo",
-			Name:   "bar",
+			Module:     "foo",
+			Name:       "bar",
+			Descriptor: FunctionImport{},
 		},
 	}
 
@@ -521,9 +526,11 @@ func TestWASMWriterReader(t *testing.T) {
 		},
 		Imports: []*Import{
 			{
-				Module:    "env",
-				Name:      "add",
-				TypeIndex: 1,
+				Module: "env",
+				Name:   "add",
+				Descriptor: FunctionImport{
+					TypeIndex: 1,
+				},
 			},
 		},
 		Functions: []*Function{
@@ -975,3 +982,1456 @@ func TestWASMWriter_writeInstruction(t *testing.T) {
 		)
 	})
 }
+
+func TestWASMWriterToWriterReaderFromReader(t *testing.T) {
+
+	t.Parallel()
+
+	module := &Module{
+		Types: []*FunctionType{
+			{
+				Params:  []ValueType{ValueTypeI32, ValueTypeI32},
+				Results: []ValueType{ValueTypeI32},
+			},
+		},
+		Functions: []*Function{
+			{
+				TypeIndex: 0,
+				Code: &Code{
+					Instructions: []Instruction{
+						InstructionLocalGet{LocalIndex: 0},
+						InstructionLocalGet{LocalIndex: 1},
+						InstructionI32Add{},
+					},
+				},
+			},
+		},
+		Exports: []*Export{
+			{
+				Name: "add",
+				Descriptor: FunctionExport{
+					FunctionIndex: 0,
+				},
+			},
+		},
+	}
+
+	var b bytes.Buffer
+
+	w := NewWASMWriterToWriter(&b)
+	err := w.WriteModule(module)
+	require.NoError(t, err)
+
+	// Nothing is written to the underlying writer before flushing
+	require.Zero(t, b.Len())
+
+	err = w.Flush()
+	require.NoError(t, err)
+
+	require.NotZero(t, b.Len())
+
+	var expected Buffer
+	err = NewWASMWriter(&expected).WriteModule(module)
+	require.NoError(t, err)
+
+	require.Equal(t, expected.Bytes(), b.Bytes())
+
+	r, err := NewWASMReaderFromReader(&b)
+	require.NoError(t, err)
+
+	err = r.ReadModule()
+	require.NoError(t, err)
+
+	require.Equal(t, module, &r.Module)
+}
+
+func TestWASMWriterReader_exportAndStart(t *testing.T) {
+
+	t.Parallel()
+
+	module := &Module{
+		Types: []*FunctionType{
+			{},
+		},
+		Functions: []*Function{
+			{
+				TypeIndex: 0,
+				Code: &Code{
+					Instructions: []Instruction{
+						InstructionNop{},
+					},
+				},
+			},
+		},
+		Memories: []*Memory{
+			{
+				Min: 1,
+				Max: nil,
+			},
+		},
+		Exports: []*Export{
+			{
+				Name: "main",
+				Descriptor: FunctionExport{
+					FunctionIndex: 0,
+				},
+			},
+			{
+				Name: "mem",
+				Descriptor: MemoryExport{
+					MemoryIndex: 0,
+				},
+			},
+		},
+		StartFunctionIndex: func() *uint32 {
+			var index uint32 = 0
+			return &index
+		}(),
+	}
+
+	var b Buffer
+	err := NewWASMWriter(&b).WriteModule(module)
+	require.NoError(t, err)
+
+	b.offset = 0
+
+	r := NewWASMReader(&b)
+	err = r.ReadModule()
+	require.NoError(t, err)
+
+	require.Equal(t, module, &r.Module)
+
+	t.Run("invalid export function index", func(t *testing.T) {
+
+		t.Parallel()
+
+		var b Buffer
+		err := NewWASMWriter(&b).WriteModule(&Module{
+			Exports: []*Export{
+				{
+					Name: "main",
+					Descriptor: FunctionExport{
+						FunctionIndex: 0,
+					},
+				},
+			},
+		})
+		require.Equal(t,
+			InvalidExportFunctionIndexError{
+				Name:  "main",
+				Index: 0,
+			},
+			err,
+		)
+	})
+
+	t.Run("invalid export memory index", func(t *testing.T) {
+
+		t.Parallel()
+
+		var b Buffer
+		err := NewWASMWriter(&b).WriteModule(&Module{
+			Exports: []*Export{
+				{
+					Name: "mem",
+					Descriptor: MemoryExport{
+						MemoryIndex: 0,
+					},
+				},
+			},
+		})
+		require.Equal(t,
+			InvalidExportMemoryIndexError{
+				Name:  "mem",
+				Index: 0,
+			},
+			err,
+		)
+	})
+
+	t.Run("invalid start function index", func(t *testing.T) {
+
+		t.Parallel()
+
+		var index uint32 = 1
+		var b Buffer
+		err := NewWASMWriter(&b).WriteModule(&Module{
+			Types: []*FunctionType{{}},
+			Functions: []*Function{
+				{
+					TypeIndex: 0,
+					Code:      &Code{},
+				},
+			},
+			StartFunctionIndex: &index,
+		})
+		require.Equal(t,
+			InvalidStartFunctionIndexError{
+				Index: 1,
+			},
+			err,
+		)
+	})
+}
+
+func TestWASMWriterReader_module(t *testing.T) {
+
+	t.Parallel()
+
+	// a module with all kinds of sections,
+	// which exports a function adding two numbers,
+	// which is also referenced through a table
+
+	module := &Module{
+		Types: []*FunctionType{
+			{
+				Params:  []ValueType{ValueTypeI32, ValueTypeI32},
+				Results: []ValueType{ValueTypeI32},
+			},
+			{},
+		},
+		Imports: []*Import{
+			{
+				Module: "env",
+				Name:   "log",
+				Descriptor: FunctionImport{
+					TypeIndex: 1,
+				},
+			},
+		},
+		Functions: []*Function{
+			{
+				TypeIndex: 0,
+				Code: &Code{
+					Instructions: []Instruction{
+						InstructionLocalGet{LocalIndex: 0},
+						InstructionLocalGet{LocalIndex: 1},
+						InstructionI32Add{},
+					},
+				},
+			},
+			{
+				TypeIndex: 1,
+				Code: &Code{
+					Instructions: []Instruction{
+						InstructionCall{FuncIndex: 0},
+					},
+				},
+			},
+		},
+		Tables: []*Table{
+			{
+				ElementType: ValueTypeFuncRef,
+				Min:         1,
+				Max: func() *uint32 {
+					var max uint32 = 2
+					return &max
+				}(),
+			},
+		},
+		Memories: []*Memory{
+			{
+				Min: 1,
+			},
+		},
+		Globals: []*Global{
+			{
+				Type: ValueTypeI32,
+				Init: []Instruction{
+					InstructionI32Const{Value: 42},
+				},
+			},
+		},
+		Exports: []*Export{
+			{
+				Name: "add",
+				Descriptor: FunctionExport{
+					FunctionIndex: 1,
+				},
+			},
+		},
+		StartFunctionIndex: func() *uint32 {
+			var index uint32 = 2
+			return &index
+		}(),
+		Elements: []*Element{
+			{
+				TableIndex: 0,
+				Offset: []Instruction{
+					InstructionI32Const{Value: 0},
+				},
+				FunctionIndices: []uint32{1},
+			},
+		},
+		Data: []*Data{
+			{
+				MemoryIndex: 0,
+				Offset: []Instruction{
+					InstructionI32Const{Value: 0},
+				},
+				Init: []byte("add"),
+			},
+		},
+	}
+
+	var b Buffer
+	err := NewWASMWriter(&b).WriteModule(module)
+	require.NoError(t, err)
+
+	b.offset = 0
+
+	r := NewWASMReader(&b)
+	err = r.ReadModule()
+	require.NoError(t, err)
+
+	require.Equal(t, module, &r.Module)
+
+	t.Run("invalid table element type", func(t *testing.T) {
+
+		t.Parallel()
+
+		b := Buffer{
+			data: []byte{
+				// section size: 4 (LEB128)
+				0x84, 0x80, 0x80, 0x80, 0x0,
+				// table count: 1
+				0x1,
+				// element type: i32, invalid
+				0x7f,
+				// limit indicator: no max
+				0x0,
+				// min: 1
+				0x1,
+			},
+		}
+
+		r := NewWASMReader(&b)
+		err := r.readTableSection()
+		require.Error(t, err)
+
+		var tableErr InvalidTableError
+		require.ErrorAs(t, err, &tableErr)
+		require.Equal(t, 0, tableErr.Index)
+
+		var elementTypeErr InvalidTableElementTypeError
+		require.ErrorAs(t, err, &elementTypeErr)
+		require.Equal(t, 6, elementTypeErr.Offset)
+		require.Equal(t, byte(0x7f), elementTypeErr.ElementType)
+	})
+}
+
+// testInstructionWriteRead writes the given instruction, asserts the encoding is the expected one,
+// and reads it back, asserting the read instruction is the written one
+func testInstructionWriteRead(t *testing.T, instruction Instruction, expected []byte) {
+	var b Buffer
+	w := NewWASMWriter(&b)
+
+	err := instruction.write(w)
+	require.NoError(t, err)
+
+	require.Equal(t, expected, b.data)
+
+	b.offset = 0
+
+	r := NewWASMReader(&b)
+	actual, err := r.readInstruction()
+	require.NoError(t, err)
+
+	require.Equal(t, instruction, actual)
+	require.Equal(t, offset(len(b.data)), b.offset)
+}
+
+func TestWASMWriterReader_vectorInstructions(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("v128.const", func(t *testing.T) {
+
+		t.Parallel()
+
+		testInstructionWriteRead(t,
+			InstructionV128Const{
+				Value: [16]byte{
+					0x0, 0x1, 0x2, 0x3, 0x4, 0x5, 0x6, 0x7,
+					0x8, 0x9, 0xa, 0xb, 0xc, 0xd, 0xe, 0xf,
+				},
+			},
+			[]byte{
+				// v128.const
+				0xfd, 0x0c,
+				// value
+				0x0, 0x1, 0x2, 0x3, 0x4, 0x5, 0x6, 0x7,
+				0x8, 0x9, 0xa, 0xb, 0xc, 0xd, 0xe, 0xf,
+			},
+		)
+	})
+
+	t.Run("i32x4.replace_lane", func(t *testing.T) {
+
+		t.Parallel()
+
+		testInstructionWriteRead(t,
+			InstructionI32x4ReplaceLane{
+				LaneIndex: 3,
+			},
+			[]byte{
+				// i32x4.replace_lane
+				0xfd, 0x1c,
+				// lane index
+				0x3,
+			},
+		)
+	})
+
+	t.Run("i32x4.add", func(t *testing.T) {
+
+		t.Parallel()
+
+		testInstructionWriteRead(t,
+			InstructionI32x4Add{},
+			[]byte{
+				// i32x4.add: opcode 174 (LEB128)
+				0xfd, 0xae, 0x01,
+			},
+		)
+	})
+
+	t.Run("i32x4.add, non-canonical opcode", func(t *testing.T) {
+
+		t.Parallel()
+
+		b := Buffer{
+			data: []byte{
+				// i32x4.add: opcode 174 (LEB128, padded)
+				0xfd, 0xae, 0x81, 0x80, 0x00,
+			},
+		}
+
+		r := NewWASMReader(&b)
+		instruction, err := r.readInstruction()
+		require.NoError(t, err)
+
+		require.Equal(t, InstructionI32x4Add{}, instruction)
+		require.Equal(t, offset(len(b.data)), b.offset)
+	})
+
+	t.Run("v128.const, non-canonical opcode", func(t *testing.T) {
+
+		t.Parallel()
+
+		b := Buffer{
+			data: []byte{
+				// v128.const: opcode 12 (LEB128, padded)
+				0xfd, 0x8c, 0x00,
+				// value
+				0x0, 0x1, 0x2, 0x3, 0x4, 0x5, 0x6, 0x7,
+				0x8, 0x9, 0xa, 0xb, 0xc, 0xd, 0xe, 0xf,
+			},
+		}
+
+		r := NewWASMReader(&b)
+		instruction, err := r.readInstruction()
+		require.NoError(t, err)
+
+		require.Equal(t,
+			InstructionV128Const{
+				Value: [16]byte{
+					0x0, 0x1, 0x2, 0x3, 0x4, 0x5, 0x6, 0x7,
+					0x8, 0x9, 0xa, 0xb, 0xc, 0xd, 0xe, 0xf,
+				},
+			},
+			instruction,
+		)
+		require.Equal(t, offset(len(b.data)), b.offset)
+	})
+
+	t.Run("unknown opcode", func(t *testing.T) {
+
+		t.Parallel()
+
+		b := Buffer{
+			data: []byte{
+				// unknown SIMD opcode 0x3fff (LEB128)
+				0xfd, 0xff, 0x7f,
+			},
+		}
+
+		r := NewWASMReader(&b)
+		_, err := r.readInstruction()
+		require.Equal(t,
+			InvalidPrefixedOpcodeError{
+				Offset: 0,
+				Prefix: 0xfd,
+				Opcode: 0x3fff,
+			},
+			err,
+		)
+	})
+
+	t.Run("v128.const, missing bytes", func(t *testing.T) {
+
+		t.Parallel()
+
+		b := Buffer{
+			data: []byte{
+				// v128.const
+				0xfd, 0x0c,
+				// value, truncated
+				0x0, 0x1, 0x2,
+			},
+		}
+
+		r := NewWASMReader(&b)
+		_, err := r.readInstruction()
+		require.Error(t, err)
+
+		var argumentErr InvalidInstructionArgumentError
+		require.ErrorAs(t, err, &argumentErr)
+		require.Equal(t, 2, argumentErr.Offset)
+	})
+}
+
+func TestWASMWriterReader_bulkMemoryInstructions(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("memory.copy", func(t *testing.T) {
+
+		t.Parallel()
+
+		testInstructionWriteRead(t,
+			InstructionMemoryCopy{},
+			[]byte{
+				// memory.copy
+				0xfc, 0x0a,
+				// reserved
+				0x0, 0x0,
+			},
+		)
+	})
+
+	t.Run("memory.init", func(t *testing.T) {
+
+		t.Parallel()
+
+		testInstructionWriteRead(t,
+			InstructionMemoryInit{
+				DataIndex: 128,
+			},
+			[]byte{
+				// memory.init
+				0xfc, 0x08,
+				// data index: 128 (LEB128)
+				0x80, 0x01,
+				// reserved
+				0x0,
+			},
+		)
+	})
+
+	t.Run("memory.fill, non-zero reserved byte", func(t *testing.T) {
+
+		t.Parallel()
+
+		b := Buffer{
+			data: []byte{
+				// memory.fill
+				0xfc, 0x0b,
+				// reserved, invalid
+				0x1,
+			},
+		}
+
+		r := NewWASMReader(&b)
+		_, err := r.readInstruction()
+		require.Error(t, err)
+
+		var reservedByteErr InvalidInstructionReservedByteError
+		require.ErrorAs(t, err, &reservedByteErr)
+		require.Equal(t, 2, reservedByteErr.Offset)
+		require.Equal(t, byte(0x1), reservedByteErr.Byte)
+	})
+}
+
+func TestWASMWriterReader_tableInstructions(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("table.get", func(t *testing.T) {
+
+		t.Parallel()
+
+		testInstructionWriteRead(t,
+			InstructionTableGet{
+				TableIndex: 3,
+			},
+			[]byte{
+				// table.get
+				0x25,
+				// table index
+				0x3,
+			},
+		)
+	})
+
+	t.Run("table.copy", func(t *testing.T) {
+
+		t.Parallel()
+
+		testInstructionWriteRead(t,
+			InstructionTableCopy{
+				DestinationTableIndex: 1,
+				SourceTableIndex:      2,
+			},
+			[]byte{
+				// table.copy
+				0xfc, 0x0e,
+				// destination table index
+				0x1,
+				// source table index
+				0x2,
+			},
+		)
+	})
+}
+
+func TestWASMWriterReader_select(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("untyped", func(t *testing.T) {
+
+		t.Parallel()
+
+		testInstructionWriteRead(t,
+			InstructionSelect{},
+			[]byte{
+				// select
+				0x1b,
+			},
+		)
+	})
+
+	t.Run("typed, result i32", func(t *testing.T) {
+
+		t.Parallel()
+
+		testInstructionWriteRead(t,
+			InstructionSelectT{
+				ResultTypes: []ValueType{ValueTypeI32},
+			},
+			[]byte{
+				// select t
+				0x1c,
+				// result type count
+				0x1,
+				// i32
+				0x7f,
+			},
+		)
+	})
+
+	t.Run("typed, invalid result type", func(t *testing.T) {
+
+		t.Parallel()
+
+		b := Buffer{
+			data: []byte{
+				// select t
+				0x1c,
+				// result type count
+				0x1,
+				// invalid type
+				0x0,
+			},
+		}
+
+		r := NewWASMReader(&b)
+		_, err := r.readInstruction()
+		require.Error(t, err)
+
+		var argumentErr InvalidInstructionArgumentError
+		require.ErrorAs(t, err, &argumentErr)
+		require.Equal(t, 2, argumentErr.Offset)
+	})
+}
+
+func TestWASMWriterReader_globals(t *testing.T) {
+
+	t.Parallel()
+
+	module := &Module{
+		Globals: []*Global{
+			{
+				Type:    ValueTypeI32,
+				Mutable: true,
+				Init: []Instruction{
+					InstructionI32Const{Value: 42},
+				},
+			},
+			{
+				Type:    ValueTypeI64,
+				Mutable: false,
+				Init: []Instruction{
+					InstructionI64Const{Value: -1},
+				},
+			},
+		},
+	}
+
+	var b Buffer
+	err := NewWASMWriter(&b).WriteModule(module)
+	require.NoError(t, err)
+
+	require.Equal(t,
+		[]byte{
+			// magic
+			0x0, 0x61, 0x73, 0x6d,
+			// version
+			0x1, 0x0, 0x0, 0x0,
+			// section ID: Global = 6
+			0x6,
+			// section size: 11 (LEB128)
+			0x8b, 0x80, 0x80, 0x80, 0x0,
+			// global count: 2
+			0x2,
+			// global 1 type: i32
+			0x7f,
+			// global 1 mutability: var
+			0x1,
+			// i32.const 42
+			0x41, 0x2a,
+			// end
+			0xb,
+			// global 2 type: i64
+			0x7e,
+			// global 2 mutability: const
+			0x0,
+			// i64.const -1
+			0x42, 0x7f,
+			// end
+			0xb,
+		},
+		b.data,
+	)
+
+	b.offset = 0
+
+	r := NewWASMReader(&b)
+	err = r.ReadModule()
+	require.NoError(t, err)
+
+	require.Equal(t, module, &r.Module)
+
+	t.Run("non-constant init expression", func(t *testing.T) {
+
+		t.Parallel()
+
+		var b Buffer
+		err := NewWASMWriter(&b).WriteModule(&Module{
+			Globals: []*Global{
+				{
+					Type: ValueTypeI32,
+					Init: []Instruction{
+						InstructionI32Const{Value: 1},
+						InstructionI32Const{Value: 2},
+						InstructionI32Add{},
+					},
+				},
+			},
+		})
+		require.Error(t, err)
+
+		var constExprErr InvalidConstantExpressionInstructionError
+		require.ErrorAs(t, err, &constExprErr)
+		require.Equal(t, 2, constExprErr.Index)
+	})
+
+	t.Run("invalid mutability", func(t *testing.T) {
+
+		t.Parallel()
+
+		b := Buffer{
+			data: []byte{
+				// section size: 5 (LEB128)
+				0x85, 0x80, 0x80, 0x80, 0x0,
+				// global count: 1
+				0x1,
+				// global type: i32
+				0x7f,
+				// global mutability: invalid
+				0x2,
+				// i32.const 42
+				0x41, 0x2a,
+				// end
+				0xb,
+			},
+		}
+
+		r := NewWASMReader(&b)
+		err := r.readGlobalSection()
+		require.Error(t, err)
+
+		var mutabilityErr InvalidGlobalMutabilityError
+		require.ErrorAs(t, err, &mutabilityErr)
+		require.Equal(t, 7, mutabilityErr.Offset)
+	})
+}
+
+func TestWASMWriterReader_segments(t *testing.T) {
+
+	t.Parallel()
+
+	module := &Module{
+		Elements: []*Element{
+			{
+				TableIndex: 0,
+				Offset: []Instruction{
+					InstructionI32Const{Value: 1},
+				},
+				FunctionIndices: []uint32{0, 1},
+			},
+		},
+		Data: []*Data{
+			{
+				MemoryIndex: 0,
+				Offset: []Instruction{
+					InstructionI32Const{Value: 8},
+				},
+				Init: []byte{0x68, 0x69},
+			},
+		},
+	}
+
+	var b Buffer
+	err := NewWASMWriter(&b).WriteModule(module)
+	require.NoError(t, err)
+
+	require.Equal(t,
+		[]byte{
+			// magic
+			0x0, 0x61, 0x73, 0x6d,
+			// version
+			0x1, 0x0, 0x0, 0x0,
+			// section ID: Element = 9
+			0x9,
+			// section size: 8 (LEB128)
+			0x88, 0x80, 0x80, 0x80, 0x0,
+			// segment count: 1
+			0x1,
+			// flags: active, table 0
+			0x0,
+			// i32.const 1
+			0x41, 0x1,
+			// end
+			0xb,
+			// function index count
+			0x2,
+			// function indices
+			0x0, 0x1,
+			// section ID: Data = 11
+			0xb,
+			// section size: 8 (LEB128)
+			0x88, 0x80, 0x80, 0x80, 0x0,
+			// segment count: 1
+			0x1,
+			// memory index
+			0x0,
+			// i32.const 8
+			0x41, 0x8,
+			// end
+			0xb,
+			// byte count
+			0x2,
+			// init (bytes 0x68, 0x69)
+			0x68, 0x69,
+		},
+		b.data,
+	)
+
+	b.offset = 0
+
+	r := NewWASMReader(&b)
+	err = r.ReadModule()
+	require.NoError(t, err)
+
+	require.Equal(t, module, &r.Module)
+
+	nonConstantOffset := []Instruction{
+		InstructionI32Const{Value: 1},
+		InstructionI32Const{Value: 2},
+		InstructionI32Add{},
+	}
+
+	t.Run("non-constant element offset", func(t *testing.T) {
+
+		t.Parallel()
+
+		var b Buffer
+		err := NewWASMWriter(&b).WriteModule(&Module{
+			Elements: []*Element{
+				{
+					Offset: nonConstantOffset,
+				},
+			},
+		})
+		require.Error(t, err)
+
+		var constExprErr InvalidConstantExpressionInstructionError
+		require.ErrorAs(t, err, &constExprErr)
+		require.Equal(t, 2, constExprErr.Index)
+	})
+
+	t.Run("non-constant data offset", func(t *testing.T) {
+
+		t.Parallel()
+
+		var b Buffer
+		err := NewWASMWriter(&b).WriteModule(&Module{
+			Data: []*Data{
+				{
+					Offset: nonConstantOffset,
+				},
+			},
+		})
+		require.Error(t, err)
+
+		var constExprErr InvalidConstantExpressionInstructionError
+		require.ErrorAs(t, err, &constExprErr)
+		require.Equal(t, 2, constExprErr.Index)
+	})
+
+	t.Run("non-constant element offset in binary", func(t *testing.T) {
+
+		t.Parallel()
+
+		b := Buffer{
+			data: []byte{
+				// section size: 10 (LEB128)
+				0x8a, 0x80, 0x80, 0x80, 0x0,
+				// segment count: 1
+				0x1,
+				// flags: active, table 0
+				0x0,
+				// i32.const 1
+				0x41, 0x1,
+				// i32.const 2
+				0x41, 0x2,
+				// i32.add
+				0x6a,
+				// end
+				0xb,
+				// function index count
+				0x0,
+			},
+		}
+
+		r := NewWASMReader(&b)
+		err := r.readElementSection()
+		require.Error(t, err)
+
+		var segmentErr InvalidElementSegmentError
+		require.ErrorAs(t, err, &segmentErr)
+		require.Equal(t, 0, segmentErr.Index)
+
+		var constExprErr InvalidConstantExpressionInstructionError
+		require.ErrorAs(t, err, &constExprErr)
+		require.Equal(t, 2, constExprErr.Index)
+	})
+
+	t.Run("non-zero table index", func(t *testing.T) {
+
+		t.Parallel()
+
+		module := &Module{
+			Elements: []*Element{
+				{
+					TableIndex: 1,
+					Offset: []Instruction{
+						InstructionI32Const{Value: 1},
+					},
+					FunctionIndices: []uint32{0},
+				},
+			},
+		}
+
+		var b Buffer
+		err := NewWASMWriter(&b).WriteModule(module)
+		require.NoError(t, err)
+
+		require.Equal(t,
+			[]byte{
+				// magic
+				0x0, 0x61, 0x73, 0x6d,
+				// version
+				0x1, 0x0, 0x0, 0x0,
+				// section ID: Element = 9
+				0x9,
+				// section size: 9 (LEB128)
+				0x89, 0x80, 0x80, 0x80, 0x0,
+				// segment count: 1
+				0x1,
+				// flags: active, explicit table index
+				0x2,
+				// table index
+				0x1,
+				// i32.const 1
+				0x41, 0x1,
+				// end
+				0xb,
+				// element kind: function reference
+				0x0,
+				// function index count
+				0x1,
+				// function indices
+				0x0,
+			},
+			b.data,
+		)
+
+		b.offset = 0
+
+		r := NewWASMReader(&b)
+		err = r.ReadModule()
+		require.NoError(t, err)
+
+		require.Equal(t, module, &r.Module)
+	})
+
+	t.Run("unsupported flags", func(t *testing.T) {
+
+		t.Parallel()
+
+		b := Buffer{
+			data: []byte{
+				// section size: 6 (LEB128)
+				0x86, 0x80, 0x80, 0x80, 0x0,
+				// segment count: 1
+				0x1,
+				// flags: passive
+				0x1,
+				// element kind: function reference
+				0x0,
+				// function index count
+				0x0,
+			},
+		}
+
+		r := NewWASMReader(&b)
+		err := r.readElementSection()
+		require.Error(t, err)
+
+		var flagsErr InvalidElementSegmentFlagsError
+		require.ErrorAs(t, err, &flagsErr)
+		require.Equal(t, 6, flagsErr.Offset)
+		require.Equal(t, uint32(1), flagsErr.Flags)
+	})
+
+	t.Run("invalid element kind", func(t *testing.T) {
+
+		t.Parallel()
+
+		b := Buffer{
+			data: []byte{
+				// section size: 8 (LEB128)
+				0x88, 0x80, 0x80, 0x80, 0x0,
+				// segment count: 1
+				0x1,
+				// flags: active, explicit table index
+				0x2,
+				// table index
+				0x1,
+				// i32.const 1
+				0x41, 0x1,
+				// end
+				0xb,
+				// element kind: invalid
+				0x1,
+				// function index count
+				0x0,
+			},
+		}
+
+		r := NewWASMReader(&b)
+		err := r.readElementSection()
+		require.Error(t, err)
+
+		var elementKindErr InvalidElementSegmentElementKindError
+		require.ErrorAs(t, err, &elementKindErr)
+		require.Equal(t, 11, elementKindErr.Offset)
+		require.Equal(t, byte(0x1), elementKindErr.ElementKind)
+	})
+
+	t.Run("invalid function index", func(t *testing.T) {
+
+		t.Parallel()
+
+		b := Buffer{
+			data: []byte{
+				// section size: 6 (LEB128)
+				0x86, 0x80, 0x80, 0x80, 0x0,
+				// segment count: 1
+				0x1,
+				// flags: active, table 0
+				0x0,
+				// i32.const 1
+				0x41, 0x1,
+				// end
+				0xb,
+				// function index count
+				0x1,
+				// function index: invalid, missing
+			},
+		}
+
+		r := NewWASMReader(&b)
+		err := r.readElementSection()
+		require.Error(t, err)
+
+		var functionIndexErr InvalidElementSectionFunctionIndexError
+		require.ErrorAs(t, err, &functionIndexErr)
+		require.Equal(t, 11, functionIndexErr.Offset)
+		require.Equal(t, 0, functionIndexErr.Index)
+	})
+}
+
+func TestWASMWriterReader_dataCount(t *testing.T) {
+
+	t.Parallel()
+
+	dataCount := uint32(1)
+
+	module := &Module{
+		DataCount: &dataCount,
+		Data: []*Data{
+			{
+				MemoryIndex: 0,
+				Offset: []Instruction{
+					InstructionI32Const{Value: 8},
+				},
+				Init: []byte{0x68, 0x69},
+			},
+		},
+	}
+
+	var b Buffer
+	err := NewWASMWriter(&b).WriteModule(module)
+	require.NoError(t, err)
+
+	require.Equal(t,
+		[]byte{
+			// magic
+			0x0, 0x61, 0x73, 0x6d,
+			// version
+			0x1, 0x0, 0x0, 0x0,
+			// section ID: DataCount = 12
+			0xc,
+			// section size: 1 (LEB128)
+			0x81, 0x80, 0x80, 0x80, 0x0,
+			// data count: 1
+			0x1,
+			// section ID: Data = 11
+			0xb,
+			// section size: 8 (LEB128)
+			0x88, 0x80, 0x80, 0x80, 0x0,
+			// segment count: 1
+			0x1,
+			// memory index
+			0x0,
+			// i32.const 8
+			0x41, 0x8,
+			// end
+			0xb,
+			// byte count
+			0x2,
+			// init (bytes 0x68, 0x69)
+			0x68, 0x69,
+		},
+		b.data,
+	)
+
+	b.offset = 0
+
+	r := NewWASMReader(&b)
+	err = r.ReadModule()
+	require.NoError(t, err)
+
+	require.Equal(t, module, &r.Module)
+
+	t.Run("write, mismatch", func(t *testing.T) {
+
+		t.Parallel()
+
+		dataCount := uint32(2)
+
+		var b Buffer
+		err := NewWASMWriter(&b).WriteModule(&Module{
+			DataCount: &dataCount,
+			Data:      module.Data,
+		})
+		require.Equal(t,
+			DataCountMismatchError{
+				Offset: 8,
+			},
+			err,
+		)
+	})
+
+	t.Run("read, missing data section", func(t *testing.T) {
+
+		t.Parallel()
+
+		b := Buffer{
+			data: []byte{
+				// magic
+				0x0, 0x61, 0x73, 0x6d,
+				// version
+				0x1, 0x0, 0x0, 0x0,
+				// section ID: DataCount = 12
+				0xc,
+				// section size: 1
+				0x1,
+				// data count: 1
+				0x1,
+			},
+		}
+
+		r := NewWASMReader(&b)
+		err := r.ReadModule()
+		require.Equal(t,
+			DataCountMismatchError{
+				Offset: 11,
+			},
+			err,
+		)
+	})
+
+	t.Run("read, after code section", func(t *testing.T) {
+
+		t.Parallel()
+
+		b := Buffer{
+			data: []byte{
+				// magic
+				0x0, 0x61, 0x73, 0x6d,
+				// version
+				0x1, 0x0, 0x0, 0x0,
+				// section ID: Code = 10
+				0xa,
+				// section size: 1
+				0x1,
+				// function count: 0
+				0x0,
+				// section ID: DataCount = 12
+				0xc,
+				// section size: 1
+				0x1,
+				// data count: 0
+				0x0,
+			},
+		}
+
+		r := NewWASMReader(&b)
+		err := r.ReadModule()
+		require.Equal(t,
+			InvalidSectionOrderError{
+				SectionID:         sectionIDDataCount,
+				PreviousSectionID: sectionIDCode,
+				Offset:            11,
+			},
+			err,
+		)
+	})
+}
+
+func TestWASMWriterReader_imports(t *testing.T) {
+
+	t.Parallel()
+
+	module := &Module{
+		Types: []*FunctionType{
+			{
+				Params:  []ValueType{ValueTypeI32},
+				Results: nil,
+			},
+		},
+		Imports: []*Import{
+			{
+				Module: "env",
+				Name:   "log",
+				Descriptor: FunctionImport{
+					TypeIndex: 0,
+				},
+			},
+			{
+				Module: "env",
+				Name:   "memory",
+				Descriptor: MemoryImport{
+					Min: 1,
+					Max: nil,
+				},
+			},
+		},
+	}
+
+	var b Buffer
+	err := NewWASMWriter(&b).WriteModule(module)
+	require.NoError(t, err)
+
+	require.Equal(t,
+		[]byte{
+			// magic
+			0x0, 0x61, 0x73, 0x6d,
+			// version
+			0x1, 0x0, 0x0, 0x0,
+			// section ID: Type = 1
+			0x1,
+			// section size: 5 (LEB128)
+			0x85, 0x80, 0x80, 0x80, 0x0,
+			// type count
+			0x1,
+			// function type
+			0x60,
+			// parameter count: 1
+			0x1,
+			// type of parameter 1: i32
+			0x7f,
+			// return value count: 0
+			0x0,
+			// section ID: Import = 2
+			0x2,
+			// section size: 25 (LEB128)
+			0x99, 0x80, 0x80, 0x80, 0x0,
+			// import count: 2
+			0x2,
+			// module length
+			0x3,
+			// module = "env"
+			0x65, 0x6e, 0x76,
+			// name length
+			0x3,
+			// name = "log"
+			0x6c, 0x6f, 0x67,
+			// indicator: function = 0
+			0x0,
+			// type index of function: 0
+			0x0,
+			// module length
+			0x3,
+			// module = "env"
+			0x65, 0x6e, 0x76,
+			// name length
+			0x6,
+			// name = "memory"
+			0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79,
+			// indicator: memory = 2
+			0x2,
+			// limit indicator: no max = 0
+			0x0,
+			// limit 1 min
+			0x1,
+		},
+		b.data,
+	)
+
+	b.offset = 0
+
+	r := NewWASMReader(&b)
+	err = r.ReadModule()
+	require.NoError(t, err)
+
+	require.Equal(t, module, &r.Module)
+}
+
+func TestWASMWriterReader_compressedLocals(t *testing.T) {
+
+	t.Parallel()
+
+	functions := []*Function{
+		{
+			Code: &Code{
+				Locals: []ValueType{
+					ValueTypeI32,
+					ValueTypeI32,
+					ValueTypeI32,
+					ValueTypeI32,
+					ValueTypeI32,
+					ValueTypeI64,
+					ValueTypeI64,
+				},
+			},
+		},
+	}
+
+	var b Buffer
+	err := NewWASMWriter(&b).writeCodeSection(functions)
+	require.NoError(t, err)
+
+	require.Equal(t,
+		[]byte{
+			// section ID: Code = 10
+			0xa,
+			// section size: 12 (LEB128)
+			0x8c, 0x80, 0x80, 0x80, 0x0,
+			// function count: 1
+			0x1,
+			// code size: 6 (LEB128)
+			0x86, 0x80, 0x80, 0x80, 0x0,
+			// number of runs of locals: 2
+			0x2,
+			// number of locals with this type: 5
+			0x5,
+			// local type: i32
+			0x7f,
+			// number of locals with this type: 2
+			0x2,
+			// local type: i64
+			0x7e,
+			// opcode: end
+			0xb,
+		},
+		b.data,
+	)
+
+	// skip the section ID
+	b.offset = 1
+
+	r := NewWASMReader(&b)
+	err = r.readCodeSection()
+	require.NoError(t, err)
+
+	require.Equal(t, functions, r.Module.Functions)
+
+	t.Run("too many locals", func(t *testing.T) {
+
+		t.Parallel()
+
+		b := Buffer{
+			data: []byte{
+				// section size: 14 (LEB128)
+				0x8e, 0x80, 0x80, 0x80, 0x0,
+				// function count: 1
+				0x1,
+				// code size: 8 (LEB128)
+				0x88, 0x80, 0x80, 0x80, 0x0,
+				// number of runs of locals: 1
+				0x1,
+				// number of locals with this type: 0xffffffff (LEB128)
+				0xff, 0xff, 0xff, 0xff, 0xf,
+				// local type: i32
+				0x7f,
+				// opcode: end
+				0xb,
+			},
+		}
+
+		r := NewWASMReader(&b)
+		err := r.readCodeSection()
+		require.Error(t, err)
+
+		var limitErr CodeSectionLocalsCountLimitError
+		require.ErrorAs(t, err, &limitErr)
+		require.Equal(t, 12, limitErr.Offset)
+		require.Equal(t, uint64(math.MaxUint32), limitErr.Count)
+	})
+}
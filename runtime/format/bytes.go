@@ -19,13 +19,36 @@
 package format
 
 import (
-	"fmt"
+	"encoding/hex"
+	"strconv"
+	"strings"
 )
 
+// Bytes returns the Cadence array-of-UInt8 literal for the given bytes, e.g. `[0x1, 0x2]`
 func Bytes(bytes []byte) string {
-	values := make([]string, len(bytes))
+	var builder strings.Builder
+	// Each byte takes at most 4 characters (e.g. `0xff`), plus 2 characters for the separator
+	builder.Grow(2 + len(bytes)*6)
+
+	var buffer [2]byte
+
+	builder.WriteByte('[')
 	for i, b := range bytes {
-		values[i] = fmt.Sprintf("0x%x", b)
+		if i > 0 {
+			builder.WriteString(", ")
+		}
+		builder.WriteString("0x")
+		builder.Write(strconv.AppendUint(buffer[:0], uint64(b), 16))
 	}
-	return Array(values)
+	builder.WriteByte(']')
+	return builder.String()
+}
+
+// HexString returns the `0x`-prefixed hexadecimal encoding of the given bytes, e.g. `0x0102`
+func HexString(bytes []byte) string {
+	result := make([]byte, 2+hex.EncodedLen(len(bytes)))
+	result[0] = '0'
+	result[1] = 'x'
+	hex.Encode(result[2:], bytes)
+	return string(result)
 }
@@ -38,4 +38,28 @@ func TestBytes(t *testing.T) {
 	t.Run("two", func(t *testing.T) {
 		require.Equal(t, "[0x1, 0x2]", Bytes([]byte{0x1, 0x2}))
 	})
+
+	t.Run("all digits", func(t *testing.T) {
+		require.Equal(t, "[0x0, 0xa, 0x10, 0xff]", Bytes([]byte{0x0, 0xa, 0x10, 0xff}))
+	})
+}
+
+func TestHexString(t *testing.T) {
+	t.Parallel()
+
+	for _, testCase := range []struct {
+		name     string
+		bytes    []byte
+		expected string
+	}{
+		{name: "nil", bytes: nil, expected: "0x"},
+		{name: "empty", bytes: []byte{}, expected: "0x"},
+		{name: "one", bytes: []byte{0x1}, expected: "0x01"},
+		{name: "two", bytes: []byte{0x1, 0x2}, expected: "0x0102"},
+		{name: "max", bytes: []byte{0xff, 0xab}, expected: "0xffab"},
+	} {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(t, testCase.expected, HexString(testCase.bytes))
+		})
+	}
 }
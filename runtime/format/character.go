@@ -0,0 +1,37 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package format
+
+import (
+	"github.com/rivo/uniseg"
+)
+
+// Character returns the Cadence literal of the character s,
+// or an InvalidInputError if s is not exactly one grapheme cluster.
+// A character may consist of multiple code points, e.g. a letter and a combining accent.
+func Character(s string) (string, error) {
+	graphemes := uniseg.NewGraphemes(s)
+	if !graphemes.Next() || graphemes.Next() {
+		return "", InvalidInputError{
+			Input:  s,
+			Reason: "invalid character, not a single grapheme cluster",
+		}
+	}
+	return String(s), nil
+}
@@ -0,0 +1,77 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package format_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime/format"
+)
+
+func TestCharacter(t *testing.T) {
+	t.Parallel()
+
+	test := func(name string, character string, expected string) {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			literal, err := format.Character(character)
+			require.NoError(t, err)
+			assert.Equal(t, expected, literal)
+		})
+	}
+
+	test("ASCII", "a", `"a"`)
+	test("escaped", `"`, `"\""`)
+	// e followed by a combining acute accent
+	test("combining accent", "é", `"e\u{301}"`)
+	// flag of Switzerland, two regional indicator symbols
+	test("multi-codepoint emoji", "\U0001F1E8\U0001F1ED", `"\u{1f1e8}\u{1f1ed}"`)
+
+	t.Run("empty", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := format.Character("")
+		require.Equal(t,
+			format.InvalidInputError{
+				Input:  "",
+				Reason: "invalid character, not a single grapheme cluster",
+			},
+			err,
+		)
+		require.EqualError(t, err, `invalid character, not a single grapheme cluster: ""`)
+	})
+
+	t.Run("multiple graphemes", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := format.Character("ab")
+		require.Equal(t,
+			format.InvalidInputError{
+				Input:  "ab",
+				Reason: "invalid character, not a single grapheme cluster",
+			},
+			err,
+		)
+		require.EqualError(t, err, `invalid character, not a single grapheme cluster: "ab"`)
+	})
+}
@@ -20,6 +20,7 @@ package format
 
 import (
 	"fmt"
+	"math/big"
 	"strconv"
 	"strings"
 
@@ -53,3 +54,28 @@ func UFix64(v uint64) string {
 		PadLeft(strconv.Itoa(int(fraction)), '0', fixedpoint.Fix64Scale),
 	)
 }
+
+// BigFixed returns the decimal representation of the fixed-point number
+// with the given unscaled value and scale, i.e. the number of fractional digits.
+// For example, the value 12345 with scale 2 is formatted as "123.45".
+func BigFixed(value *big.Int, scale uint) string {
+	digits := new(big.Int).Abs(value).String()
+
+	var builder strings.Builder
+	if value.Sign() < 0 {
+		builder.WriteByte('-')
+	}
+
+	if scale == 0 {
+		builder.WriteString(digits)
+		return builder.String()
+	}
+
+	digits = PadLeft(digits, '0', scale+1)
+	integerLength := uint(len(digits)) - scale
+
+	builder.WriteString(digits[:integerLength])
+	builder.WriteByte('.')
+	builder.WriteString(digits[integerLength:])
+	return builder.String()
+}
@@ -19,6 +19,7 @@
 package format
 
 import (
+	"math/big"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -30,3 +31,39 @@ func TestUFix64(t *testing.T) {
 
 	require.Equal(t, "99999999999.70000000", UFix64(9999999999970000000))
 }
+
+func TestBigFixed(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("scale 0", func(t *testing.T) {
+
+		t.Parallel()
+
+		require.Equal(t, "0", BigFixed(big.NewInt(0), 0))
+		require.Equal(t, "12345", BigFixed(big.NewInt(12345), 0))
+		require.Equal(t, "-12345", BigFixed(big.NewInt(-12345), 0))
+	})
+
+	t.Run("scale 8", func(t *testing.T) {
+
+		t.Parallel()
+
+		require.Equal(t, "0.00000000", BigFixed(big.NewInt(0), 8))
+		require.Equal(t, "1.23450000", BigFixed(big.NewInt(123450000), 8))
+		require.Equal(t, "-1.23450000", BigFixed(big.NewInt(-123450000), 8))
+
+		value, ok := new(big.Int).SetString("9999999999970000000", 10)
+		require.True(t, ok)
+		require.Equal(t, "99999999999.70000000", BigFixed(value, 8))
+	})
+
+	t.Run("scale larger than number of digits", func(t *testing.T) {
+
+		t.Parallel()
+
+		require.Equal(t, "0.00012", BigFixed(big.NewInt(12), 5))
+		require.Equal(t, "-0.00012", BigFixed(big.NewInt(-12), 5))
+		require.Equal(t, "0.000000000000000000000000000000000001", BigFixed(big.NewInt(1), 36))
+	})
+}
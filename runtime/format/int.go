@@ -24,6 +24,11 @@ import (
 )
 
 func BigInt(int *big.Int) string {
+	// Fast path: avoid the allocations of big.Int.Text
+	// for integers which fit into an int64
+	if int != nil && int.IsInt64() {
+		return strconv.FormatInt(int.Int64(), 10)
+	}
 	return int.Text(10)
 }
 
@@ -31,6 +36,26 @@ func Int(int int64) string {
 	return strconv.FormatInt(int, 10)
 }
 
+// IntWidth formats the given integer, left-padded with zeros
+// so that its digits are at least the given width.
+// The sign of a negative integer is kept outside the padding,
+// e.g. IntWidth(-42, 4) is "-0042"
+func IntWidth(int int64, width int) string {
+	if width < 0 {
+		width = 0
+	}
+
+	if int >= 0 {
+		return PadLeft(strconv.FormatInt(int, 10), '0', uint(width))
+	}
+
+	// NOTE: the magnitude of math.MinInt64 cannot be represented as an int64,
+	// so negate in a way that cannot overflow, and format the unsigned magnitude
+	magnitude := uint64(-(int + 1)) + 1
+
+	return "-" + PadLeft(strconv.FormatUint(magnitude, 10), '0', uint(width))
+}
+
 func Uint(uint uint64) string {
 	return strconv.FormatUint(uint, 10)
 }
@@ -0,0 +1,114 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package format
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt(t *testing.T) {
+
+	t.Parallel()
+
+	require.Equal(t, "0", Int(0))
+	require.Equal(t, "-42", Int(-42))
+	require.Equal(t, "9223372036854775807", Int(math.MaxInt64))
+	require.Equal(t, "-9223372036854775808", Int(math.MinInt64))
+}
+
+func TestIntWidth(t *testing.T) {
+
+	t.Parallel()
+
+	test := func(int int64, width int, expected string) {
+		require.Equal(t, expected, IntWidth(int, width))
+	}
+
+	test(0, 0, "0")
+	test(0, 3, "000")
+	test(42, 4, "0042")
+	test(42, 1, "42")
+	test(42, -1, "42")
+	test(-42, 4, "-0042")
+	test(-42, 2, "-42")
+	test(-1, 3, "-001")
+	test(math.MaxInt64, 21, "009223372036854775807")
+	test(math.MinInt64, 0, "-9223372036854775808")
+	test(math.MinInt64, 21, "-009223372036854775808")
+}
+
+func TestBigInt(t *testing.T) {
+
+	t.Parallel()
+
+	test := func(int *big.Int) {
+		require.Equal(t, int.Text(10), BigInt(int))
+	}
+
+	for i := int64(-1000); i <= 1000; i++ {
+		test(big.NewInt(i))
+	}
+
+	minInt64 := big.NewInt(math.MinInt64)
+	maxInt64 := big.NewInt(math.MaxInt64)
+	one := big.NewInt(1)
+
+	test(minInt64)
+	test(maxInt64)
+
+	// just outside the int64 range
+	test(new(big.Int).Sub(minInt64, one))
+	test(new(big.Int).Add(maxInt64, one))
+
+	// far outside the int64 range
+	large := new(big.Int).Exp(big.NewInt(10), big.NewInt(40), nil)
+	test(large)
+	test(new(big.Int).Neg(large))
+
+	require.Equal(t, "<nil>", BigInt(nil))
+}
+
+func BenchmarkBigInt(b *testing.B) {
+
+	b.Run("small", func(b *testing.B) {
+		int := big.NewInt(-123456789)
+
+		b.ReportAllocs()
+		b.ResetTimer()
+
+		for i := 0; i < b.N; i++ {
+			_ = BigInt(int)
+		}
+	})
+
+	b.Run("large", func(b *testing.B) {
+		int := new(big.Int).Exp(big.NewInt(10), big.NewInt(40), nil)
+
+		b.ReportAllocs()
+		b.ResetTimer()
+
+		for i := 0; i < b.N; i++ {
+			_ = BigInt(int)
+		}
+	})
+}
@@ -20,8 +20,12 @@ package format
 
 import (
 	"fmt"
+
+	"github.com/onflow/cadence/runtime/common"
 )
 
+// Path returns the canonical form of the path with the given domain and identifier.
+// The domain is not validated, see CheckedPath.
 func Path(domain string, identifier string) string {
 	return fmt.Sprintf(
 		"/%s/%s",
@@ -29,3 +33,15 @@ func Path(domain string, identifier string) string {
 		identifier,
 	)
 }
+
+// CheckedPath returns the canonical form of the path with the given domain and identifier,
+// or an InvalidInputError if the domain is not a valid path domain, i.e. `storage`, `public`, or `private`.
+func CheckedPath(domain string, identifier string) (string, error) {
+	if common.PathDomainFromIdentifier(domain) == common.PathDomainUnknown {
+		return "", InvalidInputError{
+			Input:  domain,
+			Reason: "invalid path domain",
+		}
+	}
+	return Path(domain, identifier), nil
+}
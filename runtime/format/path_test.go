@@ -0,0 +1,58 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package format_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime/format"
+)
+
+func TestCheckedPath(t *testing.T) {
+	t.Parallel()
+
+	for _, domain := range []string{"storage", "public", "private"} {
+		domain := domain
+
+		t.Run(domain, func(t *testing.T) {
+			t.Parallel()
+
+			path, err := format.CheckedPath(domain, "foo")
+			require.NoError(t, err)
+			assert.Equal(t, "/"+domain+"/foo", path)
+		})
+	}
+
+	t.Run("invalid domain", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := format.CheckedPath("unknown", "foo")
+		require.Equal(t,
+			format.InvalidInputError{
+				Input:  "unknown",
+				Reason: "invalid path domain",
+			},
+			err,
+		)
+		require.EqualError(t, err, `invalid path domain: "unknown"`)
+	})
+}
@@ -0,0 +1,66 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package format_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/format"
+	"github.com/onflow/cadence/runtime/parser"
+)
+
+func TestString(t *testing.T) {
+	t.Parallel()
+
+	for _, testCase := range []struct {
+		name     string
+		value    string
+		expected string
+	}{
+		{name: "empty", value: "", expected: `""`},
+		{name: "plain", value: "abc", expected: `"abc"`},
+		{name: "quotes", value: `say "hi"`, expected: `"say \"hi\""`},
+		{name: "backslash", value: `a\b`, expected: `"a\\b"`},
+		{name: "newlines", value: "a\nb\r\n", expected: `"a\nb\r\n"`},
+		{name: "tab", value: "a\tb", expected: `"a\tb"`},
+		{name: "emoji", value: "😀", expected: `"\u{1f600}"`},
+		{name: "NUL", value: "a\x00b", expected: `"a\0b"`},
+		{name: "non-printable", value: "\x7f", expected: `"\u{7f}"`},
+	} {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			literal := format.String(testCase.value)
+			require.Equal(t, testCase.expected, literal)
+
+			// The literal must round-trip through the parser
+
+			expression, errs := parser.ParseExpression(nil, []byte(literal), parser.Config{})
+			require.Empty(t, errs)
+
+			require.IsType(t, &ast.StringExpression{}, expression)
+			require.Equal(t, testCase.value, expression.(*ast.StringExpression).Value)
+		})
+	}
+}
@@ -28,3 +28,24 @@ func TypeValue(ty string) string {
 	}
 	return fmt.Sprintf("Type<%s>()", ty)
 }
+
+// Optional returns the syntax of an optional type with the given inner type, i.e. `T?`.
+func Optional(inner string) string {
+	return fmt.Sprintf("%s?", inner)
+}
+
+// Reference returns the syntax of a reference type with the given inner type,
+// i.e. `&T`, or `auth(A) &T` if the reference has the given authorization `A`.
+// An empty authorization denotes an unauthorized reference.
+func Reference(authorization string, inner string) string {
+	if authorization != "" {
+		return fmt.Sprintf("auth(%s) &%s", authorization, inner)
+	}
+	return fmt.Sprintf("&%s", inner)
+}
+
+// VariableSizedArray returns the syntax of a variable-sized array type
+// with the given element type, i.e. `[T]`.
+func VariableSizedArray(inner string) string {
+	return fmt.Sprintf("[%s]", inner)
+}
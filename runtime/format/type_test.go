@@ -0,0 +1,61 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package format_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/onflow/cadence/runtime/format"
+)
+
+func TestTypeSyntax(t *testing.T) {
+	t.Parallel()
+
+	for _, testCase := range []struct {
+		name     string
+		actual   string
+		expected string
+	}{
+		{name: "optional", actual: format.Optional("T"), expected: "T?"},
+		{name: "reference", actual: format.Reference("", "T"), expected: "&T"},
+		{name: "authorized reference", actual: format.Reference("E", "T"), expected: "auth(E) &T"},
+		{name: "authorized reference, multiple entitlements", actual: format.Reference("E, F", "T"), expected: "auth(E, F) &T"},
+		{name: "variable-sized array", actual: format.VariableSizedArray("T"), expected: "[T]"},
+		{
+			name:     "optional array of references",
+			actual:   format.Optional(format.VariableSizedArray(format.Reference("", "T"))),
+			expected: "[&T]?",
+		},
+		{
+			name:     "array of optional authorized references",
+			actual:   format.VariableSizedArray(format.Optional(format.Reference("E", "T"))),
+			expected: "[auth(E) &T?]",
+		},
+	} {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, testCase.expected, testCase.actual)
+		})
+	}
+}
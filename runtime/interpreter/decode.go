@@ -972,28 +972,18 @@ func (d LocationDecoder) decodeAddressBytes() ([]byte, error) {
 
 func (d StorableDecoder) decodePath() (PathValue, error) {
 
-	const expectedLength = encodedPathValueLength
-
-	size, err := d.decoder.DecodeArrayHead()
+	err := decodeCBORArrayHead(d.decoder, encodedPathValueLength, "path")
 	if err != nil {
-		if e, ok := err.(*cbor.WrongTypeError); ok {
-			// No need to meter EmptyPathValue here or below because it's ignored for the error
-			return EmptyPathValue, errors.NewUnexpectedError(
-				"invalid path encoding: expected [%d]any, got %s",
-				expectedLength,
-				e.ActualType.String(),
-			)
-		}
+		// No need to meter EmptyPathValue here or in decodePathFields because it's ignored for the error
 		return EmptyPathValue, err
 	}
 
-	if size != expectedLength {
-		return EmptyPathValue, errors.NewUnexpectedError(
-			"invalid path encoding: expected [%d]any, got [%d]any",
-			expectedLength,
-			size,
-		)
-	}
+	return d.decodePathFields()
+}
+
+// decodePathFields decodes the fields of a path,
+// after the head of its array has been decoded
+func (d StorableDecoder) decodePathFields() (PathValue, error) {
 
 	// Decode domain at array index encodedPathValueDomainFieldKey
 	domain, err := decodeUint64(d.decoder, d.memoryGauge)
@@ -1026,30 +1016,76 @@ func (d StorableDecoder) decodePath() (PathValue, error) {
 	), nil
 }
 
-func (d StorableDecoder) decodeCapability() (*IDCapabilityValue, error) {
-
-	const expectedLength = encodedCapabilityValueLength
-
-	size, err := d.decoder.DecodeArrayHead()
+// decodeCBORArrayHead decodes the head of a CBOR array,
+// and validates that the array has the expected length.
+// The description names the encoded value in the returned errors.
+func decodeCBORArrayHead(decoder *cbor.StreamDecoder, expectedLength uint64, description string) error {
+	size, err := decoder.DecodeArrayHead()
 	if err != nil {
 		if e, ok := err.(*cbor.WrongTypeError); ok {
-			return nil, errors.NewUnexpectedError(
-				"invalid capability encoding: expected [%d]any, got %s",
+			return errors.NewUnexpectedError(
+				"invalid %s encoding: expected [%d]any, got %s",
+				description,
 				expectedLength,
 				e.ActualType.String(),
 			)
 		}
-		return nil, err
+		return err
 	}
 
 	if size != expectedLength {
-		return nil, errors.NewUnexpectedError(
-			"invalid capability encoding: expected [%d]any, got [%d]any",
+		return errors.NewUnexpectedError(
+			"invalid %s encoding: expected [%d]any, got [%d]any",
+			description,
 			expectedLength,
 			size,
 		)
 	}
 
+	return nil
+}
+
+// decodeCBORTaggedArray decodes the tag number and the head of a tagged CBOR array,
+// and validates that the tag number and the array length are the expected ones.
+// The description names the encoded value in the returned errors.
+func decodeCBORTaggedArray(
+	decoder *cbor.StreamDecoder,
+	expectedTag uint64,
+	expectedLength uint64,
+	description string,
+) error {
+	tag, err := decoder.DecodeTagNumber()
+	if err != nil {
+		if e, ok := err.(*cbor.WrongTypeError); ok {
+			return errors.NewUnexpectedError(
+				"invalid %s encoding: expected CBOR tag %d, got %s",
+				description,
+				expectedTag,
+				e.ActualType.String(),
+			)
+		}
+		return err
+	}
+
+	if tag != expectedTag {
+		return errors.NewUnexpectedError(
+			"invalid %s encoding: expected CBOR tag %d, got %d",
+			description,
+			expectedTag,
+			tag,
+		)
+	}
+
+	return decodeCBORArrayHead(decoder, expectedLength, description)
+}
+
+func (d StorableDecoder) decodeCapability() (*IDCapabilityValue, error) {
+
+	err := decodeCBORArrayHead(d.decoder, encodedCapabilityValueLength, "capability")
+	if err != nil {
+		return nil, err
+	}
+
 	// address
 
 	// Decode address at array index encodedCapabilityValueAddressFieldKey
@@ -1328,28 +1364,11 @@ func (d StorableDecoder) decodeType() (TypeValue, error) {
 // Deprecated: decodePathCapability
 func (d StorableDecoder) decodePathCapability() (*PathCapabilityValue, error) {
 
-	const expectedLength = encodedPathCapabilityValueLength
-
-	size, err := d.decoder.DecodeArrayHead()
+	err := decodeCBORArrayHead(d.decoder, encodedPathCapabilityValueLength, "capability")
 	if err != nil {
-		if e, ok := err.(*cbor.WrongTypeError); ok {
-			return nil, errors.NewUnexpectedError(
-				"invalid capability encoding: expected [%d]any, got %s",
-				expectedLength,
-				e.ActualType.String(),
-			)
-		}
 		return nil, err
 	}
 
-	if size != expectedLength {
-		return nil, errors.NewUnexpectedError(
-			"invalid capability encoding: expected [%d]any, got [%d]any",
-			expectedLength,
-			size,
-		)
-	}
-
 	// address
 
 	// Decode address at array index encodedPathCapabilityValueAddressFieldKey
@@ -1378,13 +1397,13 @@ func (d StorableDecoder) decodePathCapability() (*PathCapabilityValue, error) {
 	// path
 
 	// Decode path at array index encodedPathCapabilityValuePathFieldKey
-	pathStorable, err := d.decodeStorable()
+	err = decodeCBORTaggedArray(d.decoder, CBORTagPathValue, encodedPathValueLength, "capability path")
 	if err != nil {
-		return nil, errors.NewUnexpectedError("invalid capability path: %w", err)
+		return nil, err
 	}
-	pathValue, ok := pathStorable.(PathValue)
-	if !ok {
-		return nil, errors.NewUnexpectedError("invalid capability path: invalid type %T", pathValue)
+	pathValue, err := d.decodePathFields()
+	if err != nil {
+		return nil, errors.NewUnexpectedError("invalid capability path: %w", err)
 	}
 
 	// Decode borrow type at array index encodedPathCapabilityValueBorrowTypeFieldKey
@@ -1418,37 +1437,17 @@ func (d StorableDecoder) decodePathCapability() (*PathCapabilityValue, error) {
 // Deprecated: decodePathLink
 func (d StorableDecoder) decodePathLink() (PathLinkValue, error) {
 
-	const expectedLength = encodedPathLinkValueLength
-
-	size, err := d.decoder.DecodeArrayHead()
+	err := decodeCBORArrayHead(d.decoder, encodedPathLinkValueLength, "link")
 	if err != nil {
-		if e, ok := err.(*cbor.WrongTypeError); ok {
-			return EmptyPathLinkValue, errors.NewUnexpectedError(
-				"invalid link encoding: expected [%d]any, got %s",
-				expectedLength,
-				e.ActualType.String(),
-			)
-		}
 		return EmptyPathLinkValue, err
 	}
 
-	if size != expectedLength {
-		return EmptyPathLinkValue, errors.NewUnexpectedError(
-			"invalid link encoding: expected [%d]any, got [%d]any",
-			expectedLength,
-			size,
-		)
-	}
-
 	// Decode path at array index encodedPathLinkValueTargetPathFieldKey
-	num, err := d.decoder.DecodeTagNumber()
+	err = decodeCBORTaggedArray(d.decoder, CBORTagPathValue, encodedPathValueLength, "link target path")
 	if err != nil {
-		return EmptyPathLinkValue, errors.NewUnexpectedError("invalid link target path encoding: %w", err)
-	}
-	if num != CBORTagPathValue {
-		return EmptyPathLinkValue, errors.NewUnexpectedError("invalid link target path encoding: expected CBOR tag %d, got %d", CBORTagPathValue, num)
+		return EmptyPathLinkValue, err
 	}
-	pathValue, err := d.decodePath()
+	pathValue, err := d.decodePathFields()
 	if err != nil {
 		return EmptyPathLinkValue, errors.NewUnexpectedError("invalid link target path encoding: %w", err)
 	}
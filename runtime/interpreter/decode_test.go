@@ -0,0 +1,136 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package interpreter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime/errors"
+)
+
+func TestDecodeCBORTaggedArray(t *testing.T) {
+
+	t.Parallel()
+
+	test := func(t *testing.T, encoded []byte, expectedError string) {
+		decoder := CBORDecMode.NewByteStreamDecoder(encoded)
+
+		err := decodeCBORTaggedArray(
+			decoder,
+			CBORTagPathCapabilityValue,
+			encodedPathCapabilityValueLength,
+			"capability",
+		)
+		if expectedError == "" {
+			require.NoError(t, err)
+		} else {
+			var unexpectedErr errors.UnexpectedError
+			require.ErrorAs(t, err, &unexpectedErr)
+			require.EqualError(t, unexpectedErr.Err, expectedError)
+		}
+	}
+
+	t.Run("valid", func(t *testing.T) {
+
+		t.Parallel()
+
+		test(
+			t,
+			[]byte{
+				// tag
+				0xd8, CBORTagPathCapabilityValue,
+				// array, 3 items follow
+				0x83,
+				// positive integers 1, 2, 3
+				0x1, 0x2, 0x3,
+			},
+			"",
+		)
+	})
+
+	t.Run("wrong tag", func(t *testing.T) {
+
+		t.Parallel()
+
+		test(
+			t,
+			[]byte{
+				// tag
+				0xd8, CBORTagPathLinkValue,
+				// array, 3 items follow
+				0x83,
+				// positive integers 1, 2, 3
+				0x1, 0x2, 0x3,
+			},
+			"invalid capability encoding: expected CBOR tag 201, got 203",
+		)
+	})
+
+	t.Run("no tag", func(t *testing.T) {
+
+		t.Parallel()
+
+		test(
+			t,
+			[]byte{
+				// array, 3 items follow
+				0x83,
+				// positive integers 1, 2, 3
+				0x1, 0x2, 0x3,
+			},
+			"invalid capability encoding: expected CBOR tag 201, got CBOR array type",
+		)
+	})
+
+	t.Run("wrong length", func(t *testing.T) {
+
+		t.Parallel()
+
+		test(
+			t,
+			[]byte{
+				// tag
+				0xd8, CBORTagPathCapabilityValue,
+				// array, 2 items follow
+				0x82,
+				// positive integers 1, 2
+				0x1, 0x2,
+			},
+			"invalid capability encoding: expected [3]any, got [2]any",
+		)
+	})
+
+	t.Run("no array", func(t *testing.T) {
+
+		t.Parallel()
+
+		test(
+			t,
+			[]byte{
+				// tag
+				0xd8, CBORTagPathCapabilityValue,
+				// positive integer 3
+				0x3,
+			},
+			"invalid capability encoding: expected [3]any, got CBOR uint type",
+		)
+	})
+}
@@ -3895,6 +3895,177 @@ func TestEncodeDecodeCapabilityValue(t *testing.T) {
 	})
 }
 
+func TestEncodeDecodePathCapabilityValue(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("untyped capability", func(t *testing.T) {
+
+		t.Parallel()
+
+		value := NewUnmeteredPathCapabilityValue( //nolint:staticcheck
+			nil,
+			NewUnmeteredAddressValueFromBytes([]byte{0x2}),
+			publicPathValue,
+		)
+
+		encoded := []byte{
+			// tag
+			0xd8, CBORTagPathCapabilityValue,
+			// array, 3 items follow
+			0x83,
+			// tag for address
+			0xd8, CBORTagAddressValue,
+			// byte sequence, length 1
+			0x41,
+			// address
+			0x02,
+			// tag for path
+			0xd8, CBORTagPathValue,
+			// array, 2 items follow
+			0x82,
+			// positive integer 3
+			0x3,
+			// UTF-8 string, 3 bytes follow
+			0x63,
+			// b, a, r
+			0x62, 0x61, 0x72,
+			// nil
+			0xf6,
+		}
+
+		testEncodeDecode(t,
+			encodeDecodeTest{
+				value:   value,
+				encoded: encoded,
+			},
+		)
+	})
+
+	t.Run("typed capability", func(t *testing.T) {
+
+		t.Parallel()
+
+		value := NewUnmeteredPathCapabilityValue( //nolint:staticcheck
+			PrimitiveStaticTypeBool,
+			NewUnmeteredAddressValueFromBytes([]byte{0x2}),
+			publicPathValue,
+		)
+
+		encoded := []byte{
+			// tag
+			0xd8, CBORTagPathCapabilityValue,
+			// array, 3 items follow
+			0x83,
+			// tag for address
+			0xd8, CBORTagAddressValue,
+			// byte sequence, length 1
+			0x41,
+			// address
+			0x02,
+			// tag for path
+			0xd8, CBORTagPathValue,
+			// array, 2 items follow
+			0x82,
+			// positive integer 3
+			0x3,
+			// UTF-8 string, 3 bytes follow
+			0x63,
+			// b, a, r
+			0x62, 0x61, 0x72,
+			// tag for borrow type
+			0xd8, CBORTagPrimitiveStaticType,
+			// bool
+			0x6,
+		}
+
+		testEncodeDecode(t,
+			encodeDecodeTest{
+				value:   value,
+				encoded: encoded,
+			},
+		)
+	})
+
+	t.Run("invalid length", func(t *testing.T) {
+
+		t.Parallel()
+
+		encoded := []byte{
+			// tag
+			0xd8, CBORTagPathCapabilityValue,
+			// array, 2 items follow
+			0x82,
+			// tag for address
+			0xd8, CBORTagAddressValue,
+			// byte sequence, length 1
+			0x41,
+			// address
+			0x02,
+			// tag for path
+			0xd8, CBORTagPathValue,
+			// array, 2 items follow
+			0x82,
+			// positive integer 3
+			0x3,
+			// UTF-8 string, 3 bytes follow
+			0x63,
+			// b, a, r
+			0x62, 0x61, 0x72,
+		}
+
+		testEncodeDecode(t,
+			encodeDecodeTest{
+				encoded:    encoded,
+				decodeOnly: true,
+				invalid:    true,
+			},
+		)
+	})
+
+	t.Run("invalid address tag", func(t *testing.T) {
+
+		t.Parallel()
+
+		encoded := []byte{
+			// tag
+			0xd8, CBORTagPathCapabilityValue,
+			// array, 3 items follow
+			0x83,
+			// tag for path, instead of address
+			0xd8, CBORTagPathValue,
+			// array, 2 items follow
+			0x82,
+			// positive integer 3
+			0x3,
+			// UTF-8 string, 3 bytes follow
+			0x63,
+			// b, a, r
+			0x62, 0x61, 0x72,
+			// tag for path
+			0xd8, CBORTagPathValue,
+			// array, 2 items follow
+			0x82,
+			// positive integer 3
+			0x3,
+			// UTF-8 string, 3 bytes follow
+			0x63,
+			// b, a, r
+			0x62, 0x61, 0x72,
+			// nil
+			0xf6,
+		}
+
+		testEncodeDecode(t,
+			encodeDecodeTest{
+				encoded:    encoded,
+				decodeOnly: true,
+				invalid:    true,
+			},
+		)
+	})
+}
+
 func TestEncodeDecodeTypeValue(t *testing.T) {
 
 	t.Parallel()
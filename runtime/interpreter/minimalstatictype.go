@@ -0,0 +1,108 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package interpreter
+
+// MinimalStaticType returns the most specific static type of the given value.
+//
+// Unlike the static type of a value, which is the declared type,
+// e.g. `[AnyStruct]` for an array declared as such,
+// the minimal static type is determined by the run-time values:
+// The element types of arrays and dictionaries are unified
+// to the minimal static type of all their elements, if they are all the same,
+// and fall back to `AnyStruct` or `AnyResource` otherwise.
+// Dictionary key types fall back to the declared key type, as keys must be hashable.
+// The declared element types of empty arrays and dictionaries are preserved.
+func MinimalStaticType(inter *Interpreter, value Value) StaticType {
+	locationRange := EmptyLocationRange
+
+	switch value := value.(type) {
+	case *ArrayValue:
+		if value.Count() == 0 {
+			return value.StaticType(inter)
+		}
+
+		var elementTypes []StaticType
+		value.Iterate(
+			inter,
+			func(element Value) (resume bool) {
+				elementTypes = append(elementTypes, MinimalStaticType(inter, element))
+				return true
+			},
+			false,
+			locationRange,
+		)
+
+		elementType := unifiedStaticType(elementTypes, topStaticType(value.IsResourceKinded(inter)))
+
+		switch arrayType := value.Type.(type) {
+		case *ConstantSizedStaticType:
+			return NewConstantSizedStaticType(inter, elementType, arrayType.Size)
+		default:
+			return NewVariableSizedStaticType(inter, elementType)
+		}
+
+	case *DictionaryValue:
+		if value.Count() == 0 {
+			return value.StaticType(inter)
+		}
+
+		var keyTypes, valueTypes []StaticType
+		value.Iterate(
+			inter,
+			locationRange,
+			func(key, value Value) (resume bool) {
+				keyTypes = append(keyTypes, MinimalStaticType(inter, key))
+				valueTypes = append(valueTypes, MinimalStaticType(inter, value))
+				return true
+			},
+		)
+
+		keyType := unifiedStaticType(keyTypes, value.Type.KeyType)
+		valueType := unifiedStaticType(valueTypes, topStaticType(value.IsResourceKinded(inter)))
+
+		return NewDictionaryStaticType(inter, keyType, valueType)
+
+	case *SomeValue:
+		innerValue := value.InnerValue(inter, locationRange)
+		return NewOptionalStaticType(inter, MinimalStaticType(inter, innerValue))
+
+	default:
+		return value.StaticType(inter)
+	}
+}
+
+// unifiedStaticType returns the given types' common type, if they are all equal,
+// or otherwise the given fallback type
+func unifiedStaticType(types []StaticType, fallbackType StaticType) StaticType {
+	unifiedType := types[0]
+	for _, ty := range types[1:] {
+		if !ty.Equal(unifiedType) {
+			return fallbackType
+		}
+	}
+	return unifiedType
+}
+
+// topStaticType returns the top type of the given resource kind
+func topStaticType(isResourceKinded bool) StaticType {
+	if isResourceKinded {
+		return PrimitiveStaticTypeAnyResource
+	}
+	return PrimitiveStaticTypeAnyStruct
+}
@@ -0,0 +1,179 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package interpreter_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/onflow/cadence/runtime/common"
+	. "github.com/onflow/cadence/runtime/interpreter"
+)
+
+func TestMinimalStaticType(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("homogeneous array", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := newTestInterpreter(t)
+
+		array := NewArrayValue(
+			inter,
+			EmptyLocationRange,
+			NewVariableSizedStaticType(nil, PrimitiveStaticTypeAnyStruct),
+			common.ZeroAddress,
+			NewUnmeteredIntValueFromInt64(1),
+			NewUnmeteredIntValueFromInt64(2),
+		)
+
+		assert.Equal(t,
+			NewVariableSizedStaticType(nil, PrimitiveStaticTypeInt),
+			MinimalStaticType(inter, array),
+		)
+	})
+
+	t.Run("heterogeneous array", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := newTestInterpreter(t)
+
+		array := NewArrayValue(
+			inter,
+			EmptyLocationRange,
+			NewConstantSizedStaticType(nil, PrimitiveStaticTypeAnyStruct, 2),
+			common.ZeroAddress,
+			NewUnmeteredIntValueFromInt64(1),
+			NewUnmeteredStringValue("2"),
+		)
+
+		assert.Equal(t,
+			NewConstantSizedStaticType(nil, PrimitiveStaticTypeAnyStruct, 2),
+			MinimalStaticType(inter, array),
+		)
+	})
+
+	t.Run("empty array", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := newTestInterpreter(t)
+
+		array := NewArrayValue(
+			inter,
+			EmptyLocationRange,
+			NewVariableSizedStaticType(nil, PrimitiveStaticTypeAnyStruct),
+			common.ZeroAddress,
+		)
+
+		assert.Equal(t,
+			NewVariableSizedStaticType(nil, PrimitiveStaticTypeAnyStruct),
+			MinimalStaticType(inter, array),
+		)
+	})
+
+	t.Run("dictionary", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := newTestInterpreter(t)
+
+		dictionary := NewDictionaryValue(
+			inter,
+			EmptyLocationRange,
+			NewDictionaryStaticType(
+				nil,
+				PrimitiveStaticTypeHashableStruct,
+				PrimitiveStaticTypeAnyStruct,
+			),
+			NewUnmeteredStringValue("a"),
+			NewUnmeteredIntValueFromInt64(1),
+			NewUnmeteredStringValue("b"),
+			TrueValue,
+		)
+
+		assert.Equal(t,
+			NewDictionaryStaticType(
+				nil,
+				PrimitiveStaticTypeString,
+				PrimitiveStaticTypeAnyStruct,
+			),
+			MinimalStaticType(inter, dictionary),
+		)
+	})
+
+	t.Run("dictionary, heterogeneous keys", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := newTestInterpreter(t)
+
+		dictionary := NewDictionaryValue(
+			inter,
+			EmptyLocationRange,
+			NewDictionaryStaticType(
+				nil,
+				PrimitiveStaticTypeHashableStruct,
+				PrimitiveStaticTypeAnyStruct,
+			),
+			NewUnmeteredStringValue("a"),
+			NewUnmeteredIntValueFromInt64(1),
+			NewUnmeteredIntValueFromInt64(2),
+			NewUnmeteredIntValueFromInt64(3),
+		)
+
+		assert.Equal(t,
+			NewDictionaryStaticType(
+				nil,
+				PrimitiveStaticTypeHashableStruct,
+				PrimitiveStaticTypeInt,
+			),
+			MinimalStaticType(inter, dictionary),
+		)
+	})
+
+	t.Run("optional", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := newTestInterpreter(t)
+
+		value := NewUnmeteredSomeValueNonCopying(
+			NewArrayValue(
+				inter,
+				EmptyLocationRange,
+				NewVariableSizedStaticType(nil, PrimitiveStaticTypeAnyStruct),
+				common.ZeroAddress,
+				TrueValue,
+			),
+		)
+
+		assert.Equal(t,
+			NewOptionalStaticType(
+				nil,
+				NewVariableSizedStaticType(nil, PrimitiveStaticTypeBool),
+			),
+			MinimalStaticType(inter, value),
+		)
+	})
+}
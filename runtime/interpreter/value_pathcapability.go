@@ -30,11 +30,20 @@ import (
 
 // TODO: remove once migrated
 
+// PathCapabilityIDResolver resolves the ID of the capability controller
+// which the path capability with the given address, path, and borrow type
+// was (or will be) migrated to.
+// It returns false if there is no such capability controller.
+type PathCapabilityIDResolver func(addressPath AddressPath, borrowType StaticType) (UInt64Value, bool)
+
 // Deprecated: PathCapabilityValue
 type PathCapabilityValue struct {
 	BorrowType StaticType
 	Path       PathValue
 	address    AddressValue
+	// idResolver is optional, and used by migration tooling
+	// to resolve the ID of the capability
+	idResolver PathCapabilityIDResolver
 }
 
 var _ Value = &PathCapabilityValue{}
@@ -56,12 +65,32 @@ func NewUnmeteredPathCapabilityValue(
 	}
 }
 
+// Deprecated: NewUnmeteredPathCapabilityValueWithIDResolver
+func NewUnmeteredPathCapabilityValueWithIDResolver(
+	borrowType StaticType,
+	address AddressValue,
+	path PathValue,
+	idResolver PathCapabilityIDResolver,
+) *PathCapabilityValue {
+	return &PathCapabilityValue{
+		BorrowType: borrowType,
+		address:    address,
+		Path:       path,
+		idResolver: idResolver,
+	}
+}
+
 func (*PathCapabilityValue) isValue() {}
 
 func (*PathCapabilityValue) isCapabilityValue() {}
 
-func (v *PathCapabilityValue) Accept(_ *Interpreter, _ Visitor, _ LocationRange) {
-	panic(errors.NewUnreachableError())
+func (v *PathCapabilityValue) Accept(interpreter *Interpreter, visitor Visitor, locationRange LocationRange) {
+	descend := visitor.VisitPathCapabilityValue(interpreter, v)
+	if !descend {
+		return
+	}
+	v.address.Accept(interpreter, visitor, locationRange)
+	v.Path.Accept(interpreter, visitor, locationRange)
 }
 
 func (v *PathCapabilityValue) Walk(_ *Interpreter, walkChild func(Value), _ LocationRange) {
@@ -177,12 +206,26 @@ func (v *PathCapabilityValue) GetMember(interpreter *Interpreter, _ LocationRang
 		return v.address
 
 	case sema.CapabilityTypeIDFieldName:
-		return InvalidCapabilityID
+		return v.id()
 	}
 
 	return nil
 }
 
+// id returns the ID of the capability controller the capability was migrated to,
+// if an ID resolver is set and resolves the capability,
+// or InvalidCapabilityID otherwise
+func (v *PathCapabilityValue) id() UInt64Value {
+	if v.idResolver != nil {
+		capabilityID, ok := v.idResolver(v.AddressPath(), v.BorrowType)
+		if ok {
+			return capabilityID
+		}
+	}
+
+	return InvalidCapabilityID
+}
+
 func (*PathCapabilityValue) RemoveMember(_ *Interpreter, _ LocationRange, _ string) Value {
 	panic(errors.NewUnreachableError())
 }
@@ -265,6 +308,7 @@ func (v *PathCapabilityValue) Clone(interpreter *Interpreter) Value {
 		BorrowType: v.BorrowType,
 		Path:       v.Path.Clone(interpreter).(PathValue),
 		address:    v.address.Clone(interpreter).(AddressValue),
+		idResolver: v.idResolver,
 	}
 }
 
@@ -354,3 +398,22 @@ func (v *PathCapabilityValue) Encode(e *atree.Encoder) error {
 func (v *PathCapabilityValue) Address() AddressValue {
 	return v.address
 }
+
+// PathCapabilityDescription describes a path capability,
+// e.g. for migration and audit tooling
+type PathCapabilityDescription struct {
+	Address AddressValue
+	Path    PathValue
+	// BorrowType is nil if the capability is untyped
+	BorrowType StaticType
+}
+
+// Describe returns a description of the path capability,
+// which does not depend on the string representation of the value
+func (v *PathCapabilityValue) Describe() PathCapabilityDescription {
+	return PathCapabilityDescription{
+		Address:    v.address,
+		Path:       v.Path,
+		BorrowType: v.BorrowType,
+	}
+}
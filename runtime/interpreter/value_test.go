@@ -1423,6 +1423,133 @@ func TestVisitor(t *testing.T) {
 	require.Equal(t, 1, stringVisits)
 }
 
+func TestPathCapabilityValueVisitor(t *testing.T) {
+
+	t.Parallel()
+
+	inter := newTestInterpreter(t)
+
+	address := AddressValue{0x42}
+	path := PathValue{
+		Domain:     common.PathDomainStorage,
+		Identifier: "foo",
+	}
+
+	value := NewUnmeteredPathCapabilityValue( //nolint:staticcheck
+		PrimitiveStaticTypeAnyStruct,
+		address,
+		path,
+	)
+
+	t.Run("descend", func(t *testing.T) {
+
+		t.Parallel()
+
+		var visits []Value
+
+		visitor := EmptyVisitor{
+			PathCapabilityValueVisitor: func(_ *Interpreter, value *PathCapabilityValue) bool { //nolint:staticcheck
+				visits = append(visits, value)
+				return true
+			},
+			AddressValueVisitor: func(_ *Interpreter, value AddressValue) {
+				visits = append(visits, value)
+			},
+			PathValueVisitor: func(_ *Interpreter, value PathValue) {
+				visits = append(visits, value)
+			},
+		}
+
+		value.Accept(inter, visitor, EmptyLocationRange)
+
+		require.Equal(t,
+			[]Value{
+				value,
+				address,
+				path,
+			},
+			visits,
+		)
+	})
+
+	t.Run("no descend", func(t *testing.T) {
+
+		t.Parallel()
+
+		var visits []Value
+
+		visitor := EmptyVisitor{
+			PathCapabilityValueVisitor: func(_ *Interpreter, value *PathCapabilityValue) bool { //nolint:staticcheck
+				visits = append(visits, value)
+				return false
+			},
+			AddressValueVisitor: func(_ *Interpreter, value AddressValue) {
+				visits = append(visits, value)
+			},
+			PathValueVisitor: func(_ *Interpreter, value PathValue) {
+				visits = append(visits, value)
+			},
+		}
+
+		value.Accept(inter, visitor, EmptyLocationRange)
+
+		require.Equal(t,
+			[]Value{value},
+			visits,
+		)
+	})
+}
+
+func TestPathCapabilityValueDescribe(t *testing.T) {
+
+	t.Parallel()
+
+	address := AddressValue{0x42}
+	path := PathValue{
+		Domain:     common.PathDomainStorage,
+		Identifier: "foo",
+	}
+
+	t.Run("typed", func(t *testing.T) {
+
+		t.Parallel()
+
+		value := NewUnmeteredPathCapabilityValue( //nolint:staticcheck
+			PrimitiveStaticTypeAnyStruct,
+			address,
+			path,
+		)
+
+		require.Equal(t,
+			PathCapabilityDescription{
+				Address:    address,
+				Path:       path,
+				BorrowType: PrimitiveStaticTypeAnyStruct,
+			},
+			value.Describe(),
+		)
+	})
+
+	t.Run("untyped", func(t *testing.T) {
+
+		t.Parallel()
+
+		value := NewUnmeteredPathCapabilityValue( //nolint:staticcheck
+			nil,
+			address,
+			path,
+		)
+
+		require.Equal(t,
+			PathCapabilityDescription{
+				Address: address,
+				Path:    path,
+			},
+			value.Describe(),
+		)
+	})
+}
+
 func TestGetHashInput(t *testing.T) {
 
 	t.Parallel()
@@ -57,6 +57,7 @@ type Visitor interface {
 	VisitAddressValue(interpreter *Interpreter, value AddressValue)
 	VisitPathValue(interpreter *Interpreter, value PathValue)
 	VisitCapabilityValue(interpreter *Interpreter, value *IDCapabilityValue)
+	VisitPathCapabilityValue(interpreter *Interpreter, value *PathCapabilityValue) bool //nolint:staticcheck
 	VisitPublishedValue(interpreter *Interpreter, value *PublishedValue)
 	VisitInterpretedFunctionValue(interpreter *Interpreter, value *InterpretedFunctionValue)
 	VisitHostFunctionValue(interpreter *Interpreter, value *HostFunctionValue)
@@ -104,6 +105,7 @@ type EmptyVisitor struct {
 	AddressValueVisitor                     func(interpreter *Interpreter, value AddressValue)
 	PathValueVisitor                        func(interpreter *Interpreter, value PathValue)
 	CapabilityValueVisitor                  func(interpreter *Interpreter, value *IDCapabilityValue)
+	PathCapabilityValueVisitor              func(interpreter *Interpreter, value *PathCapabilityValue) bool //nolint:staticcheck
 	PublishedValueVisitor                   func(interpreter *Interpreter, value *PublishedValue)
 	InterpretedFunctionValueVisitor         func(interpreter *Interpreter, value *InterpretedFunctionValue)
 	HostFunctionValueVisitor                func(interpreter *Interpreter, value *HostFunctionValue)
@@ -380,6 +382,13 @@ func (v EmptyVisitor) VisitCapabilityValue(interpreter *Interpreter, value *IDCa
 	v.CapabilityValueVisitor(interpreter, value)
 }
 
+func (v EmptyVisitor) VisitPathCapabilityValue(interpreter *Interpreter, value *PathCapabilityValue) bool { //nolint:staticcheck
+	if v.PathCapabilityValueVisitor == nil {
+		return true
+	}
+	return v.PathCapabilityValueVisitor(interpreter, value)
+}
+
 func (v EmptyVisitor) VisitPublishedValue(interpreter *Interpreter, value *PublishedValue) {
 	if v.PublishedValueVisitor == nil {
 		return
@@ -475,6 +475,7 @@ func parsePragmaDeclaration(p *parser) (*ast.PragmaDeclaration, error) {
 			startPos,
 			expr.EndPosition(p.memoryGauge),
 		),
+		ast.Comments{},
 	), nil
 }
 
@@ -698,6 +699,7 @@ func parseImportDeclaration(p *parser) (*ast.ImportDeclaration, error) {
 			endPos,
 		),
 		locationPos,
+		ast.Comments{},
 	), nil
 }
 
@@ -802,6 +804,7 @@ func parseEventDeclaration(
 			nil,
 			parameterList.StartPos,
 			"",
+			ast.Comments{},
 		),
 	)
 
@@ -916,6 +919,7 @@ func parseFieldWithVariableKind(
 			startPos,
 			typeAnnotation.EndPosition(p.memoryGauge),
 		),
+		ast.Comments{},
 	), nil
 }
 
@@ -1471,6 +1475,7 @@ func parseFieldDeclarationWithoutVariableKind(
 			startPos,
 			typeAnnotation.EndPosition(p.memoryGauge),
 		),
+		ast.Comments{},
 	), nil
 }
 
@@ -1536,6 +1541,7 @@ func parseSpecialFunctionDeclaration(
 			functionBlock,
 			startPos,
 			docString,
+			ast.Comments{},
 		),
 	), nil
 }
@@ -1574,5 +1580,6 @@ func parseEnumCase(
 		identifier,
 		docString,
 		startPos,
+		ast.Comments{},
 	), nil
 }
@@ -342,6 +342,7 @@ func parseFunctionDeclaration(
 		functionBlock,
 		startPos,
 		docString,
+		ast.Comments{},
 	), nil
 }
 
@@ -195,6 +195,7 @@ func parseFunctionDeclarationOrFunctionExpressionStatement(p *parser) (ast.State
 			functionBlock,
 			startPos,
 			"",
+			ast.Comments{},
 		), nil
 	} else {
 		parameterList, returnTypeAnnotation, functionBlock, err :=
@@ -250,6 +251,7 @@ func parseReturnStatement(p *parser) (*ast.ReturnStatement, error) {
 			tokenRange.StartPos,
 			endPosition,
 		),
+		ast.Comments{},
 	), nil
 }
 
@@ -336,6 +338,7 @@ func parseIfStatement(p *parser) (*ast.IfStatement, error) {
 			thenBlock,
 			elseBlock,
 			startPos,
+			ast.Comments{},
 		)
 
 		if variableDeclaration != nil {
@@ -381,7 +384,13 @@ func parseWhileStatement(p *parser) (*ast.WhileStatement, error) {
 		return nil, err
 	}
 
-	return ast.NewWhileStatement(p.memoryGauge, expression, block, startPos), nil
+	return ast.NewWhileStatement(
+		p.memoryGauge,
+		expression,
+		block,
+		startPos,
+		ast.Comments{},
+	), nil
 }
 
 func parseForStatement(p *parser) (*ast.ForStatement, error) {
@@ -447,6 +456,7 @@ func parseForStatement(p *parser) (*ast.ForStatement, error) {
 		block,
 		expression,
 		startPos,
+		ast.Comments{},
 	), nil
 }
 
@@ -287,6 +287,7 @@ func parseTransactionExecute(p *parser) (*ast.SpecialFunctionDeclaration, error)
 			),
 			identifier.Pos,
 			"",
+			ast.Comments{},
 		),
 	), nil
 }
@@ -281,6 +281,7 @@ func parseDeclaration(p *parser, docString string) (ast.Declaration, error) {
 }
 
 func handlePriv(p *parser) {
+	p.recordLegacyAccessModifier(ast.AccessSelf, p.current.Range)
 	p.report(p.syntaxErrorWithSuggestedFix(
 		"`priv` is no longer a valid access keyword",
 		"access(self)",
@@ -288,6 +289,26 @@ func handlePriv(p *parser) {
 	p.next()
 }
 
+// recordLegacyAccessModifier records the legacy access modifier with the given range,
+// which is replaced by the given access
+func (p *parser) recordLegacyAccessModifier(access ast.PrimitiveAccess, keywordRange ast.Range) {
+	// The modifier might have already been recorded, if the tokens are replayed
+	count := len(p.legacyAccessModifiers)
+	if count > 0 &&
+		p.legacyAccessModifiers[count-1].StartPos.Offset >= keywordRange.StartPos.Offset {
+
+		return
+	}
+
+	p.legacyAccessModifiers = append(
+		p.legacyAccessModifiers,
+		ast.LegacyAccessModifier{
+			Access: access,
+			Range:  keywordRange,
+		},
+	)
+}
+
 func handlePub(p *parser) error {
 	pubToken := p.current
 
@@ -295,6 +316,7 @@ func handlePub(p *parser) error {
 
 	// Try to parse `(set)` if given
 	if !p.current.Is(lexer.TokenParenOpen) {
+		p.recordLegacyAccessModifier(ast.AccessAll, pubToken.Range)
 		p.report(NewSyntaxErrorWithSuggestedReplacement(
 			pubToken.Range,
 			"`pub` is no longer a valid access keyword",
@@ -623,6 +645,7 @@ func parseTransfer(p *parser) *ast.Transfer {
 
 func parsePragmaDeclaration(p *parser) (*ast.PragmaDeclaration, error) {
 	startPos := p.current.StartPosition()
+	comments := p.takeLeadingComments()
 	p.next()
 
 	expr, err := parseExpression(p, lowestBindingPower)
@@ -638,6 +661,7 @@ func parsePragmaDeclaration(p *parser) (*ast.PragmaDeclaration, error) {
 			startPos,
 			expr.EndPosition(p.memoryGauge),
 		),
+		comments,
 	), nil
 }
 
@@ -650,6 +674,7 @@ func parsePragmaDeclaration(p *parser) (*ast.PragmaDeclaration, error) {
 func parseImportDeclaration(p *parser) (*ast.ImportDeclaration, error) {
 
 	startPosition := p.current.StartPos
+	comments := p.takeLeadingComments()
 
 	var identifiers []ast.Identifier
 
@@ -861,6 +886,7 @@ func parseImportDeclaration(p *parser) (*ast.ImportDeclaration, error) {
 			endPos,
 		),
 		locationPos,
+		comments,
 	), nil
 }
 
@@ -964,6 +990,7 @@ func parseEventDeclaration(
 			nil,
 			parameterList.StartPos,
 			"",
+			ast.Comments{},
 		),
 	)
 
@@ -1029,6 +1056,7 @@ func parseFieldWithVariableKind(
 ) (*ast.FieldDeclaration, error) {
 
 	startPos := ast.EarliestPosition(p.current.StartPos, accessPos, staticPos, nativePos)
+	comments := p.takeLeadingComments()
 
 	var variableKind ast.VariableKind
 	switch string(p.currentTokenSource()) {
@@ -1078,6 +1106,7 @@ func parseFieldWithVariableKind(
 			startPos,
 			typeAnnotation.EndPosition(p.memoryGauge),
 		),
+		comments,
 	), nil
 }
 
@@ -1865,6 +1894,7 @@ func parseFieldDeclarationWithoutVariableKind(
 ) (*ast.FieldDeclaration, error) {
 
 	startPos := ast.EarliestPosition(identifier.Pos, accessPos, staticPos, nativePos)
+	comments := p.takeLeadingComments()
 
 	_, err := p.mustOne(lexer.TokenColon)
 	if err != nil {
@@ -1892,6 +1922,7 @@ func parseFieldDeclarationWithoutVariableKind(
 			startPos,
 			typeAnnotation.EndPosition(p.memoryGauge),
 		),
+		comments,
 	), nil
 }
 
@@ -1909,6 +1940,7 @@ func parseSpecialFunctionDeclaration(
 ) (*ast.SpecialFunctionDeclaration, error) {
 
 	startPos := ast.EarliestPosition(identifier.Pos, accessPos, purityPos, staticPos, nativePos)
+	comments := p.takeLeadingComments()
 
 	parameterList, returnTypeAnnotation, functionBlock, err :=
 		parseFunctionParameterListAndRest(p, functionBlockIsOptional)
@@ -1959,6 +1991,7 @@ func parseSpecialFunctionDeclaration(
 			functionBlock,
 			startPos,
 			docString,
+			comments,
 		),
 	), nil
 }
@@ -1977,6 +2010,7 @@ func parseEnumCase(
 	if accessPos != nil {
 		startPos = *accessPos
 	}
+	comments := p.takeLeadingComments()
 
 	// Skip the `enum` keyword
 	p.nextSemanticToken()
@@ -1997,5 +2031,6 @@ func parseEnumCase(
 		identifier,
 		docString,
 		startPos,
+		comments,
 	), nil
 }
@@ -882,7 +882,19 @@ func TestParseFunctionDeclaration(t *testing.T) {
 						},
 					},
 					DocString: " Test",
-					StartPos:  ast.Position{Line: 2, Column: 0, Offset: 9},
+					Comments: ast.Comments{
+						Leading: []*ast.Comment{
+							ast.NewComment(
+								nil,
+								[]byte("/// Test"),
+								ast.Range{
+									StartPos: ast.Position{Offset: 0, Line: 1, Column: 0},
+									EndPos:   ast.Position{Offset: 7, Line: 1, Column: 7},
+								},
+							),
+						},
+					},
+					StartPos: ast.Position{Line: 2, Column: 0, Offset: 9},
 				},
 			},
 			result,
@@ -920,7 +932,27 @@ func TestParseFunctionDeclaration(t *testing.T) {
 						},
 					},
 					DocString: " First line\n Second line",
-					StartPos:  ast.Position{Line: 7, Column: 0, Offset: 39},
+					Comments: ast.Comments{
+						Leading: []*ast.Comment{
+							ast.NewComment(
+								nil,
+								[]byte("/// First line"),
+								ast.Range{
+									StartPos: ast.Position{Offset: 3, Line: 2, Column: 2},
+									EndPos:   ast.Position{Offset: 16, Line: 2, Column: 15},
+								},
+							),
+							ast.NewComment(
+								nil,
+								[]byte("/// Second line"),
+								ast.Range{
+									StartPos: ast.Position{Offset: 21, Line: 4, Column: 0},
+									EndPos:   ast.Position{Offset: 35, Line: 4, Column: 14},
+								},
+							),
+						},
+					},
+					StartPos: ast.Position{Line: 7, Column: 0, Offset: 39},
 				},
 			},
 			result,
@@ -958,7 +990,19 @@ func TestParseFunctionDeclaration(t *testing.T) {
 						},
 					},
 					DocString: " Cool dogs.\n\n Cool cats!! ",
-					StartPos:  ast.Position{Line: 7, Column: 0, Offset: 39},
+					Comments: ast.Comments{
+						Leading: []*ast.Comment{
+							ast.NewComment(
+								nil,
+								[]byte("/** Cool dogs.\n\n Cool cats!! */"),
+								ast.Range{
+									StartPos: ast.Position{Offset: 5, Line: 2, Column: 4},
+									EndPos:   ast.Position{Offset: 35, Line: 4, Column: 14},
+								},
+							),
+						},
+					},
+					StartPos: ast.Position{Line: 7, Column: 0, Offset: 39},
 				},
 			},
 			result,
@@ -2419,6 +2463,35 @@ func TestParseImportDeclaration(t *testing.T) {
 			result,
 		)
 	})
+
+	t.Run("leading comments", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := testParseDeclarations(`
+          // nolint: unused
+          /* the token standard */
+          import "foo"
+
+          import "bar"
+        `)
+		require.Empty(t, errs)
+
+		require.Len(t, result, 2)
+
+		first, ok := result[0].(*ast.ImportDeclaration)
+		require.True(t, ok)
+
+		require.Len(t, first.Comments.Leading, 2)
+		assert.Equal(t, "nolint: unused", string(first.Comments.Leading[0].Text()))
+		assert.Equal(t, "the token standard", string(first.Comments.Leading[1].Text()))
+		assert.Equal(t, "/* the token standard */", string(first.Comments.Leading[1].Source()))
+
+		second, ok := result[1].(*ast.ImportDeclaration)
+		require.True(t, ok)
+
+		assert.Empty(t, second.Comments.Leading)
+	})
 }
 
 func TestParseEvent(t *testing.T) {
@@ -3685,6 +3758,45 @@ func TestParseCompositeDeclaration(t *testing.T) {
 			errs,
 		)
 	})
+
+	t.Run("fields with leading comments", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := testParseDeclarations(`
+          struct S {
+              // the first
+              /// The first field
+              let a: Int
+
+              access(all) var b: Int
+
+              /// The third field
+              c: Int
+          }
+        `)
+		require.Empty(t, errs)
+
+		require.Len(t, result, 1)
+
+		compositeDeclaration, ok := result[0].(*ast.CompositeDeclaration)
+		require.True(t, ok)
+
+		fields := compositeDeclaration.Members.Fields()
+		require.Len(t, fields, 3)
+
+		first := fields[0]
+		require.Len(t, first.Comments.Leading, 2)
+		assert.Equal(t, "the first", string(first.Comments.Leading[0].Text()))
+		assert.Equal(t, "/// The first field", string(first.Comments.Leading[1].Source()))
+		assert.Equal(t, " The first field", first.DocString)
+
+		assert.Empty(t, fields[1].Comments.Leading)
+
+		third := fields[2]
+		require.Len(t, third.Comments.Leading, 1)
+		assert.Equal(t, "/// The third field", string(third.Comments.Leading[0].Source()))
+	})
 }
 
 func TestParseInvalidCompositeFunctionWithSelfParameter(t *testing.T) {
@@ -4840,6 +4952,38 @@ func TestParseEnumDeclaration(t *testing.T) {
 			errs,
 		)
 	})
+
+	t.Run("enum case with leading comments", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := testParseDeclarations(`
+          enum E: UInt8 {
+              // the default
+              /// The first case
+              case a
+
+              case b
+          }
+        `)
+		require.Empty(t, errs)
+
+		require.Len(t, result, 1)
+
+		enumDeclaration, ok := result[0].(*ast.CompositeDeclaration)
+		require.True(t, ok)
+
+		enumCases := enumDeclaration.Members.EnumCases()
+		require.Len(t, enumCases, 2)
+
+		first := enumCases[0]
+		require.Len(t, first.Comments.Leading, 2)
+		assert.Equal(t, "the default", string(first.Comments.Leading[0].Text()))
+		assert.Equal(t, "/// The first case", string(first.Comments.Leading[1].Source()))
+		assert.Equal(t, " The first case", first.DocString)
+
+		assert.Empty(t, enumCases[1].Comments.Leading)
+	})
 }
 
 func TestParseTransactionDeclaration(t *testing.T) {
@@ -5597,6 +5741,126 @@ func TestParseTransactionDeclaration(t *testing.T) {
 			errs[0].Error(),
 		)
 	})
+
+	t.Run("pre condition with leading comment", func(t *testing.T) {
+
+		t.Parallel()
+
+		const code = `
+		transaction {
+		    prepare() {}
+		    pre {
+		        // the answer
+		        x == 42
+		        y == 0
+		    }
+		    execute {}
+		}
+		`
+
+		result, errs := testParseDeclarations(code)
+		require.Empty(t, errs)
+		require.Len(t, result, 1)
+
+		transaction, ok := result[0].(*ast.TransactionDeclaration)
+		require.True(t, ok)
+
+		utils.AssertEqualWithDiff(t,
+			&ast.Conditions{
+				&ast.TestCondition{
+					Test: &ast.BinaryExpression{
+						Operation: ast.OperationEqual,
+						Left: &ast.IdentifierExpression{
+							Identifier: ast.Identifier{
+								Identifier: "x",
+								Pos:        ast.Position{Offset: 82, Line: 6, Column: 10},
+							},
+						},
+						Right: &ast.IntegerExpression{
+							PositiveLiteral: []byte("42"),
+							Value:           big.NewInt(42),
+							Base:            10,
+							Range: ast.Range{
+								StartPos: ast.Position{Offset: 87, Line: 6, Column: 15},
+								EndPos:   ast.Position{Offset: 88, Line: 6, Column: 16},
+							},
+						},
+					},
+					Comments: ast.Comments{
+						Leading: []*ast.Comment{
+							ast.NewComment(
+								nil,
+								[]byte("// the answer"),
+								ast.Range{
+									StartPos: ast.Position{Offset: 58, Line: 5, Column: 10},
+									EndPos:   ast.Position{Offset: 70, Line: 5, Column: 22},
+								},
+							),
+						},
+					},
+				},
+				&ast.TestCondition{
+					Test: &ast.BinaryExpression{
+						Operation: ast.OperationEqual,
+						Left: &ast.IdentifierExpression{
+							Identifier: ast.Identifier{
+								Identifier: "y",
+								Pos:        ast.Position{Offset: 100, Line: 7, Column: 10},
+							},
+						},
+						Right: &ast.IntegerExpression{
+							PositiveLiteral: []byte("0"),
+							Value:           new(big.Int),
+							Base:            10,
+							Range: ast.Range{
+								StartPos: ast.Position{Offset: 105, Line: 7, Column: 15},
+								EndPos:   ast.Position{Offset: 105, Line: 7, Column: 15},
+							},
+						},
+					},
+				},
+			},
+			transaction.PreConditions,
+		)
+	})
+
+	t.Run("pre conditions with leading comments", func(t *testing.T) {
+
+		t.Parallel()
+
+		const code = `
+		transaction {
+		    prepare() {}
+		    pre {
+		        // first
+		        x > 0
+		        // second
+		        y > 0 : "y must be positive"
+		        /* third */
+		        z > 0
+		    }
+		    execute {}
+		}
+		`
+
+		result, errs := testParseDeclarations(code)
+		require.Empty(t, errs)
+		require.Len(t, result, 1)
+
+		transaction, ok := result[0].(*ast.TransactionDeclaration)
+		require.True(t, ok)
+
+		conditions := *transaction.PreConditions
+		require.Len(t, conditions, 3)
+
+		for i, text := range []string{"first", "second", "third"} {
+			condition, ok := conditions[i].(*ast.TestCondition)
+			require.True(t, ok)
+
+			require.Len(t, condition.Comments.Leading, 1)
+			assert.Equal(t, text, string(condition.Comments.Leading[0].Text()))
+		}
+	})
 }
 
 func TestParseFunctionAndBlock(t *testing.T) {
@@ -6895,6 +7159,28 @@ func TestParsePragmaNoArguments(t *testing.T) {
 			errs,
 		)
 	})
+
+	t.Run("leading comments", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := testParseDeclarations(`
+          /// enables pedantic checking
+          #pedantic
+        `)
+		require.Empty(t, errs)
+
+		require.Len(t, result, 1)
+
+		pragma, ok := result[0].(*ast.PragmaDeclaration)
+		require.True(t, ok)
+
+		require.Len(t, pragma.Comments.Leading, 1)
+
+		comment := pragma.Comments.Leading[0]
+		assert.True(t, comment.Doc())
+		assert.Equal(t, "enables pedantic checking", string(comment.Text()))
+	})
 }
 
 func TestParsePragmaArguments(t *testing.T) {
@@ -8891,6 +9177,18 @@ func TestParseMemberDocStrings(t *testing.T) {
 							&ast.FunctionDeclaration{
 								Access:    ast.AccessNotSpecified,
 								DocString: " noReturnNoBlock",
+								Comments: ast.Comments{
+									Leading: []*ast.Comment{
+										ast.NewComment(
+											nil,
+											[]byte("/// noReturnNoBlock"),
+											ast.Range{
+												StartPos: ast.Position{Offset: 40, Line: 4, Column: 14},
+												EndPos:   ast.Position{Offset: 58, Line: 4, Column: 32},
+											},
+										),
+									},
+								},
 								Identifier: ast.Identifier{
 									Identifier: "noReturnNoBlock",
 									Pos:        ast.Position{Offset: 78, Line: 5, Column: 18},
@@ -8906,6 +9204,18 @@ func TestParseMemberDocStrings(t *testing.T) {
 							&ast.FunctionDeclaration{
 								Access:    ast.AccessNotSpecified,
 								DocString: " returnNoBlock",
+								Comments: ast.Comments{
+									Leading: []*ast.Comment{
+										ast.NewComment(
+											nil,
+											[]byte("/// returnNoBlock"),
+											ast.Range{
+												StartPos: ast.Position{Offset: 111, Line: 7, Column: 14},
+												EndPos:   ast.Position{Offset: 127, Line: 7, Column: 30},
+											},
+										),
+									},
+								},
 								Identifier: ast.Identifier{
 									Identifier: "returnNoBlock",
 									Pos:        ast.Position{Offset: 147, Line: 8, Column: 18},
@@ -8931,6 +9241,18 @@ func TestParseMemberDocStrings(t *testing.T) {
 							&ast.FunctionDeclaration{
 								Access:    ast.AccessNotSpecified,
 								DocString: " returnAndBlock",
+								Comments: ast.Comments{
+									Leading: []*ast.Comment{
+										ast.NewComment(
+											nil,
+											[]byte("/// returnAndBlock"),
+											ast.Range{
+												StartPos: ast.Position{Offset: 183, Line: 10, Column: 14},
+												EndPos:   ast.Position{Offset: 200, Line: 10, Column: 31},
+											},
+										),
+									},
+								},
 								Identifier: ast.Identifier{
 									Identifier: "returnAndBlock",
 									Pos:        ast.Position{Offset: 220, Line: 11, Column: 18},
@@ -9006,6 +9328,18 @@ func TestParseMemberDocStrings(t *testing.T) {
 								FunctionDeclaration: &ast.FunctionDeclaration{
 									Access:    ast.AccessNotSpecified,
 									DocString: " unknown",
+									Comments: ast.Comments{
+										Leading: []*ast.Comment{
+											ast.NewComment(
+												nil,
+												[]byte("/// unknown"),
+												ast.Range{
+													StartPos: ast.Position{Offset: 40, Line: 4, Column: 14},
+													EndPos:   ast.Position{Offset: 50, Line: 4, Column: 24},
+												},
+											),
+										},
+									},
 									Identifier: ast.Identifier{
 										Identifier: "unknown",
 										Pos:        ast.Position{Offset: 66, Line: 5, Column: 14},
@@ -9024,6 +9358,18 @@ func TestParseMemberDocStrings(t *testing.T) {
 								FunctionDeclaration: &ast.FunctionDeclaration{
 									Access:    ast.AccessNotSpecified,
 									DocString: " initNoBlock",
+									Comments: ast.Comments{
+										Leading: []*ast.Comment{
+											ast.NewComment(
+												nil,
+												[]byte("/// initNoBlock"),
+												ast.Range{
+													StartPos: ast.Position{Offset: 91, Line: 7, Column: 14},
+													EndPos:   ast.Position{Offset: 105, Line: 7, Column: 28},
+												},
+											),
+										},
+									},
 									Identifier: ast.Identifier{
 										Identifier: "init",
 										Pos:        ast.Position{Offset: 121, Line: 8, Column: 14},
@@ -9708,6 +10054,34 @@ func TestParseDeprecatedAccessModifiers(t *testing.T) {
 			errs,
 		)
 	})
+
+	t.Run("recorded in program", func(t *testing.T) {
+
+		t.Parallel()
+
+		program, err := ParseProgram(nil, []byte(" pub fun foo ( ) { priv let x = 1 }"), Config{})
+		require.Error(t, err)
+
+		utils.AssertEqualWithDiff(t,
+			[]ast.LegacyAccessModifier{
+				{
+					Access: ast.AccessAll,
+					Range: ast.Range{
+						StartPos: ast.Position{Offset: 1, Line: 1, Column: 1},
+						EndPos:   ast.Position{Offset: 3, Line: 1, Column: 3},
+					},
+				},
+				{
+					Access: ast.AccessSelf,
+					Range: ast.Range{
+						StartPos: ast.Position{Offset: 19, Line: 1, Column: 19},
+						EndPos:   ast.Position{Offset: 22, Line: 1, Column: 22},
+					},
+				},
+			},
+			program.LegacyAccessModifiers(),
+		)
+	})
 }
 
 func TestParseKeywordsAsFieldNames(t *testing.T) {
@@ -330,6 +330,7 @@ func parseFunctionDeclaration(
 ) (*ast.FunctionDeclaration, error) {
 
 	startPos := ast.EarliestPosition(p.current.StartPos, accessPos, purityPos, staticPos, nativePos)
+	comments := p.takeLeadingComments()
 
 	// Skip the `fun` keyword
 	p.nextSemanticToken()
@@ -373,6 +374,7 @@ func parseFunctionDeclaration(
 		functionBlock,
 		startPos,
 		docString,
+		comments,
 	), nil
 }
 
@@ -153,6 +153,34 @@ func Lex(input []byte, memoryGauge common.MemoryGauge) (TokenStream, error) {
 	return l, err
 }
 
+// Tokenize returns the tokens of the given input, including trivia,
+// i.e. space tokens and comment tokens, e.g. for syntax highlighting.
+//
+// Unlike parsing, tokenizing does not require the input to be valid, e.g. it may be incomplete.
+// If the input cannot be tokenized, the tokens up to the error are returned, together with the error.
+func Tokenize(input []byte) ([]Token, error) {
+	tokenStream, err := Lex(input, nil)
+	defer tokenStream.Reclaim()
+
+	var tokens []Token
+	for {
+		token := tokenStream.Next()
+		switch token.Type {
+		case TokenEOF:
+			return tokens, err
+
+		case TokenError:
+			tokenErr, ok := token.SpaceOrError.(error)
+			if !ok {
+				panic(errors.NewUnreachableError())
+			}
+			return tokens, tokenErr
+		}
+
+		tokens = append(tokens, token)
+	}
+}
+
 // run executes the stateFn, which will scan the runes in the input
 // and emit tokens.
 //
@@ -2653,3 +2653,175 @@ func TestLimit(t *testing.T) {
 	_, err := Lex([]byte(code), nil)
 	require.ErrorAs(t, err, &TokenLimitReachedError{})
 }
+
+func TestTokenize(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("partial function declaration", func(t *testing.T) {
+
+		t.Parallel()
+
+		tokens, err := Tokenize([]byte("/// Adds\nfun add(a: Int"))
+		require.NoError(t, err)
+
+		utils.AssertEqualWithDiff(t,
+			[]Token{
+				{
+					Type: TokenLineComment,
+					Range: ast.Range{
+						StartPos: ast.Position{Offset: 0, Line: 1, Column: 0},
+						EndPos:   ast.Position{Offset: 7, Line: 1, Column: 7},
+					},
+				},
+				{
+					Type:         TokenSpace,
+					SpaceOrError: Space{ContainsNewline: true},
+					Range: ast.Range{
+						StartPos: ast.Position{Offset: 8, Line: 1, Column: 8},
+						EndPos:   ast.Position{Offset: 8, Line: 1, Column: 8},
+					},
+				},
+				{
+					Type: TokenIdentifier,
+					Range: ast.Range{
+						StartPos: ast.Position{Offset: 9, Line: 2, Column: 0},
+						EndPos:   ast.Position{Offset: 11, Line: 2, Column: 2},
+					},
+				},
+				{
+					Type:         TokenSpace,
+					SpaceOrError: Space{ContainsNewline: false},
+					Range: ast.Range{
+						StartPos: ast.Position{Offset: 12, Line: 2, Column: 3},
+						EndPos:   ast.Position{Offset: 12, Line: 2, Column: 3},
+					},
+				},
+				{
+					Type: TokenIdentifier,
+					Range: ast.Range{
+						StartPos: ast.Position{Offset: 13, Line: 2, Column: 4},
+						EndPos:   ast.Position{Offset: 15, Line: 2, Column: 6},
+					},
+				},
+				{
+					Type: TokenParenOpen,
+					Range: ast.Range{
+						StartPos: ast.Position{Offset: 16, Line: 2, Column: 7},
+						EndPos:   ast.Position{Offset: 16, Line: 2, Column: 7},
+					},
+				},
+				{
+					Type: TokenIdentifier,
+					Range: ast.Range{
+						StartPos: ast.Position{Offset: 17, Line: 2, Column: 8},
+						EndPos:   ast.Position{Offset: 17, Line: 2, Column: 8},
+					},
+				},
+				{
+					Type: TokenColon,
+					Range: ast.Range{
+						StartPos: ast.Position{Offset: 18, Line: 2, Column: 9},
+						EndPos:   ast.Position{Offset: 18, Line: 2, Column: 9},
+					},
+				},
+				{
+					Type:         TokenSpace,
+					SpaceOrError: Space{ContainsNewline: false},
+					Range: ast.Range{
+						StartPos: ast.Position{Offset: 19, Line: 2, Column: 10},
+						EndPos:   ast.Position{Offset: 19, Line: 2, Column: 10},
+					},
+				},
+				{
+					Type: TokenIdentifier,
+					Range: ast.Range{
+						StartPos: ast.Position{Offset: 20, Line: 2, Column: 11},
+						EndPos:   ast.Position{Offset: 22, Line: 2, Column: 13},
+					},
+				},
+			},
+			tokens,
+		)
+	})
+
+	t.Run("partial function declaration with invalid character", func(t *testing.T) {
+
+		t.Parallel()
+
+		tokens, err := Tokenize([]byte("fun add(a: Int 'x"))
+		require.EqualError(t, err, `unrecognized character: U+0027 '''`)
+
+		utils.AssertEqualWithDiff(t,
+			[]Token{
+				{
+					Type: TokenIdentifier,
+					Range: ast.Range{
+						StartPos: ast.Position{Offset: 0, Line: 1, Column: 0},
+						EndPos:   ast.Position{Offset: 2, Line: 1, Column: 2},
+					},
+				},
+				{
+					Type:         TokenSpace,
+					SpaceOrError: Space{ContainsNewline: false},
+					Range: ast.Range{
+						StartPos: ast.Position{Offset: 3, Line: 1, Column: 3},
+						EndPos:   ast.Position{Offset: 3, Line: 1, Column: 3},
+					},
+				},
+				{
+					Type: TokenIdentifier,
+					Range: ast.Range{
+						StartPos: ast.Position{Offset: 4, Line: 1, Column: 4},
+						EndPos:   ast.Position{Offset: 6, Line: 1, Column: 6},
+					},
+				},
+				{
+					Type: TokenParenOpen,
+					Range: ast.Range{
+						StartPos: ast.Position{Offset: 7, Line: 1, Column: 7},
+						EndPos:   ast.Position{Offset: 7, Line: 1, Column: 7},
+					},
+				},
+				{
+					Type: TokenIdentifier,
+					Range: ast.Range{
+						StartPos: ast.Position{Offset: 8, Line: 1, Column: 8},
+						EndPos:   ast.Position{Offset: 8, Line: 1, Column: 8},
+					},
+				},
+				{
+					Type: TokenColon,
+					Range: ast.Range{
+						StartPos: ast.Position{Offset: 9, Line: 1, Column: 9},
+						EndPos:   ast.Position{Offset: 9, Line: 1, Column: 9},
+					},
+				},
+				{
+					Type:         TokenSpace,
+					SpaceOrError: Space{ContainsNewline: false},
+					Range: ast.Range{
+						StartPos: ast.Position{Offset: 10, Line: 1, Column: 10},
+						EndPos:   ast.Position{Offset: 10, Line: 1, Column: 10},
+					},
+				},
+				{
+					Type: TokenIdentifier,
+					Range: ast.Range{
+						StartPos: ast.Position{Offset: 11, Line: 1, Column: 11},
+						EndPos:   ast.Position{Offset: 13, Line: 1, Column: 13},
+					},
+				},
+				{
+					Type:         TokenSpace,
+					SpaceOrError: Space{ContainsNewline: false},
+					Range: ast.Range{
+						StartPos: ast.Position{Offset: 14, Line: 1, Column: 14},
+						EndPos:   ast.Position{Offset: 14, Line: 1, Column: 14},
+					},
+				},
+			},
+			tokens,
+		)
+	})
+}
@@ -86,6 +86,16 @@ type parser struct {
 	typeDepth int
 	// config enables certain features
 	config Config
+	// leadingComments are the comments preceding the declaration which is currently parsed
+	leadingComments []*ast.Comment
+	// skippedComments are the comments which were skipped by the trivia
+	// preceding the token at offset skippedCommentsOffset,
+	// e.g. when looking ahead after an expression.
+	// They become the leading comments of the element starting at that token
+	skippedComments       []*ast.Comment
+	skippedCommentsOffset int
+	// legacyAccessModifiers are the rejected legacy access modifiers, in source order
+	legacyAccessModifiers []ast.LegacyAccessModifier
 }
 
 // Parse creates a lexer to scan the given input string,
@@ -453,6 +463,57 @@ func (p *parser) parseTrivia(options triviaOptions) (containsNewline bool, docSt
 		}
 	}()
 
+	var comments []*ast.Comment
+
+	startOffset := p.current.StartPos.Offset
+
+	if options.parseDocStrings {
+		p.leadingComments = nil
+
+		// If the trivia preceding the current token was already skipped,
+		// the skipped comments are the leading comments
+		if p.skippedComments != nil && p.skippedCommentsPrecede(startOffset) {
+			comments = p.skippedComments
+			p.skippedComments = nil
+		}
+
+		defer func() {
+			p.leadingComments = comments
+		}()
+	} else {
+		// If the trivia preceding the current token was already partially skipped,
+		// e.g. up to a newline, the skipped comments are continued
+		if p.skippedCommentsPrecede(startOffset) {
+			comments = p.skippedComments
+		}
+
+		defer func() {
+			// Only record the comments if trivia was skipped,
+			// so looking ahead again does not reset them
+			if p.current.StartPos.Offset != startOffset {
+				p.skippedComments = comments
+				p.skippedCommentsOffset = p.current.StartPos.Offset
+			}
+		}()
+	}
+
+	addComment := func(source []byte, startPos, endPos ast.Position) {
+		// When skipping trivia, the comments on the same line as the preceding token
+		// are not leading comments of the following token
+		if !options.parseDocStrings && !p.commentStartsLine(startPos, comments) {
+			return
+		}
+
+		comments = append(
+			comments,
+			ast.NewComment(
+				p.memoryGauge,
+				source,
+				ast.NewRange(p.memoryGauge, startPos, endPos),
+			),
+		)
+	}
+
 	var atEnd, insideLineDocString bool
 
 	for !atEnd {
@@ -475,12 +536,23 @@ func (p *parser) parseTrivia(options triviaOptions) (containsNewline bool, docSt
 			p.next()
 
 		case lexer.TokenBlockCommentStart:
-			commentStartOffset := p.current.StartPos.Offset
+			commentStartPos := p.current.StartPos
+			commentStartOffset := commentStartPos.Offset
 			endToken, ok := p.parseBlockComment()
 
-			if ok && options.parseDocStrings {
-				commentEndOffset := endToken.EndPos.Offset
+			if !ok {
+				break
+			}
+
+			commentEndOffset := endToken.EndPos.Offset
+
+			addComment(
+				p.tokens.Input()[commentStartOffset:commentEndOffset+1],
+				commentStartPos,
+				endToken.EndPos,
+			)
 
+			if options.parseDocStrings {
 				contentWithPrefix := p.tokens.Input()[commentStartOffset : commentEndOffset-1]
 
 				insideLineDocString = false
@@ -492,8 +564,11 @@ func (p *parser) parseTrivia(options triviaOptions) (containsNewline bool, docSt
 			}
 
 		case lexer.TokenLineComment:
+			comment := p.currentTokenSource()
+
+			addComment(comment, p.current.StartPos, p.current.EndPos)
+
 			if options.parseDocStrings {
-				comment := p.currentTokenSource()
 				if bytes.HasPrefix(comment, lineCommentDocStringPrefix) {
 					if insideLineDocString {
 						docStringBuilder.WriteByte('\n')
@@ -518,6 +593,96 @@ func (p *parser) parseTrivia(options triviaOptions) (containsNewline bool, docSt
 	return
 }
 
+// skippedCommentsPrecede returns true if the skipped comments precede the token at the given offset,
+// i.e. if at most whitespace was skipped since the comments were skipped
+func (p *parser) skippedCommentsPrecede(offset int) bool {
+	if offset < p.skippedCommentsOffset {
+		return false
+	}
+	skipped := p.tokens.Input()[p.skippedCommentsOffset:offset]
+	return len(bytes.TrimSpace(skipped)) == 0
+}
+
+// commentStartsLine returns true if the comment starting at the given position
+// is only preceded by whitespace on its line, or follows the last of the given comments on the same line
+func (p *parser) commentStartsLine(startPos ast.Position, previousComments []*ast.Comment) bool {
+	if len(previousComments) > 0 &&
+		previousComments[len(previousComments)-1].EndPos.Line == startPos.Line {
+
+		return true
+	}
+
+	input := p.tokens.Input()
+	for i := startPos.Offset - 1; i >= 0; i-- {
+		switch input[i] {
+		case '\n':
+			return true
+		case ' ', '\t', '\r':
+			continue
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// takeLeadingComments returns the comments preceding the declaration which is currently parsed,
+// and resets them, so they are only attached to a single declaration
+func (p *parser) takeLeadingComments() ast.Comments {
+	comments := ast.Comments{
+		Leading: p.leadingComments,
+	}
+	p.leadingComments = nil
+	return comments
+}
+
+// parseTrailingComments parses and returns the comments following the element which was just parsed,
+// up to the end of the line
+func (p *parser) parseTrailingComments() []*ast.Comment {
+	p.parseTrivia(triviaOptions{
+		skipNewlines:    false,
+		parseDocStrings: true,
+	})
+	return p.takeLeadingComments().Leading
+}
+
+// parseTrailingCommentsAfter is like parseTrailingComments,
+// but for elements like expressions, whose parsing already skipped the trivia following them.
+// It re-scans the tokens from the given cursor up to the given end position,
+// and restores the current token stream state afterwards
+func (p *parser) parseTrailingCommentsAfter(cursor int, endPos ast.Position) []*ast.Comment {
+	currentCursor := p.tokens.Cursor()
+	current := p.current
+
+	p.tokens.Revert(cursor)
+	for {
+		p.current = p.tokens.Next()
+		if p.current.Is(lexer.TokenEOF) || p.current.StartPos.Offset > endPos.Offset {
+			break
+		}
+	}
+
+	comments := p.parseTrailingComments()
+
+	p.tokens.Revert(currentCursor)
+	p.current = current
+
+	// The trailing comments might have also been skipped when looking ahead after the element.
+	// They must not become the leading comments of the next element
+	if len(comments) > 0 {
+		lastOffset := comments[len(comments)-1].StartPos.Offset
+		skippedComments := p.skippedComments
+		p.skippedComments = nil
+		for _, comment := range skippedComments {
+			if comment.StartPos.Offset > lastOffset {
+				p.skippedComments = append(p.skippedComments, comment)
+			}
+		}
+	}
+
+	return comments
+}
+
 func (p *parser) mustIdentifier() (ast.Identifier, error) {
 	identifier, err := p.mustOne(lexer.TokenIdentifier)
 	if err != nil {
@@ -704,11 +869,15 @@ func ParseProgramFromTokenStream(
 	program *ast.Program,
 	err error,
 ) {
+	var legacyAccessModifiers []ast.LegacyAccessModifier
+
 	declarations, errs := ParseTokenStream(
 		memoryGauge,
 		input,
 		func(p *parser) ([]ast.Declaration, error) {
-			return parseDeclarations(p, lexer.TokenEOF)
+			declarations, err := parseDeclarations(p, lexer.TokenEOF)
+			legacyAccessModifiers = p.legacyAccessModifiers
+			return declarations, err
 		},
 		config,
 	)
@@ -719,7 +888,11 @@ func ParseProgramFromTokenStream(
 		}
 	}
 
-	program = ast.NewProgram(memoryGauge, declarations)
+	program = ast.NewProgramWithLegacyAccessModifiers(
+		memoryGauge,
+		declarations,
+		legacyAccessModifiers,
+	)
 
 	return
 }
@@ -1007,3 +1007,23 @@ func TestParseWhitespaceAtEnd(t *testing.T) {
 
 	assert.Empty(t, errs)
 }
+
+func TestParseCommentsMemoryMetering(t *testing.T) {
+
+	t.Parallel()
+
+	gauge := makeLimitingMemoryGauge()
+
+	_, err := ParseProgram(
+		gauge,
+		[]byte(`
+          // first
+          /// second
+          let x = 1
+        `),
+		Config{},
+	)
+	require.NoError(t, err)
+
+	assert.Equal(t, uint64(2), gauge.totals[common.MemoryKindComment])
+}
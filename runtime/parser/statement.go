@@ -27,7 +27,10 @@ import (
 func parseStatements(p *parser, isEndToken func(token lexer.Token) bool) (statements []ast.Statement, err error) {
 	sawSemicolon := false
 	for {
-		p.skipSpaceAndComments()
+		p.parseTrivia(triviaOptions{
+			skipNewlines:    true,
+			parseDocStrings: true,
+		})
 		switch p.current.Type {
 		case lexer.TokenSemicolon:
 			sawSemicolon = true
@@ -224,6 +227,7 @@ func parseFunctionDeclarationOrFunctionExpressionStatement(
 			functionBlock,
 			startPos,
 			"",
+			ast.Comments{},
 		), nil
 	} else {
 		parameterList, returnTypeAnnotation, functionBlock, err :=
@@ -247,14 +251,22 @@ func parseFunctionDeclarationOrFunctionExpressionStatement(
 }
 
 func parseReturnStatement(p *parser) (*ast.ReturnStatement, error) {
+	comments := p.takeLeadingComments()
+
 	tokenRange := p.current.Range
 	endPosition := tokenRange.EndPos
 	p.next()
 
 	sawNewLine, _ := p.parseTrivia(triviaOptions{
-		skipNewlines: false,
+		skipNewlines:    false,
+		parseDocStrings: true,
 	})
 
+	// The comments following the keyword are trailing comments,
+	// followed by the comments following the expression, if any
+
+	comments.Trailing = p.takeLeadingComments().Leading
+
 	var expression ast.Expression
 	var err error
 	switch p.current.Type {
@@ -262,12 +274,19 @@ func parseReturnStatement(p *parser) (*ast.ReturnStatement, error) {
 		break
 	default:
 		if !sawNewLine {
+			expressionCursor := p.tokens.Cursor()
+
 			expression, err = parseExpression(p, lowestBindingPower)
 			if err != nil {
 				return nil, err
 			}
 
 			endPosition = expression.EndPosition(p.memoryGauge)
+
+			comments.Trailing = append(
+				comments.Trailing,
+				p.parseTrailingCommentsAfter(expressionCursor, endPosition)...,
+			)
 		}
 	}
 
@@ -279,6 +298,7 @@ func parseReturnStatement(p *parser) (*ast.ReturnStatement, error) {
 			tokenRange.StartPos,
 			endPosition,
 		),
+		comments,
 	), nil
 }
 
@@ -298,6 +318,8 @@ func parseContinueStatement(p *parser) *ast.ContinueStatement {
 
 func parseIfStatement(p *parser) (*ast.IfStatement, error) {
 
+	comments := p.takeLeadingComments()
+
 	var ifStatements []*ast.IfStatement
 
 	for {
@@ -336,6 +358,10 @@ func parseIfStatement(p *parser) (*ast.IfStatement, error) {
 
 		parseNested := false
 
+		// save current stream state before looking ahead for the `else` keyword
+		cursor := p.tokens.Cursor()
+		current := p.current
+
 		p.skipSpaceAndComments()
 		if p.isToken(p.current, lexer.TokenIdentifier, KeywordElse) {
 			p.nextSemanticToken()
@@ -347,6 +373,11 @@ func parseIfStatement(p *parser) (*ast.IfStatement, error) {
 					return nil, err
 				}
 			}
+		} else {
+			// no `else`, revert back to previous lexer state,
+			// so the following comments are not skipped
+			p.tokens.Revert(cursor)
+			p.current = current
 		}
 
 		var test ast.IfStatementTest
@@ -365,6 +396,7 @@ func parseIfStatement(p *parser) (*ast.IfStatement, error) {
 			thenBlock,
 			elseBlock,
 			startPos,
+			ast.Comments{},
 		)
 
 		if variableDeclaration != nil {
@@ -392,11 +424,16 @@ func parseIfStatement(p *parser) (*ast.IfStatement, error) {
 		result = outer
 	}
 
+	comments.Trailing = p.parseTrailingComments()
+	result.Comments = comments
+
 	return result, nil
 }
 
 func parseWhileStatement(p *parser) (*ast.WhileStatement, error) {
 
+	comments := p.takeLeadingComments()
+
 	startPos := p.current.StartPos
 	p.next()
 
@@ -410,11 +447,21 @@ func parseWhileStatement(p *parser) (*ast.WhileStatement, error) {
 		return nil, err
 	}
 
-	return ast.NewWhileStatement(p.memoryGauge, expression, block, startPos), nil
+	comments.Trailing = p.parseTrailingComments()
+
+	return ast.NewWhileStatement(
+		p.memoryGauge,
+		expression,
+		block,
+		startPos,
+		comments,
+	), nil
 }
 
 func parseForStatement(p *parser) (*ast.ForStatement, error) {
 
+	comments := p.takeLeadingComments()
+
 	startPos := p.current.StartPos
 	p.nextSemanticToken()
 
@@ -469,6 +516,8 @@ func parseForStatement(p *parser) (*ast.ForStatement, error) {
 		return nil, err
 	}
 
+	comments.Trailing = p.parseTrailingComments()
+
 	return ast.NewForStatement(
 		p.memoryGauge,
 		identifier,
@@ -476,6 +525,7 @@ func parseForStatement(p *parser) (*ast.ForStatement, error) {
 		block,
 		expression,
 		startPos,
+		comments,
 	), nil
 }
 
@@ -581,7 +631,11 @@ func parseConditions(p *parser) (conditions ast.Conditions, err error) {
 
 	var done bool
 	for !done {
-		p.skipSpaceAndComments()
+		// Collect the comments preceding the condition
+		p.parseTrivia(triviaOptions{
+			skipNewlines:    true,
+			parseDocStrings: true,
+		})
 		switch p.current.Type {
 		case lexer.TokenSemicolon:
 			p.next()
@@ -617,6 +671,8 @@ func parseConditions(p *parser) (conditions ast.Conditions, err error) {
 //		| expression (':' expression )?
 func parseCondition(p *parser) (ast.Condition, error) {
 
+	comments := p.takeLeadingComments()
+
 	if p.isToken(p.current, lexer.TokenIdentifier, KeywordEmit) {
 		emitStatement, err := parseEmitStatement(p)
 		if err != nil {
@@ -645,8 +701,9 @@ func parseCondition(p *parser) (ast.Condition, error) {
 	}
 
 	return &ast.TestCondition{
-		Test:    test,
-		Message: message,
+		Test:     test,
+		Message:  message,
+		Comments: comments,
 	}, nil
 }
 
@@ -168,6 +168,69 @@ func TestParseReturnStatement(t *testing.T) {
 			result,
 		)
 	})
+
+	t.Run("trailing comment", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := testParseStatements(`
+          // leave early
+          return x // the result
+        `)
+		require.Empty(t, errs)
+
+		require.Len(t, result, 1)
+
+		statement, ok := result[0].(*ast.ReturnStatement)
+		require.True(t, ok)
+
+		require.Len(t, statement.Comments.Leading, 1)
+		assert.Equal(t, "leave early", string(statement.Comments.Leading[0].Text()))
+
+		require.Len(t, statement.Comments.Trailing, 1)
+		assert.Equal(t, "the result", string(statement.Comments.Trailing[0].Text()))
+	})
+
+	t.Run("comment after keyword", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := testParseStatements(`
+          return /* the result */ x // done
+        `)
+		require.Empty(t, errs)
+
+		require.Len(t, result, 1)
+
+		statement, ok := result[0].(*ast.ReturnStatement)
+		require.True(t, ok)
+
+		assert.Empty(t, statement.Comments.Leading)
+
+		require.Len(t, statement.Comments.Trailing, 2)
+		assert.Equal(t, "the result", string(statement.Comments.Trailing[0].Text()))
+		assert.Equal(t, "done", string(statement.Comments.Trailing[1].Text()))
+	})
+
+	t.Run("leading comment after variable declaration", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := testParseStatements(`
+          let y = 2 // two
+          // before return
+          return 1
+        `)
+		require.Empty(t, errs)
+
+		require.Len(t, result, 2)
+
+		statement, ok := result[1].(*ast.ReturnStatement)
+		require.True(t, ok)
+
+		require.Len(t, statement.Comments.Leading, 1)
+		assert.Equal(t, "before return", string(statement.Comments.Leading[0].Text()))
+	})
 }
 
 func TestParseIfStatement(t *testing.T) {
@@ -574,6 +637,38 @@ func TestParseIfStatement(t *testing.T) {
 		)
 	})
 
+	t.Run("comments", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := testParseStatements(`
+          /* check the flag */
+          if true {
+          } else {
+          } // done
+          while false {} // never
+        `)
+		require.Empty(t, errs)
+
+		require.Len(t, result, 2)
+
+		ifStatement, ok := result[0].(*ast.IfStatement)
+		require.True(t, ok)
+
+		require.Len(t, ifStatement.Comments.Leading, 1)
+		assert.Equal(t, "check the flag", string(ifStatement.Comments.Leading[0].Text()))
+
+		require.Len(t, ifStatement.Comments.Trailing, 1)
+		assert.Equal(t, "done", string(ifStatement.Comments.Trailing[0].Text()))
+
+		whileStatement, ok := result[1].(*ast.WhileStatement)
+		require.True(t, ok)
+
+		assert.Empty(t, whileStatement.Comments.Leading)
+
+		require.Len(t, whileStatement.Comments.Trailing, 1)
+		assert.Equal(t, "never", string(whileStatement.Comments.Trailing[0].Text()))
+	})
 }
 
 func TestParseWhileStatement(t *testing.T) {
@@ -829,6 +924,83 @@ func TestParseForStatement(t *testing.T) {
 	})
 }
 
+func TestParseForStatementComments(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("after expression statement", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := testParseStatements(`
+          foo() // call
+          // before for
+          /* loop */
+          for x in xs {} // done
+        `)
+		require.Empty(t, errs)
+
+		require.Len(t, result, 2)
+
+		statement, ok := result[1].(*ast.ForStatement)
+		require.True(t, ok)
+
+		require.Len(t, statement.Comments.Leading, 2)
+		assert.Equal(t, "before for", string(statement.Comments.Leading[0].Text()))
+		assert.Equal(t, "loop", string(statement.Comments.Leading[1].Text()))
+
+		require.Len(t, statement.Comments.Trailing, 1)
+		assert.Equal(t, "done", string(statement.Comments.Trailing[0].Text()))
+	})
+
+	t.Run("after return statement", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := testParseStatements(`
+          return x // the result
+          // before for
+          for x in xs {}
+        `)
+		require.Empty(t, errs)
+
+		require.Len(t, result, 2)
+
+		statement, ok := result[1].(*ast.ForStatement)
+		require.True(t, ok)
+
+		require.Len(t, statement.Comments.Leading, 1)
+		assert.Equal(t, "before for", string(statement.Comments.Leading[0].Text()))
+	})
+
+	t.Run("first statement of function", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := testParseDeclarations(`
+          fun test() {
+              // before for
+              for x in xs {}
+          }
+        `)
+		require.Empty(t, errs)
+
+		require.Len(t, result, 1)
+
+		function, ok := result[0].(*ast.FunctionDeclaration)
+		require.True(t, ok)
+
+		statements := function.FunctionBlock.Block.Statements
+		require.Len(t, statements, 1)
+
+		statement, ok := statements[0].(*ast.ForStatement)
+		require.True(t, ok)
+
+		require.Len(t, statement.Comments.Leading, 1)
+		assert.Equal(t, "before for", string(statement.Comments.Leading[0].Text()))
+	})
+}
+
 func TestParseForStatementIndexBinding(t *testing.T) {
 
 	t.Parallel()
@@ -289,6 +289,7 @@ func parseTransactionExecute(p *parser) (*ast.SpecialFunctionDeclaration, error)
 			),
 			identifier.Pos,
 			"",
+			ast.Comments{},
 		),
 	), nil
 }
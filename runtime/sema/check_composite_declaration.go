@@ -261,6 +261,8 @@ func (checker *Checker) visitCompositeLikeDeclaration(declaration ast.CompositeL
 
 	defaultFunctions := availableDefaultFunctions(compositeType)
 
+	conflictingFunctions := checker.checkConformanceReturnTypeConflicts(declaration, compositeType)
+
 	for _, conformance := range compositeType.EffectiveInterfaceConformances() {
 		checker.checkCompositeLikeConformance(
 			declaration,
@@ -268,7 +270,8 @@ func (checker *Checker) visitCompositeLikeDeclaration(declaration ast.CompositeL
 			conformance.InterfaceType,
 			conformance.ConformanceChainRoot,
 			compositeConformanceCheckOptions{
-				checkMissingMembers: true,
+				checkMissingMembers:  true,
+				conflictingFunctions: conflictingFunctions,
 			},
 			inheritedMembers,
 			defaultFunctions,
@@ -310,6 +313,148 @@ func (checker *Checker) visitCompositeLikeDeclaration(declaration ast.CompositeL
 	}
 }
 
+type conformanceFunction struct {
+	conformance Conformance
+	member      *Member
+}
+
+// checkConformanceReturnTypeConflicts checks that functions with the same name,
+// which are declared in multiple interfaces the composite conforms to,
+// have return types which have a common subtype.
+// If there is no common subtype, no implementation can satisfy all interfaces,
+// and a conformance error naming both interfaces is reported.
+//
+// It returns the names of the conflicting functions,
+// so the individual conformance checks do not report redundant mismatches
+func (checker *Checker) checkConformanceReturnTypeConflicts(
+	declaration ast.CompositeLikeDeclaration,
+	compositeType *CompositeType,
+) map[string]struct{} {
+
+	var conflictingFunctions map[string]struct{}
+
+	functionsByName := map[string][]conformanceFunction{}
+
+	for _, conformance := range compositeType.EffectiveInterfaceConformances() {
+		interfaceType := conformance.InterfaceType
+
+		interfaceType.Members.Foreach(func(name string, interfaceMember *Member) {
+			if interfaceMember.DeclarationKind != common.DeclarationKindFunction ||
+				interfaceMember.Predeclared {
+
+				return
+			}
+
+			if _, ok := conflictingFunctions[name]; ok {
+				return
+			}
+
+			functionType, ok := interfaceMember.TypeAnnotation.Type.(*FunctionType)
+			if !ok {
+				return
+			}
+			returnType := functionType.ReturnTypeAnnotation.Type
+
+			for _, existing := range functionsByName[name] {
+				existingReturnType := existing.member.TypeAnnotation.Type.(*FunctionType).ReturnTypeAnnotation.Type
+
+				if haveCommonSubtype(existingReturnType, returnType) {
+					continue
+				}
+
+				if conflictingFunctions == nil {
+					conflictingFunctions = map[string]struct{}{}
+				}
+				conflictingFunctions[name] = struct{}{}
+
+				checker.report(
+					&ConformanceError{
+						CompositeDeclaration: declaration,
+						CompositeType:        compositeType,
+						InterfaceType:        existing.conformance.ConformanceChainRoot,
+						NestedInterfaceType:  existing.conformance.InterfaceType,
+						ReturnTypeConflict: &ReturnTypeConflict{
+							FunctionName:       name,
+							InterfaceType:      interfaceType,
+							ReturnType:         existingReturnType,
+							ConflictReturnType: returnType,
+						},
+						Pos: declaration.DeclarationIdentifier().Pos,
+					},
+				)
+
+				return
+			}
+
+			functionsByName[name] = append(
+				functionsByName[name],
+				conformanceFunction{
+					conformance: conformance,
+					member:      interfaceMember,
+				},
+			)
+		})
+	}
+
+	return conflictingFunctions
+}
+
+// haveCommonSubtype returns true if the given types might have a common subtype
+// other than `Never`, i.e. if there might be a type which is a subtype of both types.
+//
+// Only types which are provably disjoint are rejected:
+// The only subtypes of a leaf type, e.g. a composite type, are the type itself and `Never`,
+// so a leaf type has no common subtype with a type it is not a subtype of.
+// All other combinations are deferred to the subtype checks of the individual conformances
+func haveCommonSubtype(a, b Type) bool {
+	if a == nil || b == nil ||
+		a.IsInvalidType() || b.IsInvalidType() {
+
+		return true
+	}
+
+	if IsSubType(a, b) || IsSubType(b, a) {
+		return true
+	}
+
+	// Two optionals always have the common subtype `Never?`.
+	// The greatest lower bound of an optional and a non-optional
+	// is the greatest lower bound of the optional's inner type and the non-optional
+
+	aOptional, aIsOptional := a.(*OptionalType)
+	bOptional, bIsOptional := b.(*OptionalType)
+
+	switch {
+	case aIsOptional && bIsOptional:
+		return true
+	case aIsOptional:
+		return haveCommonSubtype(aOptional.Type, b)
+	case bIsOptional:
+		return haveCommonSubtype(a, bOptional.Type)
+	}
+
+	return !isLeafType(a) && !isLeafType(b)
+}
+
+// isLeafType returns true if the only subtypes of the given type
+// are the type itself and `Never`
+func isLeafType(ty Type) bool {
+	switch ty := ty.(type) {
+	case *CompositeType:
+		return true
+	case IntegerRangedType:
+		// e.g. `Int` is a leaf type, but `Integer` is not
+		return !ty.IsSuperType()
+	}
+
+	switch ty {
+	case BoolType, StringType, CharacterType:
+		return true
+	}
+
+	return false
+}
+
 func availableDefaultFunctions(compositeType *CompositeType) map[string]struct{} {
 	defaultFunctions := make(map[string]struct{})
 
@@ -1066,6 +1211,7 @@ func (checker *Checker) declareEnumConstructor(
 				DeclarationKind: common.DeclarationKindField,
 				VariableKind:    ast.VariableKindConstant,
 				DocString:       enumCase.DocString,
+				DocComments:     enumCase.Comments.LeadingDocComments(),
 			})
 
 		if checker.PositionInfo != nil && constructorOrigins != nil {
@@ -1284,7 +1430,10 @@ func (checker *Checker) enumRawType(declaration *ast.CompositeDeclaration) Type
 }
 
 type compositeConformanceCheckOptions struct {
-	checkMissingMembers bool
+	// conflictingFunctions are the functions which have already been reported
+	// to have conflicting return types across conformances
+	conflictingFunctions map[string]struct{}
+	checkMissingMembers  bool
 }
 
 // checkCompositeLikeConformance checks if the given composite declaration with the given composite type
@@ -1356,12 +1505,21 @@ func (checker *Checker) checkCompositeLikeConformance(
 
 			// If the composite member exists, check if it satisfies the mem
 
-			if !checker.memberSatisfied(compositeType, compositeMember, interfaceMember) {
+			// Mismatches of functions with conflicting return types were already reported
+
+			_, isConflicting := options.conflictingFunctions[name]
+
+			if !isConflicting &&
+				!checker.memberSatisfied(compositeType, compositeMember, interfaceMember) {
+
 				memberMismatches = append(
 					memberMismatches,
 					MemberMismatch{
-						CompositeMember: compositeMember,
-						InterfaceMember: interfaceMember,
+						CompositeMember:      compositeMember,
+						InterfaceMember:      interfaceMember,
+						ParameterMismatch:    functionParameterMismatch(compositeMember, interfaceMember),
+						VariableKindMismatch: fieldVariableKindMismatch(compositeMember, interfaceMember),
+						AccessMismatch:       checker.memberAccessMismatch(compositeMember, interfaceMember),
 					},
 				)
 			}
@@ -1399,6 +1557,18 @@ func (checker *Checker) checkCompositeLikeConformance(
 
 	})
 
+	if len(missingMembers) > 0 && checker.Config.AllowIncompleteConformance {
+		checker.reportWarning(
+			&IncompleteConformanceWarning{
+				CompositeType:  compositeType,
+				InterfaceType:  conformanceChainRoot,
+				MissingMembers: missingMembers,
+				Pos:            compositeDeclaration.DeclarationIdentifier().Pos,
+			},
+		)
+		missingMembers = nil
+	}
+
 	if len(missingMembers) > 0 ||
 		len(memberMismatches) > 0 ||
 		len(missingNestedCompositeTypes) > 0 ||
@@ -1607,9 +1777,125 @@ func (checker *Checker) memberSatisfied(
 	effectiveInterfaceMemberAccess := checker.effectiveInterfaceMemberAccess(interfaceMember.Access)
 	effectiveCompositeMemberAccess := checker.EffectiveCompositeMemberAccess(compositeMember.Access)
 
+	// Composite members may be more permissive than the interface members they implement.
+	// Members of interfaces (e.g. inherited members) must have the same access
+
+	if _, ok := compositeKindedType.(*CompositeType); ok {
+		return isAccessWidening(effectiveCompositeMemberAccess, effectiveInterfaceMemberAccess)
+	}
+
 	return effectiveCompositeMemberAccess.Equal(effectiveInterfaceMemberAccess)
 }
 
+// isAccessWidening returns true if the given composite member access
+// is equal to the given interface member access, or if both are primitive accesses,
+// and the composite member access is more permissive, e.g. `access(all)` for `access(contract)`.
+//
+// Entitlement-based accesses must be equal
+func isAccessWidening(compositeMemberAccess, interfaceMemberAccess Access) bool {
+	if compositeMemberAccess.Equal(interfaceMemberAccess) {
+		return true
+	}
+
+	compositeMemberPrimitiveAccess, ok := compositeMemberAccess.(PrimitiveAccess)
+	if !ok {
+		return false
+	}
+
+	interfaceMemberPrimitiveAccess, ok := interfaceMemberAccess.(PrimitiveAccess)
+	if !ok {
+		return false
+	}
+
+	// Primitive accesses are ordered from least to most permissive
+	return compositeMemberPrimitiveAccess >= interfaceMemberPrimitiveAccess
+}
+
+// memberAccessMismatch returns the effective accesses of the composite member
+// and the interface member, if the composite member access is more restrictive
+// than the interface member access, or nil otherwise
+func (checker *Checker) memberAccessMismatch(compositeMember, interfaceMember *Member) *AccessMismatch {
+	effectiveInterfaceMemberAccess := checker.effectiveInterfaceMemberAccess(interfaceMember.Access)
+	effectiveCompositeMemberAccess := checker.EffectiveCompositeMemberAccess(compositeMember.Access)
+
+	if isAccessWidening(effectiveCompositeMemberAccess, effectiveInterfaceMemberAccess) {
+		return nil
+	}
+
+	return &AccessMismatch{
+		ExpectedAccess: effectiveInterfaceMemberAccess,
+		ActualAccess:   effectiveCompositeMemberAccess,
+	}
+}
+
+// functionParameterMismatch returns the first parameter of the composite member function
+// which has a type different from the corresponding parameter of the interface member function,
+// or nil if the members are not functions, or all parameter types are equal
+func functionParameterMismatch(compositeMember, interfaceMember *Member) *ParameterMismatch {
+	compositeMemberFunctionType, ok := compositeMember.TypeAnnotation.Type.(*FunctionType)
+	if !ok {
+		return nil
+	}
+
+	interfaceMemberFunctionType, ok := interfaceMember.TypeAnnotation.Type.(*FunctionType)
+	if !ok {
+		return nil
+	}
+
+	interfaceParameters := interfaceMemberFunctionType.Parameters
+
+	for i, compositeParameter := range compositeMemberFunctionType.Parameters {
+		if i >= len(interfaceParameters) {
+			break
+		}
+
+		actualType := compositeParameter.TypeAnnotation.Type
+		expectedType := interfaceParameters[i].TypeAnnotation.Type
+
+		if actualType.IsInvalidType() || expectedType.IsInvalidType() {
+			continue
+		}
+
+		if !actualType.Equal(expectedType) {
+			return &ParameterMismatch{
+				Index:        i,
+				ExpectedType: expectedType,
+				ActualType:   actualType,
+			}
+		}
+	}
+
+	return nil
+}
+
+// fieldVariableKindMismatch returns the variable kinds of the composite member field
+// and the interface member field, if they differ, i.e. a constant field is implemented as a variable field,
+// or vice versa. It returns nil if the members are not fields, or the variable kinds are equal
+func fieldVariableKindMismatch(compositeMember, interfaceMember *Member) *VariableKindMismatch {
+	if compositeMember.DeclarationKind != common.DeclarationKindField ||
+		interfaceMember.DeclarationKind != common.DeclarationKindField {
+
+		return nil
+	}
+
+	expectedKind := interfaceMember.VariableKind
+	actualKind := compositeMember.VariableKind
+
+	// A missing variable kind of the composite member field was already reported
+
+	if expectedKind == ast.VariableKindNotSpecified ||
+		actualKind == ast.VariableKindNotSpecified ||
+		actualKind == expectedKind {
+
+		return nil
+	}
+
+	return &VariableKindMismatch{
+		ExpectedKind: expectedKind,
+		ActualKind:   actualKind,
+	}
+}
+
 func CompositeLikeConstructorType(
 	elaboration *Elaboration,
 	compositeDeclaration ast.CompositeLikeDeclaration,
@@ -1767,6 +2053,7 @@ func (checker *Checker) defaultMembersAndOrigins(
 				TypeAnnotation:  fieldTypeAnnotation,
 				VariableKind:    field.VariableKind,
 				DocString:       field.DocString,
+				DocComments:     field.Comments.LeadingDocComments(),
 			})
 
 		if checker.PositionInfo != nil && origins != nil {
@@ -1846,6 +2133,7 @@ func (checker *Checker) defaultMembersAndOrigins(
 				VariableKind:      ast.VariableKindConstant,
 				ArgumentLabels:    argumentLabels,
 				DocString:         function.DocString,
+				DocComments:       function.Comments.LeadingDocComments(),
 				HasImplementation: hasImplementation,
 				HasConditions:     hasConditions,
 			})
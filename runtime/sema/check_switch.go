@@ -22,6 +22,11 @@ import (
 	"github.com/onflow/cadence/runtime/ast"
 )
 
+type switchCaseLiteralKey struct {
+	kind  ast.ElementType
+	value string
+}
+
 func (checker *Checker) VisitSwitchStatement(statement *ast.SwitchStatement) (_ struct{}) {
 
 	testType := checker.VisitExpression(statement.Expression, statement, nil)
@@ -39,6 +44,8 @@ func (checker *Checker) VisitSwitchStatement(statement *ast.SwitchStatement) (_
 		)
 	}
 
+	checker.checkSwitchCasesDuplicateLiterals(statement.Cases)
+
 	// Check all cases
 
 	checker.functionActivations.Current().WithSwitch(func() {
@@ -188,3 +195,48 @@ func (checker *Checker) checkSwitchCaseStatements(switchCase *ast.SwitchCase) {
 	)
 	checker.checkBlock(block)
 }
+
+// checkSwitchCasesDuplicateLiterals reports a warning for each case
+// which matches the same string or integer literal as an earlier case,
+// as the later case is never taken
+func (checker *Checker) checkSwitchCasesDuplicateLiterals(cases []*ast.SwitchCase) {
+
+	var previousCases map[switchCaseLiteralKey]ast.Expression
+
+	for _, switchCase := range cases {
+
+		var key switchCaseLiteralKey
+
+		switch caseExpression := switchCase.Expression.(type) {
+		case *ast.StringExpression:
+			key = switchCaseLiteralKey{
+				kind:  caseExpression.ElementType(),
+				value: caseExpression.Value,
+			}
+
+		case *ast.IntegerExpression:
+			key = switchCaseLiteralKey{
+				kind:  caseExpression.ElementType(),
+				value: caseExpression.Value.String(),
+			}
+
+		default:
+			continue
+		}
+
+		if previousCase, ok := previousCases[key]; ok {
+			checker.reportWarning(
+				&DuplicateSwitchCaseWarning{
+					PreviousRange: ast.NewRangeFromPositioned(checker.memoryGauge, previousCase),
+					Range:         ast.NewRangeFromPositioned(checker.memoryGauge, switchCase.Expression),
+				},
+			)
+			continue
+		}
+
+		if previousCases == nil {
+			previousCases = map[switchCaseLiteralKey]ast.Expression{}
+		}
+		previousCases[key] = switchCase.Expression
+	}
+}
@@ -115,6 +115,7 @@ type Checker struct {
 	// initialized lazily. use beforeExtractor()
 	_beforeExtractor                   *BeforeExtractor
 	errors                             []error
+	warnings                           []error
 	functionActivations                *FunctionActivations
 	purityCheckScopes                  []PurityCheckScope
 	entitlementMappingInScope          *EntitlementMapType
@@ -281,6 +282,7 @@ func (checker *Checker) Check() error {
 	if !checker.IsChecked() {
 		checker.Elaboration.setIsChecking(true)
 		checker.errors = nil
+		checker.warnings = nil
 		check := func() {
 			if checker.Config.ErrorShortCircuitingEnabled {
 				defer func() {
@@ -344,6 +346,40 @@ func (checker *Checker) report(err error) {
 	}
 }
 
+func (checker *Checker) reportWarning(warning Warning) {
+	checker.warnings = append(checker.warnings, warning)
+}
+
+// checkDeprecatedIdentifier reports a warning if the given identifier
+// refers to a predeclared variable which is configured to be deprecated.
+// Variables declared in the program itself (e.g. ones shadowing a deprecated declaration)
+// have a position and are not reported
+func (checker *Checker) checkDeprecatedIdentifier(identifier ast.Identifier, variable *Variable) {
+	deprecatedIdentifiers := checker.Config.DeprecatedIdentifiers
+	if len(deprecatedIdentifiers) == 0 || variable.Pos != nil {
+		return
+	}
+
+	replacement, ok := deprecatedIdentifiers[identifier.Identifier]
+	if !ok {
+		return
+	}
+
+	checker.reportWarning(
+		&DeprecatedIdentifierWarning{
+			Identifier:  identifier.Identifier,
+			Replacement: replacement,
+			Range:       ast.NewRangeFromPositioned(checker.memoryGauge, identifier),
+		},
+	)
+}
+
+// Warnings returns the warnings reported while checking the program.
+// Warnings do not cause checking to fail
+func (checker *Checker) Warnings() []error {
+	return checker.warnings
+}
+
 func (checker *Checker) CheckProgram(program *ast.Program) {
 
 	for _, declaration := range program.ImportDeclarations() {
@@ -1227,6 +1263,8 @@ func (checker *Checker) convertNominalType(t *ast.NominalType) Type {
 		return InvalidType
 	}
 
+	checker.checkDeprecatedIdentifier(t.Identifier, variable)
+
 	ty := variable.Type
 
 	var resolvedIdentifiers []ast.Identifier
@@ -55,4 +55,13 @@ type Config struct {
 	AllowStaticDeclarations bool
 	// AttachmentsEnabled determines if attachments are enabled
 	AttachmentsEnabled bool
+	// AllowIncompleteConformance determines if missing members of interface conformances
+	// are reported as warnings instead of errors.
+	// Mismatching members are still reported as errors.
+	// Programs with incomplete conformances should only be checked, not executed
+	AllowIncompleteConformance bool
+	// DeprecatedIdentifiers are the identifiers of predeclared values and types which are deprecated,
+	// mapped to a hint about the replacement (may be empty).
+	// Uses of such identifiers are reported as warnings
+	DeprecatedIdentifiers map[string]string
 }
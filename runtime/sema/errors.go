@@ -148,6 +148,16 @@ type SemanticError interface {
 	isSemanticError()
 }
 
+// Warning
+
+// Warning is a diagnostic which is reported by the checker,
+// but which does not cause checking to fail
+type Warning interface {
+	error
+	ast.HasPosition
+	isWarning()
+}
+
 // RedeclarationError
 
 type RedeclarationError struct {
@@ -1447,6 +1457,29 @@ func (e *InvalidEnumConformancesError) Error() string {
 type MemberMismatch struct {
 	CompositeMember *Member
 	InterfaceMember *Member
+	// ParameterMismatch is the first mismatched parameter, if any
+	ParameterMismatch *ParameterMismatch
+	// VariableKindMismatch is the mismatched variable kind of a field, if any
+	VariableKindMismatch *VariableKindMismatch
+	// AccessMismatch is the mismatched access, if the composite member is more restrictive
+	AccessMismatch *AccessMismatch
+}
+
+type ParameterMismatch struct {
+	ExpectedType Type
+	ActualType   Type
+	// Index is the zero-based index of the parameter
+	Index int
+}
+
+type VariableKindMismatch struct {
+	ExpectedKind ast.VariableKind
+	ActualKind   ast.VariableKind
+}
+
+type AccessMismatch struct {
+	ExpectedAccess Access
+	ActualAccess   Access
 }
 
 type InitializerMismatch struct {
@@ -1455,12 +1488,26 @@ type InitializerMismatch struct {
 	CompositeParameters []Parameter
 	InterfaceParameters []Parameter
 }
+
+// ReturnTypeConflict is a function which is declared in two interfaces,
+// with return types that have no common subtype
+type ReturnTypeConflict struct {
+	// InterfaceType is the other interface which declares the function
+	InterfaceType *InterfaceType
+	// ReturnType is the return type of the function in the conformance error's interface
+	ReturnType Type
+	// ConflictReturnType is the return type of the function in the other interface
+	ConflictReturnType Type
+	FunctionName       string
+}
+
 type ConformanceError struct {
 	CompositeDeclaration        ast.CompositeLikeDeclaration
 	CompositeType               *CompositeType
 	InterfaceType               *InterfaceType
 	NestedInterfaceType         *InterfaceType
 	InitializerMismatch         *InitializerMismatch
+	ReturnTypeConflict          *ReturnTypeConflict
 	MissingMembers              []*Member
 	MemberMismatches            []MemberMismatch
 	MissingNestedCompositeTypes []*CompositeType
@@ -1476,6 +1523,18 @@ func (*ConformanceError) isSemanticError() {}
 func (*ConformanceError) IsUserError() {}
 
 func (e *ConformanceError) Error() string {
+	if e.ReturnTypeConflict != nil {
+		return fmt.Sprintf(
+			"%s `%s` cannot conform to both %s interface `%s` and %s interface `%s`",
+			e.CompositeType.Kind.Name(),
+			e.CompositeType.QualifiedString(),
+			e.NestedInterfaceType.CompositeKind.Name(),
+			e.NestedInterfaceType.QualifiedString(),
+			e.ReturnTypeConflict.InterfaceType.CompositeKind.Name(),
+			e.ReturnTypeConflict.InterfaceType.QualifiedString(),
+		)
+	}
+
 	return fmt.Sprintf(
 		"%s `%s` does not conform to %s interface `%s`",
 		e.CompositeType.Kind.Name(),
@@ -1486,23 +1545,33 @@ func (e *ConformanceError) Error() string {
 }
 
 func (e *ConformanceError) SecondaryError() string {
-	var builder strings.Builder
+	if e.ReturnTypeConflict != nil {
+		returnType, conflictReturnType := ErrorMessageExpectedActualTypes(
+			e.ReturnTypeConflict.ReturnType,
+			e.ReturnTypeConflict.ConflictReturnType,
+		)
+		return fmt.Sprintf(
+			"function `%s` has incompatible return types `%s` and `%s`, which have no common subtype",
+			e.ReturnTypeConflict.FunctionName,
+			returnType,
+			conflictReturnType,
+		)
+	}
+
+	subject := fmt.Sprintf("`%s`", e.CompositeType.QualifiedString())
+
+	// All missing members, missing nested types, and the initializer mismatch are reported together
+	var parts []string
+
 	if len(e.MissingMembers) > 0 {
-		builder.WriteString(fmt.Sprintf("`%s` is missing definitions for members: ", e.CompositeType.QualifiedString()))
-		for i, member := range e.MissingMembers {
-			builder.WriteString(fmt.Sprintf("`%s`", member.Identifier.Identifier))
-			if i != len(e.MissingMembers)-1 {
-				builder.WriteString(", ")
-			}
-		}
-		if len(e.MissingNestedCompositeTypes) > 0 {
-			builder.WriteString(". ")
-		}
+		parts = append(parts, missingMembersMessage(e.CompositeType, e.MissingMembers))
 	}
 
 	if len(e.MissingNestedCompositeTypes) > 0 {
-		builder.WriteString(fmt.Sprintf("`%s` is", e.CompositeType.QualifiedString()))
-		if len(e.MissingMembers) > 0 {
+		var builder strings.Builder
+		builder.WriteString(subject)
+		builder.WriteString(" is")
+		if len(parts) > 0 {
 			builder.WriteString(" also")
 		}
 		builder.WriteString(" missing definitions for types: ")
@@ -1512,7 +1581,72 @@ func (e *ConformanceError) SecondaryError() string {
 				builder.WriteString(", ")
 			}
 		}
+		parts = append(parts, builder.String())
+	}
+
+	if e.InitializerMismatch != nil {
+		var builder strings.Builder
+		builder.WriteString(subject)
+		if len(parts) > 0 {
+			builder.WriteString(" also")
+		}
+		builder.WriteString(
+			fmt.Sprintf(
+				" has a mismatching initializer: expected `%s`, got `%s`",
+				formatInitializer(
+					e.InitializerMismatch.InterfacePurity,
+					e.InitializerMismatch.InterfaceParameters,
+				),
+				formatInitializer(
+					e.InitializerMismatch.CompositePurity,
+					e.InitializerMismatch.CompositeParameters,
+				),
+			),
+		)
+		parts = append(parts, builder.String())
+	}
+
+	for _, memberMismatch := range e.MemberMismatches {
+		accessMismatch := memberMismatch.AccessMismatch
+		if accessMismatch == nil {
+			continue
+		}
+
+		var builder strings.Builder
+		builder.WriteString(subject)
+		if len(parts) > 0 {
+			builder.WriteString(" also")
+		}
+		builder.WriteString(
+			fmt.Sprintf(
+				" has a more restrictive access for member `%s`: expected `%s`, got `%s`",
+				memberMismatch.CompositeMember.Identifier.Identifier,
+				accessMismatch.ExpectedAccess.QualifiedKeyword(),
+				accessMismatch.ActualAccess.QualifiedKeyword(),
+			),
+		)
+		parts = append(parts, builder.String())
+	}
+
+	return strings.Join(parts, ". ")
+}
+
+func formatInitializer(purity FunctionPurity, parameters []Parameter) string {
+	var builder strings.Builder
+
+	if purity == FunctionPurityView {
+		builder.WriteString(purity.String())
+		builder.WriteByte(' ')
+	}
+
+	builder.WriteString("init(")
+	for i, parameter := range parameters {
+		if i > 0 {
+			builder.WriteString(", ")
+		}
+		builder.WriteString(parameter.QualifiedString())
 	}
+	builder.WriteByte(')')
 
 	return builder.String()
 }
@@ -1534,6 +1668,19 @@ func (e *ConformanceError) ErrorNotes() (notes []errors.ErrorNote) {
 		notes = append(notes, &MemberMismatchNote{
 			Range: compositeMemberIdentifierRange,
 		})
+
+		parameterMismatchNote := e.parameterMismatchNote(memberMismatch)
+		if parameterMismatchNote != nil {
+			notes = append(notes, parameterMismatchNote)
+		}
+
+		variableKindMismatch := memberMismatch.VariableKindMismatch
+		if variableKindMismatch != nil {
+			notes = append(notes, &VariableKindMismatchNote{
+				VariableKindMismatch: *variableKindMismatch,
+				Range:                compositeMemberIdentifierRange,
+			})
+		}
 	}
 
 	if e.InitializerMismatch != nil && len(e.CompositeDeclaration.DeclarationMembers().Initializers()) > 0 {
@@ -1549,6 +1696,90 @@ func (e *ConformanceError) ErrorNotes() (notes []errors.ErrorNote) {
 	return
 }
 
+// parameterMismatchNote returns a note for the mismatched parameter of the given member mismatch,
+// if the mismatched composite member function is declared in the composite declaration
+func (e *ConformanceError) parameterMismatchNote(memberMismatch MemberMismatch) *ParameterMismatchNote {
+	parameterMismatch := memberMismatch.ParameterMismatch
+	if parameterMismatch == nil || e.CompositeDeclaration == nil {
+		return nil
+	}
+
+	compositeMember := memberMismatch.CompositeMember
+
+	functionDeclaration, ok := e.CompositeDeclaration.DeclarationMembers().
+		FunctionsByIdentifier()[compositeMember.Identifier.Identifier]
+	if !ok ||
+		functionDeclaration.Identifier.Pos != compositeMember.Identifier.Pos ||
+		functionDeclaration.ParameterList == nil {
+
+		return nil
+	}
+
+	parameters := functionDeclaration.ParameterList.Parameters
+	if parameterMismatch.Index >= len(parameters) {
+		return nil
+	}
+
+	parameter := parameters[parameterMismatch.Index]
+
+	return &ParameterMismatchNote{
+		ParameterMismatch: *parameterMismatch,
+		Range:             ast.NewUnmeteredRangeFromPositioned(parameter.TypeAnnotation),
+	}
+}
+
+// IncompleteConformanceWarning
+//
+// IncompleteConformanceWarning is reported instead of a ConformanceError for missing members,
+// if incomplete conformances are allowed (see Config.AllowIncompleteConformance)
+type IncompleteConformanceWarning struct {
+	CompositeType  *CompositeType
+	InterfaceType  *InterfaceType
+	MissingMembers []*Member
+	Pos            ast.Position
+}
+
+var _ Warning = &IncompleteConformanceWarning{}
+var _ errors.SecondaryError = &IncompleteConformanceWarning{}
+
+func (*IncompleteConformanceWarning) isWarning() {}
+
+func (e *IncompleteConformanceWarning) Error() string {
+	return fmt.Sprintf(
+		"%s `%s` does not fully conform to %s interface `%s`",
+		e.CompositeType.Kind.Name(),
+		e.CompositeType.QualifiedString(),
+		e.InterfaceType.CompositeKind.Name(),
+		e.InterfaceType.QualifiedString(),
+	)
+}
+
+func (e *IncompleteConformanceWarning) SecondaryError() string {
+	return missingMembersMessage(e.CompositeType, e.MissingMembers)
+}
+
+// missingMembersMessage returns the message for the members missing from the given composite type,
+// shared by ConformanceError and IncompleteConformanceWarning
+func missingMembersMessage(compositeType *CompositeType, missingMembers []*Member) string {
+	var builder strings.Builder
+	builder.WriteString(fmt.Sprintf("`%s` is missing definitions for members: ", compositeType.QualifiedString()))
+	for i, member := range missingMembers {
+		builder.WriteString(fmt.Sprintf("`%s`", member.Identifier.Identifier))
+		if i != len(missingMembers)-1 {
+			builder.WriteString(", ")
+		}
+	}
+	return builder.String()
+}
+
+func (e *IncompleteConformanceWarning) StartPosition() ast.Position {
+	return e.Pos
+}
+
+func (e *IncompleteConformanceWarning) EndPosition(common.MemoryGauge) ast.Position {
+	return e.Pos
+}
+
 // MemberMismatchNote
 
 type MemberMismatchNote struct {
@@ -1559,6 +1790,38 @@ func (n MemberMismatchNote) Message() string {
 	return "mismatch here"
 }
 
+// ParameterMismatchNote
+
+type ParameterMismatchNote struct {
+	ParameterMismatch
+	ast.Range
+}
+
+func (n ParameterMismatchNote) Message() string {
+	return fmt.Sprintf(
+		"mismatched type for parameter %d: expected `%s`, got `%s`",
+		// parameters are numbered starting at 1 for users
+		n.Index+1,
+		n.ExpectedType.QualifiedString(),
+		n.ActualType.QualifiedString(),
+	)
+}
+
+// VariableKindMismatchNote
+
+type VariableKindMismatchNote struct {
+	VariableKindMismatch
+	ast.Range
+}
+
+func (n VariableKindMismatchNote) Message() string {
+	return fmt.Sprintf(
+		"mismatched variable kind: expected `%s`, got `%s`",
+		n.ExpectedKind.Keyword(),
+		n.ActualKind.Keyword(),
+	)
+}
+
 // DuplicateConformanceError
 //
 // TODO: just make this a warning?
@@ -4110,6 +4373,61 @@ func (e *MissingSwitchCaseStatementsError) EndPosition(common.MemoryGauge) ast.P
 	return e.Pos
 }
 
+// DuplicateSwitchCaseWarning
+
+type DuplicateSwitchCaseWarning struct {
+	PreviousRange ast.Range
+	ast.Range
+}
+
+var _ Warning = &DuplicateSwitchCaseWarning{}
+var _ errors.ErrorNotes = &DuplicateSwitchCaseWarning{}
+
+func (*DuplicateSwitchCaseWarning) isWarning() {}
+
+func (e *DuplicateSwitchCaseWarning) Error() string {
+	return "duplicate switch case: the case is never taken"
+}
+
+func (e *DuplicateSwitchCaseWarning) ErrorNotes() []errors.ErrorNote {
+	return []errors.ErrorNote{
+		DuplicateSwitchCaseNote{
+			Range: e.PreviousRange,
+		},
+	}
+}
+
+// DuplicateSwitchCaseNote
+
+type DuplicateSwitchCaseNote struct {
+	ast.Range
+}
+
+func (n DuplicateSwitchCaseNote) Message() string {
+	return "the same value is matched here"
+}
+
+// DeprecatedIdentifierWarning
+
+type DeprecatedIdentifierWarning struct {
+	Identifier  string
+	Replacement string
+	ast.Range
+}
+
+var _ Warning = &DeprecatedIdentifierWarning{}
+var _ errors.SecondaryError = &DeprecatedIdentifierWarning{}
+
+func (*DeprecatedIdentifierWarning) isWarning() {}
+
+func (e *DeprecatedIdentifierWarning) Error() string {
+	return fmt.Sprintf("`%s` is deprecated", e.Identifier)
+}
+
+func (e *DeprecatedIdentifierWarning) SecondaryError() string {
+	return e.Replacement
+}
+
 // MissingEntryPointError
 
 type MissingEntryPointError struct {
@@ -25,6 +25,8 @@ import (
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/common"
 	"github.com/onflow/cadence/runtime/parser"
 )
 
@@ -72,6 +74,50 @@ func TestErrorMessageExpectedActualTypes(t *testing.T) {
 	})
 }
 
+func TestConformanceErrorSecondaryError(t *testing.T) {
+
+	t.Parallel()
+
+	compositeType := &CompositeType{
+		Location:   common.StringLocation("test"),
+		Identifier: "R",
+		Kind:       common.CompositeKindResource,
+	}
+
+	err := &ConformanceError{
+		CompositeType: compositeType,
+		MissingMembers: []*Member{
+			{Identifier: ast.Identifier{Identifier: "foo"}},
+			{Identifier: ast.Identifier{Identifier: "bar"}},
+		},
+		MissingNestedCompositeTypes: []*CompositeType{
+			{
+				Location:      common.StringLocation("test"),
+				Identifier:    "S",
+				Kind:          common.CompositeKindStructure,
+				containerType: compositeType,
+			},
+		},
+		InitializerMismatch: &InitializerMismatch{
+			CompositePurity: FunctionPurityView,
+			InterfacePurity: FunctionPurityImpure,
+			InterfaceParameters: []Parameter{
+				{
+					Identifier:     "x",
+					TypeAnnotation: IntTypeAnnotation,
+				},
+			},
+		},
+	}
+
+	assert.Equal(t,
+		"`R` is missing definitions for members: `foo`, `bar`. "+
+			"`R` is also missing definitions for types: `R.S`. "+
+			"`R` also has a mismatching initializer: expected `init(x: Int)`, got `view init()`",
+		err.SecondaryError(),
+	)
+}
+
 func TestUnwrappingCheckerError(t *testing.T) {
 	t.Parallel()
 
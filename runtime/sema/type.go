@@ -5401,6 +5401,7 @@ type Member struct {
 	// Parent type where this member can be resolved
 	ContainerType  Type
 	DocString      string
+	DocComments    []*ast.Comment
 	ArgumentLabels []string
 	Identifier     ast.Identifier
 	Access         Access
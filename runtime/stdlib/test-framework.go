@@ -22,6 +22,7 @@ package stdlib
 import (
 	"github.com/onflow/cadence/runtime/common"
 	"github.com/onflow/cadence/runtime/interpreter"
+	"github.com/onflow/cadence/runtime/sema"
 )
 
 // TestFramework & Blockchain are the interfaces to be implemented by
@@ -33,9 +34,31 @@ type TestFramework interface {
 	EmulatorBackend() Blockchain
 
 	ReadFile(string) (string, error)
+
+	// WriteFile writes the given content to the file at the given path,
+	// relative to the test root
+	WriteFile(path string, content string) error
+
+	// ListFiles returns the names of the files in the given directory,
+	// relative to the test root
+	ListFiles(directory string) ([]string, error)
+
+	// CreateKey generates a new private key for the given signature algorithm
+	CreateKey(signatureAlgorithm sema.SignatureAlgorithm) (*PrivateKey, error)
+
+	// Sign signs the given tag + message using the given private key and hash algorithm
+	Sign(
+		privateKey *PrivateKey,
+		message []byte,
+		tag string,
+		hashAlgorithm sema.HashAlgorithm,
+	) ([]byte, error)
 }
 
 type Blockchain interface {
+	// Hasher hashes using the hash algorithms of the keys of accounts
+	Hasher
+
 	RunScript(
 		inter *interpreter.Interpreter,
 		code string, arguments []interpreter.Value,
@@ -57,12 +80,14 @@ type Blockchain interface {
 
 	CommitBlock() error
 
+	// DeployContract deploys the contract with the given name, and returns
+	// the address of the account the contract was deployed to
 	DeployContract(
 		inter *interpreter.Interpreter,
 		name string,
 		path string,
 		arguments []interpreter.Value,
-	) error
+	) (common.Address, error)
 
 	Logs() []string
 
@@ -75,8 +100,17 @@ type Blockchain interface {
 
 	Reset(uint64)
 
+	// ResetToGenesis resets the state of the blockchain to its genesis state,
+	// removing all created accounts, their storage, and all blocks.
+	// Snapshots are not affected
+	ResetToGenesis()
+
+	// MoveTime moves the time of the blockchain by the given delta, in seconds.
+	// It advances both the block height and the block timestamp
 	MoveTime(int64)
 
+	CurrentBlockHeight() uint64
+
 	CreateSnapshot(string) error
 
 	LoadSnapshot(string) error
@@ -89,9 +123,25 @@ type ScriptResult struct {
 
 type TransactionResult struct {
 	Error error
+	// Authorizers are the addresses of the accounts which authorized the transaction
+	Authorizers []common.Address
+	// Signers are the addresses of the accounts which signed the transaction
+	Signers []common.Address
+	// ComputationUsed is the amount of computation metered during the execution of the transaction
+	ComputationUsed uint64
 }
 
 type Account struct {
 	PublicKey *PublicKey
-	Address   common.Address
+	// Keys are all keys of the account, including revoked keys
+	Keys    []*AccountKey
+	Address common.Address
+}
+
+// PrivateKey is a key generated by the test framework,
+// together with its public key
+type PrivateKey struct {
+	PublicKey *PublicKey
+	// PrivateKey is the encoded private key
+	PrivateKey []byte
 }
@@ -20,11 +20,13 @@ package stdlib
 
 import (
 	"fmt"
+	"sort"
 	"sync"
 
 	"github.com/onflow/cadence/runtime/ast"
 	"github.com/onflow/cadence/runtime/common"
 	"github.com/onflow/cadence/runtime/errors"
+	"github.com/onflow/cadence/runtime/format"
 	"github.com/onflow/cadence/runtime/interpreter"
 	"github.com/onflow/cadence/runtime/sema"
 )
@@ -37,17 +39,25 @@ const testContractTypeName = "Test"
 
 const testScriptResultTypeName = "ScriptResult"
 const testTransactionResultTypeName = "TransactionResult"
+const testContractDeploymentResultTypeName = "ContractDeploymentResult"
 const testResultStatusTypeName = "ResultStatus"
 const testResultStatusTypeSucceededCaseName = "succeeded"
 const testResultStatusTypeFailedCaseName = "failed"
 const testAccountTypeName = "TestAccount"
 const testErrorTypeName = "Error"
 const testMatcherTypeName = "Matcher"
+const testPrivateKeyTypeName = "PrivateKey"
 
 const accountAddressFieldName = "address"
 
+const accountKeysFieldName = "keys"
+
+const privateKeyPrivateKeyFieldName = "privateKey"
+
 const matcherTestFieldName = "test"
 
+const matcherDescribeFieldName = "describe"
+
 const TestContractLocation = common.IdentifierLocation(testContractTypeName)
 
 var testOnce sync.Once
@@ -130,17 +140,57 @@ func arrayValueToSlice(
 
 	result := make([]interpreter.Value, 0, array.Count())
 
-	array.Iterate(
+	err := arrayValueIterate(
 		inter,
+		array,
+		locationRange,
 		func(element interpreter.Value) (resume bool) {
 			result = append(result, element)
 			return true
 		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// arrayValueIterate calls the given function for each element of the given array value,
+// without materializing the elements into a slice.
+// Iteration stops early if the function returns false.
+func arrayValueIterate(
+	inter *interpreter.Interpreter,
+	value interpreter.Value,
+	locationRange interpreter.LocationRange,
+	f func(element interpreter.Value) (resume bool),
+) error {
+	array, ok := value.(*interpreter.ArrayValue)
+	if !ok {
+		return errors.NewDefaultUserError("value is not an array")
+	}
+
+	array.Iterate(
+		inter,
+		f,
 		false,
 		locationRange,
 	)
 
-	return result, nil
+	return nil
+}
+
+// newResultStatus returns the 'ResultStatus' enum case for an operation which failed with the given error, if any.
+func newResultStatus(inter *interpreter.Interpreter, err error) interpreter.Value {
+	// Lookup and get 'ResultStatus' enum value.
+	resultStatusConstructor := getConstructor(inter, testResultStatusTypeName)
+	if err != nil {
+		failedVar := resultStatusConstructor.NestedVariables[testResultStatusTypeFailedCaseName]
+		return failedVar.GetValue(inter)
+	}
+
+	succeededVar := resultStatusConstructor.NestedVariables[testResultStatusTypeSucceededCaseName]
+	return succeededVar.GetValue(inter)
 }
 
 // newScriptResult Creates a "ScriptResult" using the return value of the executed script.
@@ -150,24 +200,33 @@ func newScriptResult(
 	result *ScriptResult,
 ) interpreter.Value {
 
+	var returnType interpreter.Value = interpreter.Nil
+
 	if returnValue == nil {
 		returnValue = interpreter.Nil
-	}
-
-	// Lookup and get 'ResultStatus' enum value.
-	resultStatusConstructor := getConstructor(inter, testResultStatusTypeName)
-	var status interpreter.Value
-	if result.Error == nil {
-		succeededVar := resultStatusConstructor.NestedVariables[testResultStatusTypeSucceededCaseName]
-		status = succeededVar.GetValue(inter)
+	} else if returnValue.IsResourceKinded(inter) {
+		// 'ScriptResult.returnValue' is a struct field,
+		// so a resource cannot be moved into the test.
+		panic(errors.NewDefaultUserError(
+			"scripts executed by the test framework cannot return resources: got `%s`",
+			returnValue.StaticType(inter),
+		))
 	} else {
-		failedVar := resultStatusConstructor.NestedVariables[testResultStatusTypeFailedCaseName]
-		status = failedVar.GetValue(inter)
+		// The static type of the return value is exact, e.g. it is optional for optionals,
+		// so it is determined here, instead of using 'getType' in the initializer
+		returnType = interpreter.NewSomeValueNonCopying(
+			inter,
+			interpreter.NewTypeValue(inter, returnValue.StaticType(inter)),
+		)
 	}
 
+	status := newResultStatus(inter, result.Error)
+
 	errValue := newErrorValue(inter, result.Error)
 
 	// Create a 'ScriptResult' by calling its constructor.
+	// The return value is passed as-is, and only boxed into an optional,
+	// so it keeps its static type (e.g. `[Int]`), instead of being generalized to `AnyStruct`.
 	scriptResultConstructor := getConstructor(inter, testScriptResultTypeName)
 	scriptResult, err := inter.InvokeExternally(
 		scriptResultConstructor,
@@ -175,6 +234,7 @@ func newScriptResult(
 		[]interpreter.Value{
 			status,
 			returnValue,
+			returnType,
 			errValue,
 		},
 	)
@@ -186,6 +246,38 @@ func newScriptResult(
 	return scriptResult
 }
 
+// newContractDeploymentResult Creates a "ContractDeploymentResult" indicating the status of the contract deployment.
+func newContractDeploymentResult(
+	inter *interpreter.Interpreter,
+	address common.Address,
+	contractName string,
+	deploymentErr error,
+) interpreter.Value {
+
+	status := newResultStatus(inter, deploymentErr)
+
+	errValue := newErrorValue(inter, deploymentErr)
+
+	// Create a 'ContractDeploymentResult' by calling its constructor.
+	contractDeploymentResultConstructor := getConstructor(inter, testContractDeploymentResultTypeName)
+	contractDeploymentResult, err := inter.InvokeExternally(
+		contractDeploymentResultConstructor,
+		contractDeploymentResultConstructor.Type,
+		[]interpreter.Value{
+			status,
+			interpreter.NewAddressValue(inter, address),
+			interpreter.NewUnmeteredStringValue(contractName),
+			errValue,
+		},
+	)
+
+	if err != nil {
+		panic(err)
+	}
+
+	return contractDeploymentResult
+}
+
 func getConstructor(inter *interpreter.Interpreter, typeName string) *interpreter.HostFunctionValue {
 	resultStatusConstructorVar := inter.FindVariable(typeName)
 	resultStatusConstructor, ok := resultStatusConstructorVar.GetValue(inter).(*interpreter.HostFunctionValue)
@@ -201,15 +293,12 @@ func addressArrayValueToSlice(
 	accountsValue interpreter.Value,
 	locationRange interpreter.LocationRange,
 ) []common.Address {
-	accountsArray, ok := accountsValue.(*interpreter.ArrayValue)
-	if !ok {
-		panic(errors.NewUnreachableError())
-	}
-
 	addresses := make([]common.Address, 0)
 
-	accountsArray.Iterate(
+	err := arrayValueIterate(
 		inter,
+		accountsValue,
+		locationRange,
 		func(element interpreter.Value) (resume bool) {
 			address, ok := element.(interpreter.AddressValue)
 			if !ok {
@@ -220,9 +309,10 @@ func addressArrayValueToSlice(
 
 			return true
 		},
-		false,
-		locationRange,
 	)
+	if err != nil {
+		panic(errors.NewUnreachableError())
+	}
 
 	return addresses
 }
@@ -233,15 +323,12 @@ func accountsArrayValueToSlice(
 	locationRange interpreter.LocationRange,
 ) []*Account {
 
-	accountsArray, ok := accountsValue.(*interpreter.ArrayValue)
-	if !ok {
-		panic(errors.NewUnreachableError())
-	}
-
 	accounts := make([]*Account, 0)
 
-	accountsArray.Iterate(
+	err := arrayValueIterate(
 		inter,
+		accountsValue,
+		locationRange,
 		func(element interpreter.Value) (resume bool) {
 			accountValue, ok := element.(interpreter.MemberAccessibleValue)
 			if !ok {
@@ -254,9 +341,10 @@ func accountsArrayValueToSlice(
 
 			return true
 		},
-		false,
-		locationRange,
 	)
+	if err != nil {
+		panic(errors.NewUnreachableError())
+	}
 
 	return accounts
 }
@@ -294,24 +382,110 @@ func accountFromValue(
 		panic(err)
 	}
 
+	// Get keys
+	keysValue := accountValue.GetMember(
+		inter,
+		locationRange,
+		accountKeysFieldName,
+	)
+
+	var keys []*AccountKey
+
+	err = arrayValueIterate(
+		inter,
+		keysValue,
+		locationRange,
+		func(element interpreter.Value) (resume bool) {
+			keyValue, ok := element.(interpreter.MemberAccessibleValue)
+			if !ok {
+				panic(errors.NewUnreachableError())
+			}
+
+			keys = append(keys, accountKeyFromValue(inter, keyValue, locationRange))
+
+			return true
+		},
+	)
+	if err != nil {
+		panic(errors.NewUnreachableError())
+	}
+
 	return &Account{
 		Address:   common.Address(address),
 		PublicKey: publicKey,
+		Keys:      keys,
+	}
+}
+
+func accountKeyFromValue(
+	inter *interpreter.Interpreter,
+	keyValue interpreter.MemberAccessibleValue,
+	locationRange interpreter.LocationRange,
+) *AccountKey {
+
+	keyIndex, ok := keyValue.GetMember(
+		inter,
+		locationRange,
+		sema.AccountKeyKeyIndexFieldName,
+	).(interpreter.IntValue)
+	if !ok {
+		panic(errors.NewUnreachableError())
+	}
+
+	publicKeyValue, ok := keyValue.GetMember(
+		inter,
+		locationRange,
+		sema.AccountKeyPublicKeyFieldName,
+	).(interpreter.MemberAccessibleValue)
+	if !ok {
+		panic(errors.NewUnreachableError())
+	}
+
+	publicKey, err := NewPublicKeyFromValue(inter, locationRange, publicKeyValue)
+	if err != nil {
+		panic(err)
+	}
+
+	hashAlgorithm := NewHashAlgorithmFromValue(
+		inter,
+		locationRange,
+		keyValue.GetMember(
+			inter,
+			locationRange,
+			sema.AccountKeyHashAlgoFieldName,
+		),
+	)
+
+	weight, ok := keyValue.GetMember(
+		inter,
+		locationRange,
+		sema.AccountKeyWeightFieldName,
+	).(interpreter.UFix64Value)
+	if !ok {
+		panic(errors.NewUnreachableError())
+	}
+
+	isRevoked, ok := keyValue.GetMember(
+		inter,
+		locationRange,
+		sema.AccountKeyIsRevokedFieldName,
+	).(interpreter.BoolValue)
+	if !ok {
+		panic(errors.NewUnreachableError())
+	}
+
+	return &AccountKey{
+		KeyIndex:  uint32(keyIndex.ToInt(locationRange)),
+		PublicKey: publicKey,
+		HashAlgo:  hashAlgorithm,
+		Weight:    weight.ToInt(locationRange),
+		IsRevoked: bool(isRevoked),
 	}
 }
 
 // newTransactionResult Creates a "TransactionResult" indicating the status of the transaction execution.
 func newTransactionResult(inter *interpreter.Interpreter, result *TransactionResult) interpreter.Value {
-	// Lookup and get 'ResultStatus' enum value.
-	resultStatusConstructor := getConstructor(inter, testResultStatusTypeName)
-	var status interpreter.Value
-	if result.Error == nil {
-		succeededVar := resultStatusConstructor.NestedVariables[testResultStatusTypeSucceededCaseName]
-		status = succeededVar.GetValue(inter)
-	} else {
-		failedVar := resultStatusConstructor.NestedVariables[testResultStatusTypeFailedCaseName]
-		status = failedVar.GetValue(inter)
-	}
+	status := newResultStatus(inter, result.Error)
 
 	// Create a 'TransactionResult' by calling its constructor.
 	transactionResultConstructor := getConstructor(inter, testTransactionResultTypeName)
@@ -323,6 +497,14 @@ func newTransactionResult(inter *interpreter.Interpreter, result *TransactionRes
 		transactionResultConstructor.Type,
 		[]interpreter.Value{
 			status,
+			newAddressArrayValue(inter, result.Authorizers),
+			newAddressArrayValue(inter, result.Signers),
+			interpreter.NewUInt64Value(
+				inter,
+				func() uint64 {
+					return result.ComputationUsed
+				},
+			),
 			errValue,
 		},
 	)
@@ -334,6 +516,21 @@ func newTransactionResult(inter *interpreter.Interpreter, result *TransactionRes
 	return transactionResult
 }
 
+func newAddressArrayValue(inter *interpreter.Interpreter, addresses []common.Address) *interpreter.ArrayValue {
+	addressValues := make([]interpreter.Value, 0, len(addresses))
+	for _, address := range addresses {
+		addressValues = append(addressValues, interpreter.NewAddressValue(inter, address))
+	}
+
+	return interpreter.NewArrayValue(
+		inter,
+		interpreter.EmptyLocationRange,
+		interpreter.NewVariableSizedStaticType(inter, interpreter.PrimitiveStaticTypeAddress),
+		common.ZeroAddress,
+		addressValues...,
+	)
+}
+
 func newErrorValue(inter *interpreter.Interpreter, err error) interpreter.Value {
 	if err == nil {
 		return interpreter.Nil
@@ -375,6 +572,100 @@ func (e TestFailedError) Error() string {
 	return fmt.Sprintf("test failed: %s", e.Err.Error())
 }
 
+// newPrivateKeyValue creates a 'Test.PrivateKey' value for the given private key.
+func newPrivateKeyValue(
+	inter *interpreter.Interpreter,
+	locationRange interpreter.LocationRange,
+	testContractValue interpreter.Value,
+	privateKey *PrivateKey,
+) interpreter.Value {
+	publicKey := NewPublicKeyValue(
+		inter,
+		locationRange,
+		privateKey.PublicKey,
+	)
+
+	privateKeyConstructor := getNestedTypeConstructorValue(
+		inter,
+		testContractValue,
+		testPrivateKeyTypeName,
+	)
+	privateKeyValue, err := inter.InvokeExternally(
+		privateKeyConstructor,
+		privateKeyConstructor.Type,
+		[]interpreter.Value{
+			publicKey,
+			interpreter.ByteSliceToByteArrayValue(inter, privateKey.PrivateKey),
+		},
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	return privateKeyValue
+}
+
+func privateKeyFromValue(
+	inter *interpreter.Interpreter,
+	locationRange interpreter.LocationRange,
+	privateKeyValue interpreter.MemberAccessibleValue,
+) *PrivateKey {
+
+	publicKeyValue, ok := privateKeyValue.GetMember(
+		inter,
+		locationRange,
+		sema.AccountKeyPublicKeyFieldName,
+	).(interpreter.MemberAccessibleValue)
+	if !ok {
+		panic(errors.NewUnreachableError())
+	}
+
+	publicKey, err := NewPublicKeyFromValue(inter, locationRange, publicKeyValue)
+	if err != nil {
+		panic(err)
+	}
+
+	privateKey, err := interpreter.ByteArrayValueToByteSlice(
+		inter,
+		privateKeyValue.GetMember(
+			inter,
+			locationRange,
+			privateKeyPrivateKeyFieldName,
+		),
+		locationRange,
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	return &PrivateKey{
+		PublicKey:  publicKey,
+		PrivateKey: privateKey,
+	}
+}
+
+func signatureAlgorithmFromValue(
+	inter *interpreter.Interpreter,
+	locationRange interpreter.LocationRange,
+	value interpreter.Value,
+) sema.SignatureAlgorithm {
+	signatureAlgorithmValue, ok := value.(interpreter.MemberAccessibleValue)
+	if !ok {
+		panic(errors.NewUnreachableError())
+	}
+
+	rawValue, ok := signatureAlgorithmValue.GetMember(
+		inter,
+		locationRange,
+		sema.EnumRawValueFieldName,
+	).(interpreter.UInt8Value)
+	if !ok {
+		panic(errors.NewUnreachableError())
+	}
+
+	return sema.SignatureAlgorithm(rawValue.ToInt(locationRange))
+}
+
 // Creates a matcher using a function that accepts an `AnyStruct` typed parameter.
 // i.e: invokes `newMatcher(fun (value: AnyStruct): Bool)`.
 func newMatcherWithAnyStructTestFunction(
@@ -404,6 +695,112 @@ func newMatcherWithAnyStructTestFunction(
 	return matcher
 }
 
+var matcherDescribeFunctionType = &sema.FunctionType{
+	Parameters: []sema.Parameter{
+		{
+			Label:          sema.ArgumentLabelNotRequired,
+			Identifier:     "value",
+			TypeAnnotation: sema.AnyStructTypeAnnotation,
+		},
+	},
+	ReturnTypeAnnotation: sema.StringTypeAnnotation,
+}
+
+// withMatcherDescription sets the `describe` function of the given matcher,
+// which describes why a tested value did not match
+func withMatcherDescription(
+	invocation interpreter.Invocation,
+	matcher interpreter.Value,
+	describe func(value interpreter.Value) string,
+) interpreter.Value {
+
+	inter := invocation.Interpreter
+
+	matcherValue, ok := matcher.(*interpreter.CompositeValue)
+	if !ok {
+		panic(errors.NewUnreachableError())
+	}
+
+	describeFunction := interpreter.NewStaticHostFunctionValue(
+		inter,
+		matcherDescribeFunctionType,
+		func(invocation interpreter.Invocation) interpreter.Value {
+			return interpreter.NewUnmeteredStringValue(
+				describe(invocation.Arguments[0]),
+			)
+		},
+	)
+
+	matcherValue.SetMember(
+		inter,
+		invocation.LocationRange,
+		matcherDescribeFieldName,
+		interpreter.NewSomeValueNonCopying(inter, describeFunction),
+	)
+
+	return matcherValue
+}
+
+// describedValueString returns the string representation of the given value,
+// for use in matcher descriptions.
+// Unlike the default string representation, the entries of dictionaries
+// are ordered by key, so descriptions are deterministic.
+func describedValueString(
+	inter *interpreter.Interpreter,
+	locationRange interpreter.LocationRange,
+	value interpreter.Value,
+) string {
+	switch value := value.(type) {
+	case *interpreter.DictionaryValue:
+		pairs := make([]struct {
+			Key   string
+			Value string
+		}, 0, value.Count())
+
+		value.Iterate(
+			inter,
+			locationRange,
+			func(key, value interpreter.Value) (resume bool) {
+				pairs = append(pairs, struct {
+					Key   string
+					Value string
+				}{
+					Key:   describedValueString(inter, locationRange, key),
+					Value: describedValueString(inter, locationRange, value),
+				})
+				return true
+			},
+		)
+
+		sort.Slice(pairs, func(i, j int) bool {
+			return pairs[i].Key < pairs[j].Key
+		})
+
+		return format.Dictionary(pairs)
+
+	case *interpreter.ArrayValue:
+		elements := make([]string, 0, value.Count())
+
+		value.Iterate(
+			inter,
+			func(element interpreter.Value) (resume bool) {
+				elements = append(
+					elements,
+					describedValueString(inter, locationRange, element),
+				)
+				return true
+			},
+			false,
+			locationRange,
+		)
+
+		return format.Array(elements)
+
+	default:
+		return value.MeteredString(inter, interpreter.SeenReferences{}, locationRange)
+	}
+}
+
 // Creates a matcher using a function that accepts a generic `T` typed parameter.
 // NOTE: Use this function only if the matcher function has a generic type.
 func newMatcherWithGenericTestFunction(
@@ -431,7 +828,8 @@ func newMatcherWithGenericTestFunction(
 	// Note: This argument validation is only needed if the matcher was created with a user-provided function.
 	// No need to validate if the matcher is created as a matcher combinator.
 	//
-	matcherTestFunction := interpreter.NewUnmeteredStaticHostFunctionValue(
+	matcherTestFunction := interpreter.NewStaticHostFunctionValue(
+		invocation.Interpreter,
 		matcherTestFunctionType,
 		func(invocation interpreter.Invocation) interpreter.Value {
 			inter := invocation.Interpreter
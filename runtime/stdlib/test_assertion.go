@@ -0,0 +1,284 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package stdlib
+
+import (
+	"fmt"
+
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/errors"
+	"github.com/onflow/cadence/runtime/interpreter"
+	"github.com/onflow/cadence/runtime/sema"
+)
+
+// 'Assertion' struct.
+//
+// 'Assertion' is returned by 'Test.assertThat'.
+// It provides chainable assertions on the wrapped value.
+
+const testAssertionTypeName = "Assertion"
+
+type testAssertionType struct {
+	compositeType             *sema.CompositeType
+	isEqualToFunctionType     *sema.FunctionType
+	isGreaterThanFunctionType *sema.FunctionType
+	isNilFunctionType         *sema.FunctionType
+}
+
+func newTestAssertionType() *testAssertionType {
+
+	compositeType := &sema.CompositeType{
+		Identifier: testAssertionTypeName,
+		Kind:       common.CompositeKindStructure,
+		Location:   TestContractLocation,
+	}
+
+	isEqualToFunctionType := &sema.FunctionType{
+		Parameters: []sema.Parameter{
+			{
+				Label:          sema.ArgumentLabelNotRequired,
+				Identifier:     "expected",
+				TypeAnnotation: sema.AnyStructTypeAnnotation,
+			},
+		},
+		ReturnTypeAnnotation: sema.NewTypeAnnotation(compositeType),
+	}
+
+	isGreaterThanFunctionType := &sema.FunctionType{
+		Parameters: []sema.Parameter{
+			{
+				Label:          sema.ArgumentLabelNotRequired,
+				Identifier:     "value",
+				TypeAnnotation: sema.NumberTypeAnnotation,
+			},
+		},
+		ReturnTypeAnnotation: sema.NewTypeAnnotation(compositeType),
+	}
+
+	isNilFunctionType := &sema.FunctionType{
+		ReturnTypeAnnotation: sema.NewTypeAnnotation(compositeType),
+	}
+
+	var members = []*sema.Member{
+		sema.NewUnmeteredPublicFunctionMember(
+			compositeType,
+			testAssertionTypeIsEqualToFunctionName,
+			isEqualToFunctionType,
+			testAssertionTypeIsEqualToFunctionDocString,
+		),
+		sema.NewUnmeteredPublicFunctionMember(
+			compositeType,
+			testAssertionTypeIsGreaterThanFunctionName,
+			isGreaterThanFunctionType,
+			testAssertionTypeIsGreaterThanFunctionDocString,
+		),
+		sema.NewUnmeteredPublicFunctionMember(
+			compositeType,
+			testAssertionTypeIsNilFunctionName,
+			isNilFunctionType,
+			testAssertionTypeIsNilFunctionDocString,
+		),
+	}
+
+	compositeType.Members = sema.MembersAsMap(members)
+	compositeType.Fields = sema.MembersFieldNames(members)
+
+	return &testAssertionType{
+		compositeType:             compositeType,
+		isEqualToFunctionType:     isEqualToFunctionType,
+		isGreaterThanFunctionType: isGreaterThanFunctionType,
+		isNilFunctionType:         isNilFunctionType,
+	}
+}
+
+// 'Assertion.isEqualTo' function
+
+const testAssertionTypeIsEqualToFunctionName = "isEqualTo"
+
+const testAssertionTypeIsEqualToFunctionDocString = `
+Fails the test-case if the value is not equal to the given value.
+Returns the assertion, so further assertions can be chained.
+`
+
+func (t *testAssertionType) newIsEqualToFunction(
+	inter *interpreter.Interpreter,
+	assertion *interpreter.CompositeValue,
+	value interpreter.Value,
+) interpreter.BoundFunctionValue {
+	return interpreter.NewUnmeteredBoundHostFunctionValue(
+		inter,
+		assertion,
+		t.isEqualToFunctionType,
+		func(invocation interpreter.Invocation) interpreter.Value {
+			expected, ok := invocation.Arguments[0].(interpreter.EquatableValue)
+			if !ok {
+				panic(errors.NewUnreachableError())
+			}
+
+			actual, ok := value.(interpreter.EquatableValue)
+			if !ok {
+				panic(AssertionError{
+					Message:       fmt.Sprintf("not equatable: %s", value),
+					LocationRange: invocation.LocationRange,
+				})
+			}
+
+			assertEqual(
+				invocation.Interpreter,
+				expected,
+				actual,
+				invocation.LocationRange,
+			)
+
+			return assertion
+		},
+	)
+}
+
+// 'Assertion.isGreaterThan' function
+
+const testAssertionTypeIsGreaterThanFunctionName = "isGreaterThan"
+
+const testAssertionTypeIsGreaterThanFunctionDocString = `
+Fails the test-case if the value is not a number greater than the given number.
+Returns the assertion, so further assertions can be chained.
+`
+
+func (t *testAssertionType) newIsGreaterThanFunction(
+	inter *interpreter.Interpreter,
+	assertion *interpreter.CompositeValue,
+	value interpreter.Value,
+) interpreter.BoundFunctionValue {
+	return interpreter.NewUnmeteredBoundHostFunctionValue(
+		inter,
+		assertion,
+		t.isGreaterThanFunctionType,
+		func(invocation interpreter.Invocation) interpreter.Value {
+			otherValue, ok := invocation.Arguments[0].(interpreter.NumberValue)
+			if !ok {
+				panic(errors.NewUnreachableError())
+			}
+
+			locationRange := invocation.LocationRange
+
+			numberValue, ok := value.(interpreter.NumberValue)
+			if !ok {
+				panic(AssertionError{
+					Message:       fmt.Sprintf("not a number: %s", value),
+					LocationRange: locationRange,
+				})
+			}
+
+			isGreaterThan := numberValue.Greater(
+				invocation.Interpreter,
+				otherValue,
+				locationRange,
+			)
+
+			if !isGreaterThan {
+				message := fmt.Sprintf(
+					"not greater than: expected: greater than %s, actual: %s",
+					otherValue,
+					numberValue,
+				)
+				panic(AssertionError{
+					Message:       message,
+					LocationRange: locationRange,
+				})
+			}
+
+			return assertion
+		},
+	)
+}
+
+// 'Assertion.isNil' function
+
+const testAssertionTypeIsNilFunctionName = "isNil"
+
+const testAssertionTypeIsNilFunctionDocString = `
+Fails the test-case if the value is not nil.
+Returns the assertion, so further assertions can be chained.
+`
+
+func (t *testAssertionType) newIsNilFunction(
+	inter *interpreter.Interpreter,
+	assertion *interpreter.CompositeValue,
+	value interpreter.Value,
+) interpreter.BoundFunctionValue {
+	return interpreter.NewUnmeteredBoundHostFunctionValue(
+		inter,
+		assertion,
+		t.isNilFunctionType,
+		func(invocation interpreter.Invocation) interpreter.Value {
+			if _, ok := value.(interpreter.NilValue); !ok {
+				panic(AssertionError{
+					Message:       fmt.Sprintf("not nil: %s", value),
+					LocationRange: invocation.LocationRange,
+				})
+			}
+
+			return assertion
+		},
+	)
+}
+
+func (t *testAssertionType) newAssertion(
+	inter *interpreter.Interpreter,
+	value interpreter.Value,
+	locationRange interpreter.LocationRange,
+) *interpreter.CompositeValue {
+
+	// TODO: Use SimpleCompositeValue
+	assertion := interpreter.NewCompositeValue(
+		inter,
+		locationRange,
+		t.compositeType.Location,
+		testAssertionTypeName,
+		common.CompositeKindStructure,
+		nil,
+		common.ZeroAddress,
+	)
+
+	fields := []interpreter.CompositeField{
+		{
+			Name:  testAssertionTypeIsEqualToFunctionName,
+			Value: t.newIsEqualToFunction(inter, assertion, value),
+		},
+		{
+			Name:  testAssertionTypeIsGreaterThanFunctionName,
+			Value: t.newIsGreaterThanFunction(inter, assertion, value),
+		},
+		{
+			Name:  testAssertionTypeIsNilFunctionName,
+			Value: t.newIsNilFunction(inter, assertion, value),
+		},
+	}
+
+	for _, field := range fields {
+		assertion.SetMember(
+			inter,
+			locationRange,
+			field.Name,
+			field.Value,
+		)
+	}
+
+	return assertion
+}
@@ -20,6 +20,9 @@ package stdlib
 
 import (
 	"fmt"
+	"math/big"
+	"path/filepath"
+	"regexp"
 	"strings"
 
 	"github.com/onflow/cadence/runtime/ast"
@@ -32,19 +35,36 @@ import (
 )
 
 type TestContractType struct {
-	Checker                  *sema.Checker
-	CompositeType            *sema.CompositeType
-	InitializerTypes         []sema.Type
-	emulatorBackendType      *testEmulatorBackendType
-	expectFunction           testContractBoundFunctionGenerator
-	newMatcherFunction       testContractBoundFunctionGenerator
-	haveElementCountFunction testContractBoundFunctionGenerator
-	beEmptyFunction          testContractBoundFunctionGenerator
-	equalFunction            testContractBoundFunctionGenerator
-	beGreaterThanFunction    testContractBoundFunctionGenerator
-	containFunction          testContractBoundFunctionGenerator
-	beLessThanFunction       testContractBoundFunctionGenerator
-	expectFailureFunction    testContractBoundFunctionGenerator
+	Checker                    *sema.Checker
+	CompositeType              *sema.CompositeType
+	InitializerTypes           []sema.Type
+	emulatorBackendType        *testEmulatorBackendType
+	assertionType              *testAssertionType
+	expectFunction             testContractBoundFunctionGenerator
+	newMatcherFunction         testContractBoundFunctionGenerator
+	satisfyFunction            testContractBoundFunctionGenerator
+	anyOfFunction              testContractBoundFunctionGenerator
+	allOfFunction              testContractBoundFunctionGenerator
+	haveElementCountFunction   testContractBoundFunctionGenerator
+	beEmptyFunction            testContractBoundFunctionGenerator
+	equalFunction              testContractBoundFunctionGenerator
+	beGreaterThanFunction      testContractBoundFunctionGenerator
+	containFunction            testContractBoundFunctionGenerator
+	notContainFunction         testContractBoundFunctionGenerator
+	beLessThanFunction         testContractBoundFunctionGenerator
+	expectFailureFunction      testContractBoundFunctionGenerator
+	beInstanceOfFunction       testContractBoundFunctionGenerator
+	conformToFunction          testContractBoundFunctionGenerator
+	matchRegexFunction         testContractBoundFunctionGenerator
+	beCloseToFunction          testContractBoundFunctionGenerator
+	beSortedFunction           testContractBoundFunctionGenerator
+	beSortedDescendingFunction testContractBoundFunctionGenerator
+	beResourceFunction         testContractBoundFunctionGenerator
+	beStructFunction           testContractBoundFunctionGenerator
+	haveFieldFunction          testContractBoundFunctionGenerator
+	assertThatFunction         testContractBoundFunctionGenerator
+	createKeyFunctionType      *sema.FunctionType
+	signFunctionType           *sema.FunctionType
 }
 
 type testContractBoundFunctionGenerator func(
@@ -163,45 +183,59 @@ func testTypeAssertEqualFunction(
 				panic(errors.NewUnreachableError())
 			}
 
-			inter := invocation.Interpreter
-
-			expectedType := expected.StaticType(inter)
-			actualType := actual.StaticType(inter)
-			if !expectedType.Equal(actualType) {
-				message := fmt.Sprintf(
-					"not equal types: expected: %s, actual: %s",
-					expectedType,
-					actualType,
-				)
-				panic(AssertionError{
-					Message:       message,
-					LocationRange: invocation.LocationRange,
-				})
-			}
-
-			equal := expected.Equal(
-				inter,
-				invocation.LocationRange,
+			assertEqual(
+				invocation.Interpreter,
+				expected,
 				actual,
+				invocation.LocationRange,
 			)
 
-			if !equal {
-				message := fmt.Sprintf(
-					"not equal: expected: %s, actual: %s",
-					expected,
-					actual,
-				)
-				panic(AssertionError{
-					Message:       message,
-					LocationRange: invocation.LocationRange,
-				})
-			}
-
 			return interpreter.Void
 		},
 	)
 }
 
+// assertEqual fails with an AssertionError if the given values
+// do not have the same static type, or are not equal
+func assertEqual(
+	inter *interpreter.Interpreter,
+	expected interpreter.EquatableValue,
+	actual interpreter.EquatableValue,
+	locationRange interpreter.LocationRange,
+) {
+	expectedType := expected.StaticType(inter)
+	actualType := actual.StaticType(inter)
+	if !expectedType.Equal(actualType) {
+		message := fmt.Sprintf(
+			"not equal types: expected: %s, actual: %s",
+			expectedType,
+			actualType,
+		)
+		panic(AssertionError{
+			Message:       message,
+			LocationRange: locationRange,
+		})
+	}
+
+	equal := expected.Equal(
+		inter,
+		locationRange,
+		actual,
+	)
+
+	if !equal {
+		message := fmt.Sprintf(
+			"not equal: expected: %s, actual: %s",
+			expected,
+			actual,
+		)
+		panic(AssertionError{
+			Message:       message,
+			LocationRange: locationRange,
+		})
+	}
+}
+
 // 'Test.fail' function
 
 const testTypeFailFunctionDocString = `
@@ -253,6 +287,7 @@ func testTypeFailFunction(
 
 const testTypeExpectFunctionDocString = `
 Expect function tests a value against a matcher, and fails the test if it's not a match.
+The optional message is included in the failure, to provide additional context.
 `
 
 const testTypeExpectFunctionName = "expect"
@@ -283,8 +318,14 @@ func newTestTypeExpectFunctionType(matcherType *sema.CompositeType) *sema.Functi
 				Identifier:     "matcher",
 				TypeAnnotation: sema.NewTypeAnnotation(matcherType),
 			},
+			{
+				Identifier:     "message",
+				TypeAnnotation: sema.StringTypeAnnotation,
+			},
 		},
 		ReturnTypeAnnotation: sema.VoidTypeAnnotation,
+		// `message` parameter is optional
+		Arity: &sema.Arity{Min: 2, Max: 3},
 	}
 }
 
@@ -313,10 +354,21 @@ func newTestTypeExpectFunction(functionType *sema.FunctionType) testContractBoun
 				)
 
 				if !result {
-					message := fmt.Sprintf(
-						"given value is: %s",
+					message := describeMatcherMismatch(
+						inter,
+						matcher,
 						value,
+						locationRange,
 					)
+
+					if len(invocation.Arguments) > 2 {
+						messageValue, ok := invocation.Arguments[2].(*interpreter.StringValue)
+						if !ok {
+							panic(errors.NewUnreachableError())
+						}
+						message = fmt.Sprintf("%s: %s", messageValue.Str, message)
+					}
+
 					panic(AssertionError{
 						Message:       message,
 						LocationRange: locationRange,
@@ -371,6 +423,53 @@ func invokeMatcherTest(
 	return bool(result)
 }
 
+// describeMatcherMismatch returns a message describing why the given value
+// did not match the given matcher. If the matcher has no description,
+// the message only reports the value
+func describeMatcherMismatch(
+	inter *interpreter.Interpreter,
+	matcher interpreter.MemberAccessibleValue,
+	value interpreter.Value,
+	locationRange interpreter.LocationRange,
+) string {
+	describe := matcher.GetMember(
+		inter,
+		locationRange,
+		matcherDescribeFieldName,
+	)
+
+	someDescribe, ok := describe.(*interpreter.SomeValue)
+	if !ok {
+		return fmt.Sprintf("given value is: %s", value)
+	}
+
+	funcValue, ok := someDescribe.InnerValue(inter, locationRange).(interpreter.FunctionValue)
+	if !ok {
+		panic(errors.NewUnexpectedError(
+			"invalid type for '%s'. expected function",
+			matcherDescribeFieldName,
+		))
+	}
+
+	description, err := inter.InvokeExternally(
+		funcValue,
+		funcValue.FunctionType(),
+		[]interpreter.Value{
+			value,
+		},
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	descriptionString, ok := description.(*interpreter.StringValue)
+	if !ok {
+		panic(errors.NewUnreachableError())
+	}
+
+	return descriptionString.Str
+}
+
 // 'Test.readFile' function
 
 const testTypeReadFileFunctionDocString = `
@@ -415,134 +514,1112 @@ func newTestTypeReadFileFunction(
 	)
 }
 
-// 'Test.NewMatcher' function.
-// Constructs a matcher that test only 'AnyStruct'.
-// Accepts test function that accepts subtype of 'AnyStruct'.
-//
-// Signature:
-//    fun newMatcher<T: AnyStruct>(test: fun(T): Bool): Test.Matcher
-//
-// where `T` is optional, and bound to `AnyStruct`.
-//
-// Sample usage: `Test.newMatcher(fun (_ value: Int: Bool) { return true })`
+// 'Test.writeFile' function
 
-const testTypeNewMatcherFunctionDocString = `
-Creates a matcher with a test function.
-The test function is of type 'fun(T): Bool', where 'T' is bound to 'AnyStruct'.
+const testTypeWriteFileFunctionDocString = `
+Write the given content to a local file.
+The path must be relative to, and stay within, the test root.
 `
 
-const testTypeNewMatcherFunctionName = "newMatcher"
+const testTypeWriteFileFunctionName = "writeFile"
 
-func newTestTypeNewMatcherFunctionType(matcherType *sema.CompositeType) *sema.FunctionType {
-	typeParameter := &sema.TypeParameter{
-		TypeBound: sema.AnyStructType,
-		Name:      "T",
-		Optional:  true,
-	}
+var testTypeWriteFileFunctionType = &sema.FunctionType{
+	Parameters: []sema.Parameter{
+		{
+			Identifier:     "path",
+			TypeAnnotation: sema.StringTypeAnnotation,
+		},
+		{
+			Identifier:     "content",
+			TypeAnnotation: sema.StringTypeAnnotation,
+		},
+	},
+	ReturnTypeAnnotation: sema.VoidTypeAnnotation,
+}
 
-	return &sema.FunctionType{
-		Purity: sema.FunctionPurityView,
-		TypeParameters: []*sema.TypeParameter{
-			typeParameter,
+func newTestTypeWriteFileFunction(
+	testFramework TestFramework,
+	inter *interpreter.Interpreter,
+	testContractValue *interpreter.CompositeValue,
+) interpreter.BoundFunctionValue {
+	return interpreter.NewUnmeteredBoundHostFunctionValue(
+		inter,
+		testContractValue,
+		testTypeWriteFileFunctionType,
+		func(invocation interpreter.Invocation) interpreter.Value {
+			pathString, ok := invocation.Arguments[0].(*interpreter.StringValue)
+			if !ok {
+				panic(errors.NewUnreachableError())
+			}
+
+			contentString, ok := invocation.Arguments[1].(*interpreter.StringValue)
+			if !ok {
+				panic(errors.NewUnreachableError())
+			}
+
+			err := checkTestFilePath(pathString.Str)
+			if err != nil {
+				panic(err)
+			}
+
+			err = testFramework.WriteFile(pathString.Str, contentString.Str)
+			if err != nil {
+				panic(err)
+			}
+
+			return interpreter.Void
 		},
-		Parameters: []sema.Parameter{
-			{
-				Label:      sema.ArgumentLabelNotRequired,
-				Identifier: "test",
-				TypeAnnotation: sema.NewTypeAnnotation(
-					// Type of the 'test' function: fun(T): Bool
-					&sema.FunctionType{
-						Parameters: []sema.Parameter{
-							{
-								Label:      sema.ArgumentLabelNotRequired,
-								Identifier: "value",
-								TypeAnnotation: sema.NewTypeAnnotation(
-									&sema.GenericType{
-										TypeParameter: typeParameter,
-									},
-								),
-							},
-						},
-						ReturnTypeAnnotation: sema.BoolTypeAnnotation,
-					},
-				),
-			},
+	)
+}
+
+// 'Test.listFiles' function
+
+const testTypeListFilesFunctionDocString = `
+List the names of the files in a local directory.
+The path must be relative to, and stay within, the test root.
+`
+
+const testTypeListFilesFunctionName = "listFiles"
+
+var testTypeListFilesFunctionType = &sema.FunctionType{
+	Parameters: []sema.Parameter{
+		{
+			Identifier:     "directory",
+			TypeAnnotation: sema.StringTypeAnnotation,
 		},
-		ReturnTypeAnnotation: sema.NewTypeAnnotation(matcherType),
-	}
+	},
+	ReturnTypeAnnotation: sema.NewTypeAnnotation(
+		&sema.VariableSizedType{
+			Type: sema.StringType,
+		},
+	),
 }
 
-func newTestTypeNewMatcherFunction(
-	newMatcherFunctionType *sema.FunctionType,
-	matcherTestFunctionType *sema.FunctionType,
-) testContractBoundFunctionGenerator {
-	return func(inter *interpreter.Interpreter, testContractValue *interpreter.CompositeValue) interpreter.BoundFunctionValue {
-		return interpreter.NewUnmeteredBoundHostFunctionValue(
-			inter,
-			testContractValue,
-			newMatcherFunctionType,
-			func(invocation interpreter.Invocation) interpreter.Value {
-				test, ok := invocation.Arguments[0].(interpreter.FunctionValue)
-				if !ok {
-					panic(errors.NewUnreachableError())
-				}
+func newTestTypeListFilesFunction(
+	testFramework TestFramework,
+	inter *interpreter.Interpreter,
+	testContractValue *interpreter.CompositeValue,
+) interpreter.BoundFunctionValue {
+	return interpreter.NewUnmeteredBoundHostFunctionValue(
+		inter,
+		testContractValue,
+		testTypeListFilesFunctionType,
+		func(invocation interpreter.Invocation) interpreter.Value {
+			directoryString, ok := invocation.Arguments[0].(*interpreter.StringValue)
+			if !ok {
+				panic(errors.NewUnreachableError())
+			}
 
-				return newMatcherWithGenericTestFunction(
-					invocation,
-					test,
-					matcherTestFunctionType,
+			err := checkTestFilePath(directoryString.Str)
+			if err != nil {
+				panic(err)
+			}
+
+			fileNames, err := testFramework.ListFiles(directoryString.Str)
+			if err != nil {
+				panic(err)
+			}
+
+			fileNameValues := make([]interpreter.Value, 0, len(fileNames))
+			for _, fileName := range fileNames {
+				fileNameValues = append(
+					fileNameValues,
+					interpreter.NewUnmeteredStringValue(fileName),
 				)
-			},
-		)
-	}
+			}
+
+			return interpreter.NewArrayValue(
+				invocation.Interpreter,
+				invocation.LocationRange,
+				interpreter.NewVariableSizedStaticType(
+					invocation.Interpreter,
+					interpreter.PrimitiveStaticTypeString,
+				),
+				common.ZeroAddress,
+				fileNameValues...,
+			)
+		},
+	)
 }
 
-// `Test.equal`
+// checkTestFilePath ensures that the given path is relative,
+// and does not escape the test root through '..' segments
+func checkTestFilePath(path string) error {
+	if filepath.IsAbs(path) || strings.HasPrefix(path, "/") {
+		return errors.NewDefaultUserError("path must be relative to the test root: %s", path)
+	}
 
-const testTypeEqualFunctionName = "equal"
+	segments := strings.FieldsFunc(path, func(r rune) bool {
+		return r == '/' || r == '\\'
+	})
+	for _, segment := range segments {
+		if segment == ".." {
+			return errors.NewDefaultUserError("path must not escape the test root: %s", path)
+		}
+	}
 
-const testTypeEqualFunctionDocString = `
-Returns a matcher that succeeds if the tested value is equal to the given value.
+	return nil
+}
+
+// 'Test.createKey' function
+
+const testTypeCreateKeyFunctionDocString = `
+Creates a new private key for the given signature algorithm.
 `
 
-func newTestTypeEqualFunctionType(matcherType *sema.CompositeType) *sema.FunctionType {
-	typeParameter := &sema.TypeParameter{
-		TypeBound: sema.AnyStructType,
-		Name:      "T",
-		Optional:  true,
-	}
+const testTypeCreateKeyFunctionName = "createKey"
 
+func newTestTypeCreateKeyFunctionType(privateKeyType *sema.CompositeType) *sema.FunctionType {
 	return &sema.FunctionType{
-		Purity: sema.FunctionPurityView,
-		TypeParameters: []*sema.TypeParameter{
-			typeParameter,
-		},
 		Parameters: []sema.Parameter{
 			{
-				Label:      sema.ArgumentLabelNotRequired,
-				Identifier: "value",
-				TypeAnnotation: sema.NewTypeAnnotation(
-					&sema.GenericType{
-						TypeParameter: typeParameter,
-					},
-				),
+				Identifier:     "signatureAlgorithm",
+				TypeAnnotation: sema.SignatureAlgorithmTypeAnnotation,
 			},
 		},
-		ReturnTypeAnnotation: sema.NewTypeAnnotation(matcherType),
+		ReturnTypeAnnotation: sema.NewTypeAnnotation(privateKeyType),
 	}
 }
 
-func newTestTypeEqualFunction(
-	equalFunctionType *sema.FunctionType,
-	matcherTestFunctionType *sema.FunctionType,
-) testContractBoundFunctionGenerator {
-	return func(inter *interpreter.Interpreter, testContractValue *interpreter.CompositeValue) interpreter.BoundFunctionValue {
-		return interpreter.NewUnmeteredBoundHostFunctionValue(
-			inter,
-			testContractValue,
-			equalFunctionType,
+func newTestTypeCreateKeyFunction(
+	testFramework TestFramework,
+	functionType *sema.FunctionType,
+	inter *interpreter.Interpreter,
+	testContractValue *interpreter.CompositeValue,
+) interpreter.BoundFunctionValue {
+	return interpreter.NewUnmeteredBoundHostFunctionValue(
+		inter,
+		testContractValue,
+		functionType,
+		func(invocation interpreter.Invocation) interpreter.Value {
+			inter := invocation.Interpreter
+			locationRange := invocation.LocationRange
+
+			signatureAlgorithm := signatureAlgorithmFromValue(
+				inter,
+				locationRange,
+				invocation.Arguments[0],
+			)
+
+			privateKey, err := testFramework.CreateKey(signatureAlgorithm)
+			if err != nil {
+				panic(err)
+			}
+
+			return newPrivateKeyValue(
+				inter,
+				locationRange,
+				*invocation.Self,
+				privateKey,
+			)
+		},
+	)
+}
+
+// 'Test.sign' function
+
+const testTypeSignFunctionDocString = `
+Signs the given message with the given private key.
+The signature can be verified using the 'verify' function of the key's public key,
+with the same domain separation tag and hash algorithm.
+`
+
+const testTypeSignFunctionName = "sign"
+
+func newTestTypeSignFunctionType(privateKeyType *sema.CompositeType) *sema.FunctionType {
+	return &sema.FunctionType{
+		Parameters: []sema.Parameter{
+			{
+				Label:          sema.ArgumentLabelNotRequired,
+				Identifier:     "key",
+				TypeAnnotation: sema.NewTypeAnnotation(privateKeyType),
+			},
+			{
+				Identifier:     "message",
+				TypeAnnotation: sema.ByteArrayTypeAnnotation,
+			},
+			{
+				Identifier:     "domainSeparationTag",
+				TypeAnnotation: sema.StringTypeAnnotation,
+			},
+			{
+				Identifier:     "hashAlgorithm",
+				TypeAnnotation: sema.HashAlgorithmTypeAnnotation,
+			},
+		},
+		ReturnTypeAnnotation: sema.ByteArrayTypeAnnotation,
+	}
+}
+
+func newTestTypeSignFunction(
+	testFramework TestFramework,
+	functionType *sema.FunctionType,
+	inter *interpreter.Interpreter,
+	testContractValue *interpreter.CompositeValue,
+) interpreter.BoundFunctionValue {
+	return interpreter.NewUnmeteredBoundHostFunctionValue(
+		inter,
+		testContractValue,
+		functionType,
+		func(invocation interpreter.Invocation) interpreter.Value {
+			inter := invocation.Interpreter
+			locationRange := invocation.LocationRange
+
+			privateKeyValue, ok := invocation.Arguments[0].(interpreter.MemberAccessibleValue)
+			if !ok {
+				panic(errors.NewUnreachableError())
+			}
+
+			privateKey := privateKeyFromValue(inter, locationRange, privateKeyValue)
+
+			message, err := interpreter.ByteArrayValueToByteSlice(
+				inter,
+				invocation.Arguments[1],
+				locationRange,
+			)
+			if err != nil {
+				panic(err)
+			}
+
+			tagValue, ok := invocation.Arguments[2].(*interpreter.StringValue)
+			if !ok {
+				panic(errors.NewUnreachableError())
+			}
+
+			hashAlgorithm := NewHashAlgorithmFromValue(
+				inter,
+				locationRange,
+				invocation.Arguments[3],
+			)
+
+			signature, err := testFramework.Sign(
+				privateKey,
+				message,
+				tagValue.Str,
+				hashAlgorithm,
+			)
+			if err != nil {
+				panic(err)
+			}
+
+			return interpreter.ByteSliceToByteArrayValue(inter, signature)
+		},
+	)
+}
+
+// 'Test.NewMatcher' function.
+// Constructs a matcher that test only 'AnyStruct'.
+// Accepts test function that accepts subtype of 'AnyStruct'.
+//
+// Signature:
+//    fun newMatcher<T: AnyStruct>(test: fun(T): Bool): Test.Matcher
+//
+// where `T` is optional, and bound to `AnyStruct`.
+//
+// Sample usage: `Test.newMatcher(fun (_ value: Int: Bool) { return true })`
+
+const testTypeNewMatcherFunctionDocString = `
+Creates a matcher with a test function.
+The test function is of type 'fun(T): Bool', where 'T' is bound to 'AnyStruct'.
+`
+
+const testTypeNewMatcherFunctionName = "newMatcher"
+
+func newTestTypeNewMatcherFunctionType(matcherType *sema.CompositeType) *sema.FunctionType {
+	typeParameter := &sema.TypeParameter{
+		TypeBound: sema.AnyStructType,
+		Name:      "T",
+		Optional:  true,
+	}
+
+	return &sema.FunctionType{
+		Purity: sema.FunctionPurityView,
+		TypeParameters: []*sema.TypeParameter{
+			typeParameter,
+		},
+		Parameters: []sema.Parameter{
+			{
+				Label:      sema.ArgumentLabelNotRequired,
+				Identifier: "test",
+				TypeAnnotation: sema.NewTypeAnnotation(
+					// Type of the 'test' function: fun(T): Bool
+					&sema.FunctionType{
+						Parameters: []sema.Parameter{
+							{
+								Label:      sema.ArgumentLabelNotRequired,
+								Identifier: "value",
+								TypeAnnotation: sema.NewTypeAnnotation(
+									&sema.GenericType{
+										TypeParameter: typeParameter,
+									},
+								),
+							},
+						},
+						ReturnTypeAnnotation: sema.BoolTypeAnnotation,
+					},
+				),
+			},
+		},
+		ReturnTypeAnnotation: sema.NewTypeAnnotation(matcherType),
+	}
+}
+
+func newTestTypeNewMatcherFunction(
+	newMatcherFunctionType *sema.FunctionType,
+	matcherTestFunctionType *sema.FunctionType,
+) testContractBoundFunctionGenerator {
+	return func(inter *interpreter.Interpreter, testContractValue *interpreter.CompositeValue) interpreter.BoundFunctionValue {
+		return interpreter.NewUnmeteredBoundHostFunctionValue(
+			inter,
+			testContractValue,
+			newMatcherFunctionType,
+			func(invocation interpreter.Invocation) interpreter.Value {
+				test, ok := invocation.Arguments[0].(interpreter.FunctionValue)
+				if !ok {
+					panic(errors.NewUnreachableError())
+				}
+
+				return newMatcherWithGenericTestFunction(
+					invocation,
+					test,
+					matcherTestFunctionType,
+				)
+			},
+		)
+	}
+}
+
+// `Test.satisfy`
+
+const testTypeSatisfyFunctionName = "satisfy"
+
+const testTypeSatisfyFunctionDocString = `
+Returns a matcher that succeeds if the given predicate returns true for the tested value.
+The predicate is of type 'fun(T): Bool', where 'T' is bound to 'AnyStruct'.
+`
+
+func newTestTypeSatisfyFunctionType(matcherType *sema.CompositeType) *sema.FunctionType {
+	typeParameter := &sema.TypeParameter{
+		TypeBound: sema.AnyStructType,
+		Name:      "T",
+		Optional:  true,
+	}
+
+	return &sema.FunctionType{
+		Purity: sema.FunctionPurityView,
+		TypeParameters: []*sema.TypeParameter{
+			typeParameter,
+		},
+		Parameters: []sema.Parameter{
+			{
+				Label:      sema.ArgumentLabelNotRequired,
+				Identifier: "predicate",
+				TypeAnnotation: sema.NewTypeAnnotation(
+					// Type of the 'predicate' function: fun(T): Bool
+					&sema.FunctionType{
+						Parameters: []sema.Parameter{
+							{
+								Label:      sema.ArgumentLabelNotRequired,
+								Identifier: "value",
+								TypeAnnotation: sema.NewTypeAnnotation(
+									&sema.GenericType{
+										TypeParameter: typeParameter,
+									},
+								),
+							},
+						},
+						ReturnTypeAnnotation: sema.BoolTypeAnnotation,
+					},
+				),
+			},
+		},
+		ReturnTypeAnnotation: sema.NewTypeAnnotation(matcherType),
+	}
+}
+
+func newTestTypeSatisfyFunction(
+	satisfyFunctionType *sema.FunctionType,
+	matcherTestFunctionType *sema.FunctionType,
+) testContractBoundFunctionGenerator {
+	return func(inter *interpreter.Interpreter, testContractValue *interpreter.CompositeValue) interpreter.BoundFunctionValue {
+		return interpreter.NewUnmeteredBoundHostFunctionValue(
+			inter,
+			testContractValue,
+			satisfyFunctionType,
+			func(invocation interpreter.Invocation) interpreter.Value {
+				predicate, ok := invocation.Arguments[0].(interpreter.FunctionValue)
+				if !ok {
+					panic(errors.NewUnreachableError())
+				}
+
+				matcher := newMatcherWithGenericTestFunction(
+					invocation,
+					predicate,
+					matcherTestFunctionType,
+				)
+
+				return withMatcherDescription(
+					invocation,
+					matcher,
+					func(value interpreter.Value) string {
+						return fmt.Sprintf("expected %s to satisfy the predicate", value)
+					},
+				)
+			},
+		)
+	}
+}
+
+// `Test.equal`
+
+const testTypeEqualFunctionName = "equal"
+
+const testTypeEqualFunctionDocString = `
+Returns a matcher that succeeds if the tested value is equal to the given value.
+`
+
+func newTestTypeEqualFunctionType(matcherType *sema.CompositeType) *sema.FunctionType {
+	typeParameter := &sema.TypeParameter{
+		TypeBound: sema.AnyStructType,
+		Name:      "T",
+		Optional:  true,
+	}
+
+	return &sema.FunctionType{
+		Purity: sema.FunctionPurityView,
+		TypeParameters: []*sema.TypeParameter{
+			typeParameter,
+		},
+		Parameters: []sema.Parameter{
+			{
+				Label:      sema.ArgumentLabelNotRequired,
+				Identifier: "value",
+				TypeAnnotation: sema.NewTypeAnnotation(
+					&sema.GenericType{
+						TypeParameter: typeParameter,
+					},
+				),
+			},
+		},
+		ReturnTypeAnnotation: sema.NewTypeAnnotation(matcherType),
+	}
+}
+
+func newTestTypeEqualFunction(
+	equalFunctionType *sema.FunctionType,
+	matcherTestFunctionType *sema.FunctionType,
+) testContractBoundFunctionGenerator {
+	return func(inter *interpreter.Interpreter, testContractValue *interpreter.CompositeValue) interpreter.BoundFunctionValue {
+		return interpreter.NewUnmeteredBoundHostFunctionValue(
+			inter,
+			testContractValue,
+			equalFunctionType,
+			func(invocation interpreter.Invocation) interpreter.Value {
+				otherValue, ok := invocation.Arguments[0].(interpreter.EquatableValue)
+				if !ok {
+					panic(errors.NewUnreachableError())
+				}
+
+				inter := invocation.Interpreter
+
+				// This is a static function.
+				equalTestFunc := interpreter.NewStaticHostFunctionValue(
+					invocation.Interpreter,
+					matcherTestFunctionType,
+					func(invocation interpreter.Invocation) interpreter.Value {
+
+						thisValue, ok := invocation.Arguments[0].(interpreter.EquatableValue)
+						if !ok {
+							panic(errors.NewUnreachableError())
+						}
+
+						equal := thisValue.Equal(
+							inter,
+							invocation.LocationRange,
+							otherValue,
+						)
+
+						return interpreter.AsBoolValue(equal)
+					},
+				)
+
+				matcher := newMatcherWithGenericTestFunction(
+					invocation,
+					equalTestFunc,
+					matcherTestFunctionType,
+				)
+
+				return withMatcherDescription(
+					invocation,
+					matcher,
+					func(value interpreter.Value) string {
+						return fmt.Sprintf("expected %s to equal %s", value, otherValue)
+					},
+				)
+			},
+		)
+	}
+}
+
+// `Test.anyOf` and `Test.allOf`
+
+const testTypeAnyOfFunctionName = "anyOf"
+
+const testTypeAnyOfFunctionDocString = `
+Returns a matcher that succeeds if any of the given matchers succeeds.
+The matchers are tested in order, and testing stops at the first matcher that succeeds.
+If no matchers are given, the matcher never succeeds.
+`
+
+const testTypeAllOfFunctionName = "allOf"
+
+const testTypeAllOfFunctionDocString = `
+Returns a matcher that succeeds if all of the given matchers succeed.
+The matchers are tested in order, and testing stops at the first matcher that fails.
+If no matchers are given, the matcher always succeeds.
+`
+
+func newTestTypeMatcherFoldFunctionType(matcherType *sema.CompositeType) *sema.FunctionType {
+	return &sema.FunctionType{
+		Purity: sema.FunctionPurityView,
+		Parameters: []sema.Parameter{
+			{
+				Label:      sema.ArgumentLabelNotRequired,
+				Identifier: "matchers",
+				TypeAnnotation: sema.NewTypeAnnotation(
+					&sema.VariableSizedType{
+						Type: matcherType,
+					},
+				),
+			},
+		},
+		ReturnTypeAnnotation: sema.NewTypeAnnotation(matcherType),
+	}
+}
+
+// newTestTypeMatcherFoldFunction returns a function which folds the given matchers into a single matcher.
+// Testing stops at the first matcher which returns the short-circuit result,
+// and the combined matcher then returns it.
+// If no matcher returns the short-circuit result, the combined matcher returns its negation,
+// i.e. `anyOf` short-circuits on true, and `allOf` short-circuits on false
+func newTestTypeMatcherFoldFunction(
+	foldFunctionType *sema.FunctionType,
+	matcherTestFunctionType *sema.FunctionType,
+	shortCircuitResult bool,
+	description string,
+) testContractBoundFunctionGenerator {
+	return func(inter *interpreter.Interpreter, testContractValue *interpreter.CompositeValue) interpreter.BoundFunctionValue {
+		return interpreter.NewUnmeteredBoundHostFunctionValue(
+			inter,
+			testContractValue,
+			foldFunctionType,
+			func(invocation interpreter.Invocation) interpreter.Value {
+				matcherValues, err := arrayValueToSlice(
+					invocation.Interpreter,
+					invocation.Arguments[0],
+					invocation.LocationRange,
+				)
+				if err != nil {
+					panic(err)
+				}
+
+				matchers := make([]interpreter.MemberAccessibleValue, 0, len(matcherValues))
+				for _, matcherValue := range matcherValues {
+					matcher, ok := matcherValue.(interpreter.MemberAccessibleValue)
+					if !ok {
+						panic(errors.NewUnreachableError())
+					}
+					matchers = append(matchers, matcher)
+				}
+
+				// This is a static function.
+				// The argument types were already validated by the individual matchers,
+				// so the combined matcher accepts any value.
+				foldTestFunc := interpreter.NewStaticHostFunctionValue(
+					invocation.Interpreter,
+					matcherTestFunctionType,
+					func(invocation interpreter.Invocation) interpreter.Value {
+						value := invocation.Arguments[0]
+
+						for _, matcher := range matchers {
+							matched := invokeMatcherTest(
+								invocation.Interpreter,
+								matcher,
+								value,
+								invocation.LocationRange,
+							)
+							if matched == shortCircuitResult {
+								return interpreter.AsBoolValue(shortCircuitResult)
+							}
+						}
+
+						return interpreter.AsBoolValue(!shortCircuitResult)
+					},
+				)
+
+				matcher := newMatcherWithAnyStructTestFunction(
+					invocation,
+					foldTestFunc,
+				)
+
+				return withMatcherDescription(
+					invocation,
+					matcher,
+					func(value interpreter.Value) string {
+						return fmt.Sprintf("expected %s to match %s", value, description)
+					},
+				)
+			},
+		)
+	}
+}
+
+// `Test.beEmpty`
+
+const testTypeBeEmptyFunctionName = "beEmpty"
+
+const testTypeBeEmptyFunctionDocString = `
+Returns a matcher that succeeds if the tested value is an array, dictionary, or string,
+and the tested value contains no elements or characters.
+`
+
+func newTestTypeBeEmptyFunctionType(matcherType *sema.CompositeType) *sema.FunctionType {
+	return &sema.FunctionType{
+		Purity:               sema.FunctionPurityView,
+		ReturnTypeAnnotation: sema.NewTypeAnnotation(matcherType),
+	}
+}
+
+func newTestTypeBeEmptyFunction(
+	beEmptyFunctionType *sema.FunctionType,
+	matcherTestFunctionType *sema.FunctionType,
+) testContractBoundFunctionGenerator {
+	return func(inter *interpreter.Interpreter, testContractValue *interpreter.CompositeValue) interpreter.BoundFunctionValue {
+		return interpreter.NewUnmeteredBoundHostFunctionValue(
+			inter,
+			testContractValue,
+			beEmptyFunctionType,
+			func(invocation interpreter.Invocation) interpreter.Value {
+
+				// This is a static function.
+				beEmptyTestFunc := interpreter.NewStaticHostFunctionValue(
+					invocation.Interpreter,
+					matcherTestFunctionType,
+					func(invocation interpreter.Invocation) interpreter.Value {
+						var isEmpty bool
+						switch value := invocation.Arguments[0].(type) {
+						case *interpreter.ArrayValue:
+							isEmpty = value.Count() == 0
+						case *interpreter.DictionaryValue:
+							isEmpty = value.Count() == 0
+						case *interpreter.StringValue:
+							isEmpty = value.Length() == 0
+						case interpreter.CharacterValue:
+							// A character always consists of exactly one grapheme
+							isEmpty = false
+						default:
+							panic(errors.NewDefaultUserError("expected Array, Dictionary, String, or Character argument"))
+						}
+
+						return interpreter.AsBoolValue(isEmpty)
+					},
+				)
+
+				matcher := newMatcherWithAnyStructTestFunction(
+					invocation,
+					beEmptyTestFunc,
+				)
+
+				return withMatcherDescription(
+					invocation,
+					matcher,
+					func(value interpreter.Value) string {
+						return fmt.Sprintf(
+							"expected %s to be empty",
+							describedValueString(invocation.Interpreter, invocation.LocationRange, value),
+						)
+					},
+				)
+			},
+		)
+	}
+}
+
+// `Test.haveElementCount`
+
+const testTypeHaveElementCountFunctionName = "haveElementCount"
+
+const testTypeHaveElementCountFunctionDocString = `
+Returns a matcher that succeeds if the tested value is an array or dictionary,
+and has the given number of elements.
+`
+
+func newTestTypeHaveElementCountFunctionType(matcherType *sema.CompositeType) *sema.FunctionType {
+	return &sema.FunctionType{
+		Purity: sema.FunctionPurityView,
+		Parameters: []sema.Parameter{
+			{
+				Label:          sema.ArgumentLabelNotRequired,
+				Identifier:     "count",
+				TypeAnnotation: sema.IntTypeAnnotation,
+			},
+		},
+		ReturnTypeAnnotation: sema.NewTypeAnnotation(matcherType),
+	}
+}
+
+func newTestTypeHaveElementCountFunction(
+	haveElementCountFunctionType *sema.FunctionType,
+	matcherTestFunctionType *sema.FunctionType,
+) testContractBoundFunctionGenerator {
+	return func(inter *interpreter.Interpreter, testContractValue *interpreter.CompositeValue) interpreter.BoundFunctionValue {
+		return interpreter.NewUnmeteredBoundHostFunctionValue(
+			inter,
+			testContractValue,
+			haveElementCountFunctionType,
+			func(invocation interpreter.Invocation) interpreter.Value {
+				count, ok := invocation.Arguments[0].(interpreter.IntValue)
+				if !ok {
+					panic(errors.NewUnreachableError())
+				}
+
+				// This is a static function.
+				haveElementCountTestFunc := interpreter.NewStaticHostFunctionValue(
+					invocation.Interpreter,
+					matcherTestFunctionType,
+					func(invocation interpreter.Invocation) interpreter.Value {
+						var matchingCount bool
+						switch value := invocation.Arguments[0].(type) {
+						case *interpreter.ArrayValue:
+							matchingCount = value.Count() == count.ToInt(invocation.LocationRange)
+						case *interpreter.DictionaryValue:
+							matchingCount = value.Count() == count.ToInt(invocation.LocationRange)
+						default:
+							panic(errors.NewDefaultUserError("expected Array or Dictionary argument"))
+						}
+
+						return interpreter.AsBoolValue(matchingCount)
+					},
+				)
+
+				matcher := newMatcherWithAnyStructTestFunction(
+					invocation,
+					haveElementCountTestFunc,
+				)
+
+				return withMatcherDescription(
+					invocation,
+					matcher,
+					func(value interpreter.Value) string {
+						return fmt.Sprintf(
+							"expected %s to have %s elements",
+							describedValueString(invocation.Interpreter, invocation.LocationRange, value),
+							count,
+						)
+					},
+				)
+			},
+		)
+	}
+}
+
+// `Test.contain` and `Test.notContain`
+
+const testTypeContainFunctionName = "contain"
+
+const testTypeContainFunctionDocString = `
+Returns a matcher that succeeds if the tested value is an array that contains
+a value that is equal to the given value, or the tested value is a dictionary
+that contains an entry where the key is equal to the given value.
+`
+
+const testTypeNotContainFunctionName = "notContain"
+
+const testTypeNotContainFunctionDocString = `
+Returns a matcher that succeeds if the tested value is an array that does not contain
+a value that is equal to the given value, or the tested value is a dictionary
+that does not contain an entry where the key is equal to the given value.
+`
+
+func newTestTypeContainFunctionType(matcherType *sema.CompositeType) *sema.FunctionType {
+	return &sema.FunctionType{
+		Purity: sema.FunctionPurityView,
+		Parameters: []sema.Parameter{
+			{
+				Label:          sema.ArgumentLabelNotRequired,
+				Identifier:     "element",
+				TypeAnnotation: sema.AnyStructTypeAnnotation,
+			},
+		},
+		ReturnTypeAnnotation: sema.NewTypeAnnotation(matcherType),
+	}
+}
+
+func newTestTypeContainFunction(
+	containFunctionType *sema.FunctionType,
+	matcherTestFunctionType *sema.FunctionType,
+	negate bool,
+) testContractBoundFunctionGenerator {
+	return func(inter *interpreter.Interpreter, testContractValue *interpreter.CompositeValue) interpreter.BoundFunctionValue {
+		return interpreter.NewUnmeteredBoundHostFunctionValue(
+			inter,
+			testContractValue,
+			containFunctionType,
+			func(invocation interpreter.Invocation) interpreter.Value {
+				element, ok := invocation.Arguments[0].(interpreter.EquatableValue)
+				if !ok {
+					panic(errors.NewUnreachableError())
+				}
+
+				inter := invocation.Interpreter
+
+				// This is a static function.
+				containTestFunc := interpreter.NewStaticHostFunctionValue(
+					invocation.Interpreter,
+					matcherTestFunctionType,
+					func(invocation interpreter.Invocation) interpreter.Value {
+						var elementFound interpreter.BoolValue
+						switch value := invocation.Arguments[0].(type) {
+						case *interpreter.ArrayValue:
+							elementFound = value.Contains(
+								inter,
+								invocation.LocationRange,
+								element,
+							)
+						case *interpreter.DictionaryValue:
+							elementFound = value.ContainsKey(
+								inter,
+								invocation.LocationRange,
+								element,
+							)
+						default:
+							panic(errors.NewDefaultUserError("expected Array or Dictionary argument"))
+						}
+
+						if negate {
+							return !elementFound
+						}
+
+						return elementFound
+					},
+				)
+
+				matcher := newMatcherWithAnyStructTestFunction(
+					invocation,
+					containTestFunc,
+				)
+
+				return withMatcherDescription(
+					invocation,
+					matcher,
+					func(value interpreter.Value) string {
+						valueString := describedValueString(inter, invocation.LocationRange, value)
+						if negate {
+							return fmt.Sprintf("expected %s to not contain %s", valueString, element)
+						}
+						return fmt.Sprintf("expected %s to contain %s", valueString, element)
+					},
+				)
+			},
+		)
+	}
+}
+
+// `Test.beGreaterThan`
+
+const testTypeBeGreaterThanFunctionName = "beGreaterThan"
+
+const testTypeBeGreaterThanFunctionDocString = `
+Returns a matcher that succeeds if the tested value is a number and
+greater than the given number.
+`
+
+func newTestTypeBeGreaterThanFunctionType(matcherType *sema.CompositeType) *sema.FunctionType {
+	return &sema.FunctionType{
+		Purity: sema.FunctionPurityView,
+		Parameters: []sema.Parameter{
+			{
+				Label:          sema.ArgumentLabelNotRequired,
+				Identifier:     "value",
+				TypeAnnotation: sema.NumberTypeAnnotation,
+			},
+		},
+		ReturnTypeAnnotation: sema.NewTypeAnnotation(matcherType),
+	}
+}
+
+func newTestTypeBeGreaterThanFunction(
+	beGreaterThanFunctionType *sema.FunctionType,
+	matcherTestFunctionType *sema.FunctionType,
+) testContractBoundFunctionGenerator {
+	return func(inter *interpreter.Interpreter, testContractValue *interpreter.CompositeValue) interpreter.BoundFunctionValue {
+		return interpreter.NewUnmeteredBoundHostFunctionValue(
+			inter,
+			testContractValue,
+			beGreaterThanFunctionType,
+			func(invocation interpreter.Invocation) interpreter.Value {
+				otherValue, ok := invocation.Arguments[0].(interpreter.NumberValue)
+				if !ok {
+					panic(errors.NewUnreachableError())
+				}
+
+				inter := invocation.Interpreter
+
+				// This is a static function.
+				beGreaterThanTestFunc := interpreter.NewStaticHostFunctionValue(
+					invocation.Interpreter,
+					matcherTestFunctionType,
+					func(invocation interpreter.Invocation) interpreter.Value {
+						thisValue, ok := invocation.Arguments[0].(interpreter.NumberValue)
+						if !ok {
+							panic(errors.NewUnreachableError())
+						}
+
+						isGreaterThan := thisValue.Greater(
+							inter,
+							otherValue,
+							invocation.LocationRange,
+						)
+
+						return isGreaterThan
+					},
+				)
+
+				matcher := newMatcherWithAnyStructTestFunction(
+					invocation,
+					beGreaterThanTestFunc,
+				)
+
+				return withMatcherDescription(
+					invocation,
+					matcher,
+					func(value interpreter.Value) string {
+						return fmt.Sprintf("expected %s to be greater than %s", value, otherValue)
+					},
+				)
+			},
+		)
+	}
+}
+
+// `Test.beLessThan`
+
+const testTypeBeLessThanFunctionName = "beLessThan"
+
+const testTypeBeLessThanFunctionDocString = `
+Returns a matcher that succeeds if the tested value is a number and
+less than the given number.
+`
+
+func newTestTypeBeLessThanFunctionType(matcherType *sema.CompositeType) *sema.FunctionType {
+	return &sema.FunctionType{
+		Purity: sema.FunctionPurityView,
+		Parameters: []sema.Parameter{
+			{
+				Label:          sema.ArgumentLabelNotRequired,
+				Identifier:     "value",
+				TypeAnnotation: sema.NumberTypeAnnotation,
+			},
+		},
+		ReturnTypeAnnotation: sema.NewTypeAnnotation(matcherType),
+	}
+}
+
+// Test.expectFailure function
+
+const testExpectFailureFunctionName = "expectFailure"
+
+const testExpectFailureFunctionDocString = `
+Wraps a function call in a closure, and expects it to fail with
+an error message that contains the given error message portion.
+`
+
+func newTestTypeExpectFailureFunctionType() *sema.FunctionType {
+	return &sema.FunctionType{
+		Parameters: []sema.Parameter{
+			{
+				Label:      sema.ArgumentLabelNotRequired,
+				Identifier: "functionWrapper",
+				TypeAnnotation: sema.NewTypeAnnotation(
+					&sema.FunctionType{
+						ReturnTypeAnnotation: sema.VoidTypeAnnotation,
+					},
+				),
+			},
+			{
+				Identifier:     "errorMessageSubstring",
+				TypeAnnotation: sema.StringTypeAnnotation,
+			},
+		},
+		ReturnTypeAnnotation: sema.VoidTypeAnnotation,
+	}
+}
+
+func newTestTypeExpectFailureFunction(
+	testExpectFailureFunctionType *sema.FunctionType,
+) testContractBoundFunctionGenerator {
+	return func(inter *interpreter.Interpreter, testContractValue *interpreter.CompositeValue) interpreter.BoundFunctionValue {
+		return interpreter.NewUnmeteredBoundHostFunctionValue(
+			inter,
+			testContractValue,
+			testExpectFailureFunctionType,
 			func(invocation interpreter.Invocation) interpreter.Value {
-				otherValue, ok := invocation.Arguments[0].(interpreter.EquatableValue)
+				inter := invocation.Interpreter
+				functionValue, ok := invocation.Arguments[0].(interpreter.FunctionValue)
+				if !ok {
+					panic(errors.NewUnreachableError())
+				}
+				functionType := functionValue.FunctionType()
+
+				errorMessage, ok := invocation.Arguments[1].(*interpreter.StringValue)
+				if !ok {
+					panic(errors.NewUnreachableError())
+				}
+
+				failedAsExpected := true
+
+				defer inter.RecoverErrors(func(internalErr error) {
+					if !failedAsExpected {
+						panic(internalErr)
+					} else if !strings.Contains(internalErr.Error(), errorMessage.Str) {
+						msg := fmt.Sprintf(
+							"Expected error message to include: %s. Actual error: %s",
+							errorMessage,
+							internalErr.Error(),
+						)
+						panic(
+							errors.NewDefaultUserError(msg),
+						)
+					}
+				})
+
+				_, err := inter.InvokeExternally(
+					functionValue,
+					functionType,
+					nil,
+				)
+				if err == nil {
+					failedAsExpected = false
+					panic(errors.NewDefaultUserError("Expected a failure, but found none."))
+				}
+
+				return interpreter.Void
+			},
+		)
+	}
+}
+
+func newTestTypeBeLessThanFunction(
+	beLessThanFunctionType *sema.FunctionType,
+	matcherTestFunctionType *sema.FunctionType,
+) testContractBoundFunctionGenerator {
+	return func(inter *interpreter.Interpreter, testContractValue *interpreter.CompositeValue) interpreter.BoundFunctionValue {
+		return interpreter.NewUnmeteredBoundHostFunctionValue(
+			inter,
+			testContractValue,
+			beLessThanFunctionType,
+			func(invocation interpreter.Invocation) interpreter.Value {
+				otherValue, ok := invocation.Arguments[0].(interpreter.NumberValue)
 				if !ok {
 					panic(errors.NewUnreachableError())
 				}
@@ -550,452 +1627,923 @@ func newTestTypeEqualFunction(
 				inter := invocation.Interpreter
 
 				// This is a static function.
-				equalTestFunc := interpreter.NewStaticHostFunctionValue(
-					nil,
+				beLessThanTestFunc := interpreter.NewStaticHostFunctionValue(
+					invocation.Interpreter,
 					matcherTestFunctionType,
 					func(invocation interpreter.Invocation) interpreter.Value {
-
-						thisValue, ok := invocation.Arguments[0].(interpreter.EquatableValue)
+						thisValue, ok := invocation.Arguments[0].(interpreter.NumberValue)
 						if !ok {
 							panic(errors.NewUnreachableError())
 						}
 
-						equal := thisValue.Equal(
+						isLessThan := thisValue.Less(
 							inter,
-							invocation.LocationRange,
 							otherValue,
+							invocation.LocationRange,
 						)
 
-						return interpreter.AsBoolValue(equal)
+						return isLessThan
 					},
 				)
 
-				return newMatcherWithGenericTestFunction(
+				matcher := newMatcherWithAnyStructTestFunction(
 					invocation,
-					equalTestFunc,
+					beLessThanTestFunc,
+				)
+
+				return withMatcherDescription(
+					invocation,
+					matcher,
+					func(value interpreter.Value) string {
+						return fmt.Sprintf("expected %s to be less than %s", value, otherValue)
+					},
+				)
+			},
+		)
+	}
+}
+
+// `Test.beInstanceOf`
+
+const testTypeBeInstanceOfFunctionName = "beInstanceOf"
+
+const testTypeBeInstanceOfFunctionDocString = `
+Returns a matcher that succeeds if the tested value is an instance of the given type,
+i.e. if the run-time type of the tested value is the given type, or a subtype of it.
+`
+
+func newTestTypeBeInstanceOfFunctionType(matcherType *sema.CompositeType) *sema.FunctionType {
+	return &sema.FunctionType{
+		Purity: sema.FunctionPurityView,
+		Parameters: []sema.Parameter{
+			{
+				Label:          sema.ArgumentLabelNotRequired,
+				Identifier:     "type",
+				TypeAnnotation: sema.MetaTypeAnnotation,
+			},
+		},
+		ReturnTypeAnnotation: sema.NewTypeAnnotation(matcherType),
+	}
+}
+
+func newTestTypeBeInstanceOfFunction(
+	beInstanceOfFunctionType *sema.FunctionType,
+	matcherTestFunctionType *sema.FunctionType,
+) testContractBoundFunctionGenerator {
+	return func(inter *interpreter.Interpreter, testContractValue *interpreter.CompositeValue) interpreter.BoundFunctionValue {
+		return interpreter.NewUnmeteredBoundHostFunctionValue(
+			inter,
+			testContractValue,
+			beInstanceOfFunctionType,
+			func(invocation interpreter.Invocation) interpreter.Value {
+				typeValue, ok := invocation.Arguments[0].(interpreter.TypeValue)
+				if !ok {
+					panic(errors.NewUnreachableError())
+				}
+
+				// This is a static function.
+				beInstanceOfTestFunc := interpreter.NewStaticHostFunctionValue(
+					invocation.Interpreter,
 					matcherTestFunctionType,
+					func(invocation interpreter.Invocation) interpreter.Value {
+						// The type is unknown, e.g. it could not be loaded
+						if typeValue.Type == nil {
+							return interpreter.FalseValue
+						}
+
+						inter := invocation.Interpreter
+
+						semaType := inter.MustConvertStaticToSemaType(typeValue.Type)
+						valueType := invocation.Arguments[0].StaticType(inter)
+
+						return interpreter.AsBoolValue(
+							inter.IsSubTypeOfSemaType(valueType, semaType),
+						)
+					},
+				)
+
+				matcher := newMatcherWithAnyStructTestFunction(
+					invocation,
+					beInstanceOfTestFunc,
+				)
+
+				return withMatcherDescription(
+					invocation,
+					matcher,
+					func(value interpreter.Value) string {
+						return fmt.Sprintf(
+							"expected %s to be an instance of %s",
+							describedValueString(invocation.Interpreter, invocation.LocationRange, value),
+							typeValue,
+						)
+					},
 				)
 			},
 		)
 	}
 }
 
-// `Test.beEmpty`
+// `Test.conformTo`
 
-const testTypeBeEmptyFunctionName = "beEmpty"
+const testTypeConformToFunctionName = "conformTo"
 
-const testTypeBeEmptyFunctionDocString = `
-Returns a matcher that succeeds if the tested value is an array or dictionary,
-and the tested value contains no elements.
+const testTypeConformToFunctionDocString = `
+Returns a matcher that succeeds if the tested value is a struct or resource,
+or a reference to one, and its run-time type conforms to the given interface type,
+e.g. Type<{I}>().
 `
 
-func newTestTypeBeEmptyFunctionType(matcherType *sema.CompositeType) *sema.FunctionType {
+func newTestTypeConformToFunctionType(matcherType *sema.CompositeType) *sema.FunctionType {
 	return &sema.FunctionType{
-		Purity:               sema.FunctionPurityView,
+		Purity: sema.FunctionPurityView,
+		Parameters: []sema.Parameter{
+			{
+				Label:          sema.ArgumentLabelNotRequired,
+				Identifier:     "type",
+				TypeAnnotation: sema.MetaTypeAnnotation,
+			},
+		},
 		ReturnTypeAnnotation: sema.NewTypeAnnotation(matcherType),
 	}
 }
 
-func newTestTypeBeEmptyFunction(
-	beEmptyFunctionType *sema.FunctionType,
+func newTestTypeConformToFunction(
+	conformToFunctionType *sema.FunctionType,
 	matcherTestFunctionType *sema.FunctionType,
 ) testContractBoundFunctionGenerator {
 	return func(inter *interpreter.Interpreter, testContractValue *interpreter.CompositeValue) interpreter.BoundFunctionValue {
 		return interpreter.NewUnmeteredBoundHostFunctionValue(
 			inter,
 			testContractValue,
-			beEmptyFunctionType,
+			conformToFunctionType,
 			func(invocation interpreter.Invocation) interpreter.Value {
+				typeValue, ok := invocation.Arguments[0].(interpreter.TypeValue)
+				if !ok {
+					panic(errors.NewUnreachableError())
+				}
 
 				// This is a static function.
-				beEmptyTestFunc := interpreter.NewStaticHostFunctionValue(
-					nil,
+				conformToTestFunc := interpreter.NewStaticHostFunctionValue(
+					invocation.Interpreter,
 					matcherTestFunctionType,
 					func(invocation interpreter.Invocation) interpreter.Value {
-						var isEmpty bool
-						switch value := invocation.Arguments[0].(type) {
-						case *interpreter.ArrayValue:
-							isEmpty = value.Count() == 0
-						case *interpreter.DictionaryValue:
-							isEmpty = value.Count() == 0
+						// The type is unknown, e.g. it could not be loaded
+						if typeValue.Type == nil {
+							return interpreter.FalseValue
+						}
+
+						inter := invocation.Interpreter
+						locationRange := invocation.LocationRange
+
+						// An interface conforms to itself, so check against its effective type,
+						// the intersection type consisting of only the interface
+						var intersectionType *sema.IntersectionType
+						switch semaType := inter.MustConvertStaticToSemaType(typeValue.Type).(type) {
+						case *sema.InterfaceType:
+							intersectionType = sema.NewIntersectionType(
+								inter,
+								nil,
+								[]*sema.InterfaceType{semaType},
+							)
+						case *sema.IntersectionType:
+							intersectionType = semaType
 						default:
-							panic(errors.NewDefaultUserError("expected Array or Dictionary argument"))
+							panic(errors.NewDefaultUserError(
+								"expected interface type, got `%s`",
+								typeValue.Type,
+							))
 						}
 
-						return interpreter.AsBoolValue(isEmpty)
+						compositeValue := testedCompositeValue(
+							inter,
+							locationRange,
+							invocation.Arguments[0],
+						)
+
+						return interpreter.AsBoolValue(
+							inter.IsSubTypeOfSemaType(
+								compositeValue.StaticType(inter),
+								intersectionType,
+							),
+						)
 					},
 				)
 
-				return newMatcherWithAnyStructTestFunction(
+				matcher := newMatcherWithAnyStructTestFunction(
 					invocation,
-					beEmptyTestFunc,
+					conformToTestFunc,
+				)
+
+				return withMatcherDescription(
+					invocation,
+					matcher,
+					func(value interpreter.Value) string {
+						return fmt.Sprintf(
+							"expected %s to conform to %s",
+							describedValueString(invocation.Interpreter, invocation.LocationRange, value),
+							typeValue,
+						)
+					},
 				)
 			},
 		)
 	}
 }
 
-// `Test.haveElementCount`
+// `Test.matchRegex`
 
-const testTypeHaveElementCountFunctionName = "haveElementCount"
+const testTypeMatchRegexFunctionName = "matchRegex"
 
-const testTypeHaveElementCountFunctionDocString = `
-Returns a matcher that succeeds if the tested value is an array or dictionary,
-and has the given number of elements.
+const testTypeMatchRegexFunctionDocString = `
+Returns a matcher that succeeds if the tested value is a string,
+and the whole string matches the given regular expression.
+The regular expression syntax is RE2.
 `
 
-func newTestTypeHaveElementCountFunctionType(matcherType *sema.CompositeType) *sema.FunctionType {
+func newTestTypeMatchRegexFunctionType(matcherType *sema.CompositeType) *sema.FunctionType {
 	return &sema.FunctionType{
 		Purity: sema.FunctionPurityView,
 		Parameters: []sema.Parameter{
 			{
 				Label:          sema.ArgumentLabelNotRequired,
-				Identifier:     "count",
-				TypeAnnotation: sema.IntTypeAnnotation,
+				Identifier:     "pattern",
+				TypeAnnotation: sema.StringTypeAnnotation,
 			},
 		},
 		ReturnTypeAnnotation: sema.NewTypeAnnotation(matcherType),
 	}
 }
 
-func newTestTypeHaveElementCountFunction(
-	haveElementCountFunctionType *sema.FunctionType,
+func newTestTypeMatchRegexFunction(
+	matchRegexFunctionType *sema.FunctionType,
+	matcherTestFunctionType *sema.FunctionType,
+) testContractBoundFunctionGenerator {
+	return func(inter *interpreter.Interpreter, testContractValue *interpreter.CompositeValue) interpreter.BoundFunctionValue {
+		return interpreter.NewUnmeteredBoundHostFunctionValue(
+			inter,
+			testContractValue,
+			matchRegexFunctionType,
+			func(invocation interpreter.Invocation) interpreter.Value {
+				pattern, ok := invocation.Arguments[0].(*interpreter.StringValue)
+				if !ok {
+					panic(errors.NewUnreachableError())
+				}
+
+				// Anchor the pattern, so the whole string must match
+				regex, err := regexp.Compile(fmt.Sprintf("^(?:%s)$", pattern.Str))
+				if err != nil {
+					panic(errors.NewDefaultUserError(
+						"invalid regular expression %s: %s",
+						pattern,
+						err,
+					))
+				}
+
+				// This is a static function.
+				matchRegexTestFunc := interpreter.NewStaticHostFunctionValue(
+					invocation.Interpreter,
+					matcherTestFunctionType,
+					func(invocation interpreter.Invocation) interpreter.Value {
+						value, ok := invocation.Arguments[0].(*interpreter.StringValue)
+						if !ok {
+							panic(errors.NewDefaultUserError("expected String argument"))
+						}
+
+						return interpreter.AsBoolValue(regex.MatchString(value.Str))
+					},
+				)
+
+				matcher := newMatcherWithAnyStructTestFunction(
+					invocation,
+					matchRegexTestFunc,
+				)
+
+				return withMatcherDescription(
+					invocation,
+					matcher,
+					func(value interpreter.Value) string {
+						return fmt.Sprintf("expected %s to match %s", value, pattern)
+					},
+				)
+			},
+		)
+	}
+}
+
+// `Test.beCloseTo`
+
+const testTypeBeCloseToFunctionName = "beCloseTo"
+
+const testTypeBeCloseToFunctionDocString = `
+Returns a matcher that succeeds if the tested value is a fixed-point number (Fix64 or UFix64),
+and the absolute difference between the tested value and the given value
+is less than or equal to the given delta.
+`
+
+func newTestTypeBeCloseToFunctionType(matcherType *sema.CompositeType) *sema.FunctionType {
+	return &sema.FunctionType{
+		Purity: sema.FunctionPurityView,
+		Parameters: []sema.Parameter{
+			{
+				Label:          sema.ArgumentLabelNotRequired,
+				Identifier:     "value",
+				TypeAnnotation: sema.Fix64TypeAnnotation,
+			},
+			{
+				Identifier:     "delta",
+				TypeAnnotation: sema.Fix64TypeAnnotation,
+			},
+		},
+		ReturnTypeAnnotation: sema.NewTypeAnnotation(matcherType),
+	}
+}
+
+func newTestTypeBeCloseToFunction(
+	beCloseToFunctionType *sema.FunctionType,
 	matcherTestFunctionType *sema.FunctionType,
 ) testContractBoundFunctionGenerator {
 	return func(inter *interpreter.Interpreter, testContractValue *interpreter.CompositeValue) interpreter.BoundFunctionValue {
 		return interpreter.NewUnmeteredBoundHostFunctionValue(
 			inter,
 			testContractValue,
-			haveElementCountFunctionType,
+			beCloseToFunctionType,
 			func(invocation interpreter.Invocation) interpreter.Value {
-				count, ok := invocation.Arguments[0].(interpreter.IntValue)
+				// The expected value and the delta are declared as Fix64
+				expectedValue, ok := invocation.Arguments[0].(interpreter.Fix64Value)
+				if !ok {
+					panic(errors.NewUnreachableError())
+				}
+				expected := big.NewInt(int64(expectedValue))
+
+				deltaValue, ok := invocation.Arguments[1].(interpreter.Fix64Value)
 				if !ok {
 					panic(errors.NewUnreachableError())
 				}
+				delta := big.NewInt(int64(deltaValue))
 
 				// This is a static function.
-				haveElementCountTestFunc := interpreter.NewStaticHostFunctionValue(
-					nil,
+				beCloseToTestFunc := interpreter.NewStaticHostFunctionValue(
+					invocation.Interpreter,
 					matcherTestFunctionType,
 					func(invocation interpreter.Invocation) interpreter.Value {
-						var matchingCount bool
-						switch value := invocation.Arguments[0].(type) {
-						case *interpreter.ArrayValue:
-							matchingCount = value.Count() == count.ToInt(invocation.LocationRange)
-						case *interpreter.DictionaryValue:
-							matchingCount = value.Count() == count.ToInt(invocation.LocationRange)
-						default:
-							panic(errors.NewDefaultUserError("expected Array or Dictionary argument"))
+						actual, ok := fixedPointBigInt(invocation.Arguments[0])
+						if !ok {
+							panic(errors.NewDefaultUserError("expected Fix64 or UFix64 argument"))
 						}
 
-						return interpreter.AsBoolValue(matchingCount)
+						// Compute the difference using big integers,
+						// as the difference of two Fix64 or UFix64 values might overflow,
+						// and Fix64 and UFix64 values cannot be subtracted from each other.
+						// Both types have the same scale, so the integers can be compared directly.
+						difference := new(big.Int).Sub(actual, expected)
+						difference.Abs(difference)
+
+						return interpreter.AsBoolValue(difference.Cmp(delta) <= 0)
 					},
 				)
 
-				return newMatcherWithAnyStructTestFunction(
+				matcher := newMatcherWithAnyStructTestFunction(
 					invocation,
-					haveElementCountTestFunc,
+					beCloseToTestFunc,
+				)
+
+				return withMatcherDescription(
+					invocation,
+					matcher,
+					func(value interpreter.Value) string {
+						return fmt.Sprintf(
+							"expected %s to be within %s of %s",
+							value,
+							deltaValue,
+							expectedValue,
+						)
+					},
 				)
 			},
 		)
 	}
 }
 
-// `Test.contain`
+// fixedPointBigInt returns the integer representation of the given Fix64 or UFix64 value,
+// which is tested against the expected Fix64 value
+func fixedPointBigInt(value interpreter.Value) (*big.Int, bool) {
+	switch value := value.(type) {
+	case interpreter.Fix64Value:
+		return big.NewInt(int64(value)), true
+	case interpreter.UFix64Value:
+		return new(big.Int).SetUint64(uint64(value)), true
+	default:
+		return nil, false
+	}
+}
 
-const testTypeContainFunctionName = "contain"
+// `Test.beSorted` and `Test.beSortedDescending`
 
-const testTypeContainFunctionDocString = `
-Returns a matcher that succeeds if the tested value is an array that contains
-a value that is equal to the given value, or the tested value is a dictionary
-that contains an entry where the key is equal to the given value.
+const testTypeBeSortedFunctionName = "beSorted"
+
+const testTypeBeSortedFunctionDocString = `
+Returns a matcher that succeeds if the tested value is an array,
+and its elements are in ascending (non-decreasing) order.
 `
 
-func newTestTypeContainFunctionType(matcherType *sema.CompositeType) *sema.FunctionType {
+const testTypeBeSortedDescendingFunctionName = "beSortedDescending"
+
+const testTypeBeSortedDescendingFunctionDocString = `
+Returns a matcher that succeeds if the tested value is an array,
+and its elements are in descending (non-increasing) order.
+`
+
+func newTestTypeBeSortedFunctionType(matcherType *sema.CompositeType) *sema.FunctionType {
 	return &sema.FunctionType{
-		Purity: sema.FunctionPurityView,
-		Parameters: []sema.Parameter{
-			{
-				Label:          sema.ArgumentLabelNotRequired,
-				Identifier:     "element",
-				TypeAnnotation: sema.AnyStructTypeAnnotation,
-			},
-		},
+		Purity:               sema.FunctionPurityView,
 		ReturnTypeAnnotation: sema.NewTypeAnnotation(matcherType),
 	}
 }
 
-func newTestTypeContainFunction(
-	containFunctionType *sema.FunctionType,
+func newTestTypeBeSortedFunction(
+	beSortedFunctionType *sema.FunctionType,
 	matcherTestFunctionType *sema.FunctionType,
+	descending bool,
 ) testContractBoundFunctionGenerator {
 	return func(inter *interpreter.Interpreter, testContractValue *interpreter.CompositeValue) interpreter.BoundFunctionValue {
 		return interpreter.NewUnmeteredBoundHostFunctionValue(
 			inter,
 			testContractValue,
-			containFunctionType,
+			beSortedFunctionType,
 			func(invocation interpreter.Invocation) interpreter.Value {
-				element, ok := invocation.Arguments[0].(interpreter.EquatableValue)
-				if !ok {
-					panic(errors.NewUnreachableError())
-				}
-
-				inter := invocation.Interpreter
 
 				// This is a static function.
-				containTestFunc := interpreter.NewStaticHostFunctionValue(
-					nil,
+				beSortedTestFunc := interpreter.NewStaticHostFunctionValue(
+					invocation.Interpreter,
 					matcherTestFunctionType,
 					func(invocation interpreter.Invocation) interpreter.Value {
-						var elementFound interpreter.BoolValue
-						switch value := invocation.Arguments[0].(type) {
-						case *interpreter.ArrayValue:
-							elementFound = value.Contains(
-								inter,
-								invocation.LocationRange,
-								element,
-							)
-						case *interpreter.DictionaryValue:
-							elementFound = value.ContainsKey(
-								inter,
-								invocation.LocationRange,
-								element,
-							)
-						default:
-							panic(errors.NewDefaultUserError("expected Array or Dictionary argument"))
+						inter := invocation.Interpreter
+						locationRange := invocation.LocationRange
+
+						array, ok := invocation.Arguments[0].(*interpreter.ArrayValue)
+						if !ok {
+							panic(errors.NewDefaultUserError("expected Array argument"))
 						}
 
-						return elementFound
+						var previous interpreter.ComparableValue
+
+						count := array.Count()
+						for i := 0; i < count; i++ {
+							element, ok := array.Get(inter, locationRange, i).(interpreter.ComparableValue)
+							if !ok {
+								panic(errors.NewDefaultUserError("expected array elements to be comparable"))
+							}
+
+							if previous != nil {
+								var inOrder interpreter.BoolValue
+								if descending {
+									inOrder = previous.GreaterEqual(inter, element, locationRange)
+								} else {
+									inOrder = previous.LessEqual(inter, element, locationRange)
+								}
+
+								if !inOrder {
+									return interpreter.FalseValue
+								}
+							}
+
+							previous = element
+						}
+
+						return interpreter.TrueValue
 					},
 				)
 
-				return newMatcherWithAnyStructTestFunction(
+				matcher := newMatcherWithAnyStructTestFunction(
 					invocation,
-					containTestFunc,
+					beSortedTestFunc,
+				)
+
+				return withMatcherDescription(
+					invocation,
+					matcher,
+					func(value interpreter.Value) string {
+						order := "ascending"
+						if descending {
+							order = "descending"
+						}
+						return fmt.Sprintf(
+							"expected %s to be sorted in %s order",
+							describedValueString(invocation.Interpreter, invocation.LocationRange, value),
+							order,
+						)
+					},
 				)
 			},
 		)
 	}
 }
 
-// `Test.beGreaterThan`
+// `Test.beResource` and `Test.beStruct`
 
-const testTypeBeGreaterThanFunctionName = "beGreaterThan"
+const testTypeBeResourceFunctionName = "beResource"
 
-const testTypeBeGreaterThanFunctionDocString = `
-Returns a matcher that succeeds if the tested value is a number and
-greater than the given number.
+const testTypeBeResourceFunctionDocString = `
+Returns a matcher that succeeds if the tested value is a resource,
+or a reference to one.
 `
 
-func newTestTypeBeGreaterThanFunctionType(matcherType *sema.CompositeType) *sema.FunctionType {
+const testTypeBeStructFunctionName = "beStruct"
+
+const testTypeBeStructFunctionDocString = `
+Returns a matcher that succeeds if the tested value is a struct,
+or a reference to one.
+`
+
+func newTestTypeBeCompositeKindFunctionType(matcherType *sema.CompositeType) *sema.FunctionType {
 	return &sema.FunctionType{
-		Purity: sema.FunctionPurityView,
-		Parameters: []sema.Parameter{
-			{
-				Label:          sema.ArgumentLabelNotRequired,
-				Identifier:     "value",
-				TypeAnnotation: sema.NumberTypeAnnotation,
-			},
-		},
+		Purity:               sema.FunctionPurityView,
 		ReturnTypeAnnotation: sema.NewTypeAnnotation(matcherType),
 	}
 }
 
-func newTestTypeBeGreaterThanFunction(
-	beGreaterThanFunctionType *sema.FunctionType,
+func newTestTypeBeCompositeKindFunction(
+	beCompositeKindFunctionType *sema.FunctionType,
 	matcherTestFunctionType *sema.FunctionType,
+	kind common.CompositeKind,
 ) testContractBoundFunctionGenerator {
 	return func(inter *interpreter.Interpreter, testContractValue *interpreter.CompositeValue) interpreter.BoundFunctionValue {
 		return interpreter.NewUnmeteredBoundHostFunctionValue(
 			inter,
 			testContractValue,
-			beGreaterThanFunctionType,
+			beCompositeKindFunctionType,
 			func(invocation interpreter.Invocation) interpreter.Value {
-				otherValue, ok := invocation.Arguments[0].(interpreter.NumberValue)
-				if !ok {
-					panic(errors.NewUnreachableError())
-				}
-
-				inter := invocation.Interpreter
 
 				// This is a static function.
-				beGreaterThanTestFunc := interpreter.NewStaticHostFunctionValue(
-					nil,
+				beCompositeKindTestFunc := interpreter.NewStaticHostFunctionValue(
+					invocation.Interpreter,
 					matcherTestFunctionType,
 					func(invocation interpreter.Invocation) interpreter.Value {
-						thisValue, ok := invocation.Arguments[0].(interpreter.NumberValue)
-						if !ok {
-							panic(errors.NewUnreachableError())
-						}
+						inter := invocation.Interpreter
+						locationRange := invocation.LocationRange
 
-						isGreaterThan := thisValue.Greater(
+						compositeValue := testedCompositeValue(
 							inter,
-							otherValue,
-							invocation.LocationRange,
+							locationRange,
+							invocation.Arguments[0],
 						)
 
-						return isGreaterThan
+						return interpreter.AsBoolValue(compositeValue.Kind == kind)
 					},
 				)
 
-				return newMatcherWithAnyStructTestFunction(
+				matcher := newMatcherWithAnyStructTestFunction(
 					invocation,
-					beGreaterThanTestFunc,
+					beCompositeKindTestFunc,
+				)
+
+				return withMatcherDescription(
+					invocation,
+					matcher,
+					func(value interpreter.Value) string {
+						return fmt.Sprintf(
+							"expected %s to be a %s",
+							describedValueString(invocation.Interpreter, invocation.LocationRange, value),
+							kind.Keyword(),
+						)
+					},
 				)
 			},
 		)
 	}
 }
 
-// `Test.beLessThan`
-
-const testTypeBeLessThanFunctionName = "beLessThan"
-
-const testTypeBeLessThanFunctionDocString = `
-Returns a matcher that succeeds if the tested value is a number and
-less than the given number.
-`
+// testedCompositeValue returns the struct or resource tested by a matcher.
+// Resources cannot be tested directly, only through references,
+// so references are dereferenced first.
+func testedCompositeValue(
+	inter *interpreter.Interpreter,
+	locationRange interpreter.LocationRange,
+	value interpreter.Value,
+) *interpreter.CompositeValue {
+	if referenceValue, ok := value.(interpreter.ReferenceValue); ok {
+		value = *referenceValue.ReferencedValue(inter, locationRange, true)
+	}
 
-func newTestTypeBeLessThanFunctionType(matcherType *sema.CompositeType) *sema.FunctionType {
-	return &sema.FunctionType{
-		Purity: sema.FunctionPurityView,
-		Parameters: []sema.Parameter{
-			{
-				Label:          sema.ArgumentLabelNotRequired,
-				Identifier:     "value",
-				TypeAnnotation: sema.NumberTypeAnnotation,
-			},
-		},
-		ReturnTypeAnnotation: sema.NewTypeAnnotation(matcherType),
+	compositeValue, ok := value.(*interpreter.CompositeValue)
+	if !ok {
+		panic(errors.NewDefaultUserError(
+			"expected struct or resource argument, got `%s`",
+			value.StaticType(inter),
+		))
 	}
+
+	return compositeValue
 }
 
-// Test.expectFailure function
+// `Test.haveField`
 
-const testExpectFailureFunctionName = "expectFailure"
+const testTypeHaveFieldFunctionName = "haveField"
 
-const testExpectFailureFunctionDocString = `
-Wraps a function call in a closure, and expects it to fail with
-an error message that contains the given error message portion.
+const testTypeHaveFieldFunctionDocString = `
+Returns a matcher that succeeds if the tested value is a struct, resource, or reference,
+and the field with the given name is equal to the given value.
 `
 
-func newTestTypeExpectFailureFunctionType() *sema.FunctionType {
+func newTestTypeHaveFieldFunctionType(matcherType *sema.CompositeType) *sema.FunctionType {
 	return &sema.FunctionType{
+		Purity: sema.FunctionPurityView,
 		Parameters: []sema.Parameter{
 			{
-				Label:      sema.ArgumentLabelNotRequired,
-				Identifier: "functionWrapper",
-				TypeAnnotation: sema.NewTypeAnnotation(
-					&sema.FunctionType{
-						ReturnTypeAnnotation: sema.VoidTypeAnnotation,
-					},
-				),
+				Label:          sema.ArgumentLabelNotRequired,
+				Identifier:     "name",
+				TypeAnnotation: sema.StringTypeAnnotation,
 			},
 			{
-				Identifier:     "errorMessageSubstring",
-				TypeAnnotation: sema.StringTypeAnnotation,
+				Identifier:     "value",
+				TypeAnnotation: sema.AnyStructTypeAnnotation,
 			},
 		},
-		ReturnTypeAnnotation: sema.VoidTypeAnnotation,
+		ReturnTypeAnnotation: sema.NewTypeAnnotation(matcherType),
 	}
 }
 
-func newTestTypeExpectFailureFunction(
-	testExpectFailureFunctionType *sema.FunctionType,
+func newTestTypeHaveFieldFunction(
+	haveFieldFunctionType *sema.FunctionType,
+	matcherTestFunctionType *sema.FunctionType,
 ) testContractBoundFunctionGenerator {
 	return func(inter *interpreter.Interpreter, testContractValue *interpreter.CompositeValue) interpreter.BoundFunctionValue {
 		return interpreter.NewUnmeteredBoundHostFunctionValue(
 			inter,
 			testContractValue,
-			testExpectFailureFunctionType,
+			haveFieldFunctionType,
 			func(invocation interpreter.Invocation) interpreter.Value {
-				inter := invocation.Interpreter
-				functionValue, ok := invocation.Arguments[0].(interpreter.FunctionValue)
+				nameValue, ok := invocation.Arguments[0].(*interpreter.StringValue)
 				if !ok {
 					panic(errors.NewUnreachableError())
 				}
-				functionType := functionValue.FunctionType()
+				name := nameValue.Str
 
-				errorMessage, ok := invocation.Arguments[1].(*interpreter.StringValue)
+				expectedValue, ok := invocation.Arguments[1].(interpreter.EquatableValue)
 				if !ok {
-					panic(errors.NewUnreachableError())
+					panic(errors.NewDefaultUserError("expected value of field '%s' is not equatable", name))
 				}
 
-				failedAsExpected := true
+				// This is a static function.
+				haveFieldTestFunc := interpreter.NewStaticHostFunctionValue(
+					invocation.Interpreter,
+					matcherTestFunctionType,
+					func(invocation interpreter.Invocation) interpreter.Value {
+						inter := invocation.Interpreter
+						locationRange := invocation.LocationRange
 
-				defer inter.RecoverErrors(func(internalErr error) {
-					if !failedAsExpected {
-						panic(internalErr)
-					} else if !strings.Contains(internalErr.Error(), errorMessage.Str) {
-						msg := fmt.Sprintf(
-							"Expected error message to include: %s.",
-							errorMessage,
-						)
-						panic(
-							errors.NewDefaultUserError(msg),
-						)
-					}
-				})
+						value, ok := invocation.Arguments[0].(interpreter.MemberAccessibleValue)
+						if !ok {
+							panic(errors.NewDefaultUserError("expected struct, resource, or reference argument"))
+						}
 
-				_, err := inter.InvokeExternally(
-					functionValue,
-					functionType,
-					nil,
+						fieldMember := fieldMemberOfValue(inter, value, name)
+						if fieldMember == nil {
+							panic(errors.NewDefaultUserError("value has no field '%s'", name))
+						}
+
+						// GetMember does not check access control,
+						// so check that the field is readable by the test
+						if !isReadableFieldOfValue(inter, value, fieldMember) {
+							panic(errors.NewDefaultUserError("field '%s' is not accessible", name))
+						}
+
+						fieldValue := value.GetMember(inter, locationRange, name)
+						if fieldValue == nil {
+							panic(errors.NewUnreachableError())
+						}
+
+						equal := expectedValue.Equal(inter, locationRange, fieldValue)
+
+						return interpreter.AsBoolValue(equal)
+					},
 				)
-				if err == nil {
-					failedAsExpected = false
-					panic(errors.NewDefaultUserError("Expected a failure, but found none."))
-				}
 
-				return interpreter.Void
+				matcher := newMatcherWithAnyStructTestFunction(
+					invocation,
+					haveFieldTestFunc,
+				)
+
+				return withMatcherDescription(
+					invocation,
+					matcher,
+					func(value interpreter.Value) string {
+						return fmt.Sprintf(
+							"expected %s to have field '%s' equal to %s",
+							describedValueString(invocation.Interpreter, invocation.LocationRange, value),
+							name,
+							expectedValue,
+						)
+					},
+				)
+			},
+		)
+	}
+}
+
+// fieldMemberOfValue returns the declared field with the given name
+// of the type of the given value, or of the type referenced by the given reference.
+// It returns nil if the type has no field with the given name
+func fieldMemberOfValue(
+	inter *interpreter.Interpreter,
+	value interpreter.Value,
+	name string,
+) *sema.Member {
+	ty := inter.MustConvertStaticToSemaType(value.StaticType(inter))
+	if referenceType, ok := ty.(*sema.ReferenceType); ok {
+		ty = referenceType.Type
+	}
+
+	resolver, ok := ty.GetMembers()[name]
+	if !ok || resolver.Kind != common.DeclarationKindField {
+		return nil
+	}
+
+	return resolver.Resolve(inter, name, ast.EmptyRange, func(error) {})
+}
+
+// isReadableFieldOfValue returns true if the given field of the given value
+// can be read from outside the declaring contract and account,
+// following the rules of the checker:
+// Only fields with access(all), or with entitled access, are readable.
+// Entitled fields are readable from owned values,
+// and from references which are authorized for the field
+func isReadableFieldOfValue(
+	inter *interpreter.Interpreter,
+	value interpreter.Value,
+	fieldMember *sema.Member,
+) bool {
+	switch access := fieldMember.Access.(type) {
+	case sema.PrimitiveAccess:
+		return ast.PrimitiveAccess(access) == ast.AccessAll
+
+	case sema.EntitlementSetAccess:
+		ty := inter.MustConvertStaticToSemaType(value.StaticType(inter))
+		referenceType, ok := ty.(*sema.ReferenceType)
+		if !ok {
+			// Owned values are fully authorized
+			return true
+		}
+		return access.PermitsAccess(referenceType.Authorization)
+
+	case *sema.EntitlementMapAccess:
+		// The authorization of the field is mapped from the authorization of the value
+		return true
+	}
+
+	return false
+}
+
+// 'Test.assertThat' function
+
+const testTypeAssertThatFunctionName = "assertThat"
+
+const testTypeAssertThatFunctionDocString = `
+Returns an assertion for the given value,
+which provides chainable assertions, e.g. 'Test.assertThat(value).isEqualTo(1)'.
+`
+
+func newTestTypeAssertThatFunctionType(assertionType *sema.CompositeType) *sema.FunctionType {
+	return &sema.FunctionType{
+		Parameters: []sema.Parameter{
+			{
+				Label:          sema.ArgumentLabelNotRequired,
+				Identifier:     "value",
+				TypeAnnotation: sema.AnyStructTypeAnnotation,
 			},
-		)
+		},
+		ReturnTypeAnnotation: sema.NewTypeAnnotation(assertionType),
 	}
 }
 
-func newTestTypeBeLessThanFunction(
-	beLessThanFunctionType *sema.FunctionType,
-	matcherTestFunctionType *sema.FunctionType,
+func newTestTypeAssertThatFunction(
+	assertThatFunctionType *sema.FunctionType,
+	assertionType *testAssertionType,
 ) testContractBoundFunctionGenerator {
 	return func(inter *interpreter.Interpreter, testContractValue *interpreter.CompositeValue) interpreter.BoundFunctionValue {
 		return interpreter.NewUnmeteredBoundHostFunctionValue(
 			inter,
 			testContractValue,
-			beLessThanFunctionType,
+			assertThatFunctionType,
 			func(invocation interpreter.Invocation) interpreter.Value {
-				otherValue, ok := invocation.Arguments[0].(interpreter.NumberValue)
-				if !ok {
-					panic(errors.NewUnreachableError())
-				}
+				return assertionType.newAssertion(
+					invocation.Interpreter,
+					invocation.Arguments[0],
+					invocation.LocationRange,
+				)
+			},
+		)
+	}
+}
 
-				inter := invocation.Interpreter
+// 'Test.each' function
 
-				// This is a static function.
-				beLessThanTestFunc := interpreter.NewStaticHostFunctionValue(
-					nil,
-					matcherTestFunctionType,
-					func(invocation interpreter.Invocation) interpreter.Value {
-						thisValue, ok := invocation.Arguments[0].(interpreter.NumberValue)
-						if !ok {
-							panic(errors.NewUnreachableError())
-						}
+const testTypeEachFunctionDocString = `
+Runs the given test body once for each of the given cases.
+Fails the test-case if the body fails for any case,
+and reports the index of the failing case.
+`
 
-						isLessThan := thisValue.Less(
-							inter,
-							otherValue,
-							invocation.LocationRange,
-						)
+const testTypeEachFunctionName = "each"
 
-						return isLessThan
+var testTypeEachFunctionType = &sema.FunctionType{
+	Parameters: []sema.Parameter{
+		{
+			Identifier: "cases",
+			TypeAnnotation: sema.NewTypeAnnotation(
+				&sema.VariableSizedType{
+					Type: sema.AnyStructType,
+				},
+			),
+		},
+		{
+			Identifier: "body",
+			TypeAnnotation: sema.NewTypeAnnotation(
+				&sema.FunctionType{
+					Parameters: []sema.Parameter{
+						{
+							Label:          sema.ArgumentLabelNotRequired,
+							Identifier:     "testCase",
+							TypeAnnotation: sema.AnyStructTypeAnnotation,
+						},
 					},
-				)
+					ReturnTypeAnnotation: sema.VoidTypeAnnotation,
+				},
+			),
+		},
+	},
+	ReturnTypeAnnotation: sema.VoidTypeAnnotation,
+}
 
-				return newMatcherWithAnyStructTestFunction(
-					invocation,
-					beLessThanTestFunc,
-				)
-			},
-		)
+func newTestTypeEachFunction(
+	inter *interpreter.Interpreter,
+	testContractValue *interpreter.CompositeValue,
+) interpreter.BoundFunctionValue {
+	return interpreter.NewUnmeteredBoundHostFunctionValue(
+		inter,
+		testContractValue,
+		testTypeEachFunctionType,
+		func(invocation interpreter.Invocation) interpreter.Value {
+			inter := invocation.Interpreter
+			locationRange := invocation.LocationRange
+
+			cases, ok := invocation.Arguments[0].(*interpreter.ArrayValue)
+			if !ok {
+				panic(errors.NewUnreachableError())
+			}
+
+			body, ok := invocation.Arguments[1].(interpreter.FunctionValue)
+			if !ok {
+				panic(errors.NewUnreachableError())
+			}
+
+			// NOTE: the count is re-evaluated for each case,
+			// as the body may modify the cases
+			for index := 0; index < cases.Count(); index++ {
+				element := cases.Get(inter, locationRange, index)
+				invokeTestCase(inter, body, element, index, locationRange)
+			}
+
+			return interpreter.Void
+		},
+	)
+}
+
+// invokeTestCase invokes the given test body with the given case,
+// and reports the index of the case if the body fails.
+// Only user errors are failures of the body,
+// all other errors are propagated as-is
+func invokeTestCase(
+	inter *interpreter.Interpreter,
+	body interpreter.FunctionValue,
+	element interpreter.Value,
+	index int,
+	locationRange interpreter.LocationRange,
+) {
+	defer inter.RecoverErrors(func(err error) {
+		if !errors.IsUserError(err) {
+			panic(err)
+		}
+
+		panic(AssertionError{
+			Message: fmt.Sprintf(
+				"case %d failed: %s",
+				index,
+				err.Error(),
+			),
+			LocationRange: locationRange,
+		})
+	})
+
+	_, err := inter.InvokeExternally(
+		body,
+		body.FunctionType(),
+		[]interpreter.Value{
+			element,
+		},
+	)
+	if err != nil {
+		panic(err)
 	}
 }
 
@@ -1063,6 +2611,15 @@ func newTestContractType() *TestContractType {
 		emulatorBackendType.compositeType,
 	)
 
+	assertionType := newTestAssertionType()
+	ty.assertionType = assertionType
+
+	// Enrich 'Test' contract elaboration with the natively implemented 'Assertion' type
+	checker.Elaboration.SetCompositeType(
+		assertionType.compositeType.ID(),
+		assertionType.compositeType,
+	)
+
 	matcherType := ty.matcherType()
 	matcherTestFunctionType := compositeFunctionType(matcherType, matcherTestFieldName)
 
@@ -1110,6 +2667,54 @@ func newTestContractType() *TestContractType {
 		),
 	)
 
+	// Test.writeFile()
+	compositeType.Members.Set(
+		testTypeWriteFileFunctionName,
+		sema.NewUnmeteredPublicFunctionMember(
+			compositeType,
+			testTypeWriteFileFunctionName,
+			testTypeWriteFileFunctionType,
+			testTypeWriteFileFunctionDocString,
+		),
+	)
+
+	// Test.listFiles()
+	compositeType.Members.Set(
+		testTypeListFilesFunctionName,
+		sema.NewUnmeteredPublicFunctionMember(
+			compositeType,
+			testTypeListFilesFunctionName,
+			testTypeListFilesFunctionType,
+			testTypeListFilesFunctionDocString,
+		),
+	)
+
+	privateKeyType := ty.privateKeyType()
+
+	// Test.createKey()
+	ty.createKeyFunctionType = newTestTypeCreateKeyFunctionType(privateKeyType)
+	compositeType.Members.Set(
+		testTypeCreateKeyFunctionName,
+		sema.NewUnmeteredPublicFunctionMember(
+			compositeType,
+			testTypeCreateKeyFunctionName,
+			ty.createKeyFunctionType,
+			testTypeCreateKeyFunctionDocString,
+		),
+	)
+
+	// Test.sign()
+	ty.signFunctionType = newTestTypeSignFunctionType(privateKeyType)
+	compositeType.Members.Set(
+		testTypeSignFunctionName,
+		sema.NewUnmeteredPublicFunctionMember(
+			compositeType,
+			testTypeSignFunctionName,
+			ty.signFunctionType,
+			testTypeSignFunctionDocString,
+		),
+	)
+
 	// Test.expect()
 	testExpectFunctionType := newTestTypeExpectFunctionType(matcherType)
 	compositeType.Members.Set(
@@ -1139,6 +2744,55 @@ func newTestContractType() *TestContractType {
 		matcherTestFunctionType,
 	)
 
+	// Test.satisfy()
+	satisfyMatcherFunctionType := newTestTypeSatisfyFunctionType(matcherType)
+	compositeType.Members.Set(
+		testTypeSatisfyFunctionName,
+		sema.NewUnmeteredPublicFunctionMember(
+			compositeType,
+			testTypeSatisfyFunctionName,
+			satisfyMatcherFunctionType,
+			testTypeSatisfyFunctionDocString,
+		),
+	)
+	ty.satisfyFunction = newTestTypeSatisfyFunction(
+		satisfyMatcherFunctionType,
+		matcherTestFunctionType,
+	)
+
+	// Test.anyOf() and Test.allOf()
+	matcherFoldFunctionType := newTestTypeMatcherFoldFunctionType(matcherType)
+	compositeType.Members.Set(
+		testTypeAnyOfFunctionName,
+		sema.NewUnmeteredPublicFunctionMember(
+			compositeType,
+			testTypeAnyOfFunctionName,
+			matcherFoldFunctionType,
+			testTypeAnyOfFunctionDocString,
+		),
+	)
+	ty.anyOfFunction = newTestTypeMatcherFoldFunction(
+		matcherFoldFunctionType,
+		matcherTestFunctionType,
+		true,
+		"any of the matchers",
+	)
+	compositeType.Members.Set(
+		testTypeAllOfFunctionName,
+		sema.NewUnmeteredPublicFunctionMember(
+			compositeType,
+			testTypeAllOfFunctionName,
+			matcherFoldFunctionType,
+			testTypeAllOfFunctionDocString,
+		),
+	)
+	ty.allOfFunction = newTestTypeMatcherFoldFunction(
+		matcherFoldFunctionType,
+		matcherTestFunctionType,
+		false,
+		"all of the matchers",
+	)
+
 	// Test.equal()
 	equalMatcherFunctionType := newTestTypeEqualFunctionType(matcherType)
 	compositeType.Members.Set(
@@ -1201,6 +2855,24 @@ func newTestContractType() *TestContractType {
 	ty.containFunction = newTestTypeContainFunction(
 		containMatcherFunctionType,
 		matcherTestFunctionType,
+		false,
+	)
+
+	// Test.notContain()
+	notContainMatcherFunctionType := newTestTypeContainFunctionType(matcherType)
+	compositeType.Members.Set(
+		testTypeNotContainFunctionName,
+		sema.NewUnmeteredPublicFunctionMember(
+			compositeType,
+			testTypeNotContainFunctionName,
+			notContainMatcherFunctionType,
+			testTypeNotContainFunctionDocString,
+		),
+	)
+	ty.notContainFunction = newTestTypeContainFunction(
+		notContainMatcherFunctionType,
+		matcherTestFunctionType,
+		true,
 	)
 
 	// Test.beGreaterThan()
@@ -1235,6 +2907,154 @@ func newTestContractType() *TestContractType {
 		matcherTestFunctionType,
 	)
 
+	// Test.beInstanceOf()
+	beInstanceOfMatcherFunctionType := newTestTypeBeInstanceOfFunctionType(matcherType)
+	compositeType.Members.Set(
+		testTypeBeInstanceOfFunctionName,
+		sema.NewUnmeteredPublicFunctionMember(
+			compositeType,
+			testTypeBeInstanceOfFunctionName,
+			beInstanceOfMatcherFunctionType,
+			testTypeBeInstanceOfFunctionDocString,
+		),
+	)
+	ty.beInstanceOfFunction = newTestTypeBeInstanceOfFunction(
+		beInstanceOfMatcherFunctionType,
+		matcherTestFunctionType,
+	)
+
+	// Test.conformTo()
+	conformToMatcherFunctionType := newTestTypeConformToFunctionType(matcherType)
+	compositeType.Members.Set(
+		testTypeConformToFunctionName,
+		sema.NewUnmeteredPublicFunctionMember(
+			compositeType,
+			testTypeConformToFunctionName,
+			conformToMatcherFunctionType,
+			testTypeConformToFunctionDocString,
+		),
+	)
+	ty.conformToFunction = newTestTypeConformToFunction(
+		conformToMatcherFunctionType,
+		matcherTestFunctionType,
+	)
+
+	// Test.matchRegex()
+	matchRegexMatcherFunctionType := newTestTypeMatchRegexFunctionType(matcherType)
+	compositeType.Members.Set(
+		testTypeMatchRegexFunctionName,
+		sema.NewUnmeteredPublicFunctionMember(
+			compositeType,
+			testTypeMatchRegexFunctionName,
+			matchRegexMatcherFunctionType,
+			testTypeMatchRegexFunctionDocString,
+		),
+	)
+	ty.matchRegexFunction = newTestTypeMatchRegexFunction(
+		matchRegexMatcherFunctionType,
+		matcherTestFunctionType,
+	)
+
+	// Test.beCloseTo()
+	beCloseToMatcherFunctionType := newTestTypeBeCloseToFunctionType(matcherType)
+	compositeType.Members.Set(
+		testTypeBeCloseToFunctionName,
+		sema.NewUnmeteredPublicFunctionMember(
+			compositeType,
+			testTypeBeCloseToFunctionName,
+			beCloseToMatcherFunctionType,
+			testTypeBeCloseToFunctionDocString,
+		),
+	)
+	ty.beCloseToFunction = newTestTypeBeCloseToFunction(
+		beCloseToMatcherFunctionType,
+		matcherTestFunctionType,
+	)
+
+	// Test.beSorted()
+	beSortedMatcherFunctionType := newTestTypeBeSortedFunctionType(matcherType)
+	compositeType.Members.Set(
+		testTypeBeSortedFunctionName,
+		sema.NewUnmeteredPublicFunctionMember(
+			compositeType,
+			testTypeBeSortedFunctionName,
+			beSortedMatcherFunctionType,
+			testTypeBeSortedFunctionDocString,
+		),
+	)
+	ty.beSortedFunction = newTestTypeBeSortedFunction(
+		beSortedMatcherFunctionType,
+		matcherTestFunctionType,
+		false,
+	)
+
+	// Test.beSortedDescending()
+	beSortedDescendingMatcherFunctionType := newTestTypeBeSortedFunctionType(matcherType)
+	compositeType.Members.Set(
+		testTypeBeSortedDescendingFunctionName,
+		sema.NewUnmeteredPublicFunctionMember(
+			compositeType,
+			testTypeBeSortedDescendingFunctionName,
+			beSortedDescendingMatcherFunctionType,
+			testTypeBeSortedDescendingFunctionDocString,
+		),
+	)
+	ty.beSortedDescendingFunction = newTestTypeBeSortedFunction(
+		beSortedDescendingMatcherFunctionType,
+		matcherTestFunctionType,
+		true,
+	)
+
+	// Test.beResource()
+	beResourceMatcherFunctionType := newTestTypeBeCompositeKindFunctionType(matcherType)
+	compositeType.Members.Set(
+		testTypeBeResourceFunctionName,
+		sema.NewUnmeteredPublicFunctionMember(
+			compositeType,
+			testTypeBeResourceFunctionName,
+			beResourceMatcherFunctionType,
+			testTypeBeResourceFunctionDocString,
+		),
+	)
+	ty.beResourceFunction = newTestTypeBeCompositeKindFunction(
+		beResourceMatcherFunctionType,
+		matcherTestFunctionType,
+		common.CompositeKindResource,
+	)
+
+	// Test.beStruct()
+	beStructMatcherFunctionType := newTestTypeBeCompositeKindFunctionType(matcherType)
+	compositeType.Members.Set(
+		testTypeBeStructFunctionName,
+		sema.NewUnmeteredPublicFunctionMember(
+			compositeType,
+			testTypeBeStructFunctionName,
+			beStructMatcherFunctionType,
+			testTypeBeStructFunctionDocString,
+		),
+	)
+	ty.beStructFunction = newTestTypeBeCompositeKindFunction(
+		beStructMatcherFunctionType,
+		matcherTestFunctionType,
+		common.CompositeKindStructure,
+	)
+
+	// Test.haveField()
+	haveFieldMatcherFunctionType := newTestTypeHaveFieldFunctionType(matcherType)
+	compositeType.Members.Set(
+		testTypeHaveFieldFunctionName,
+		sema.NewUnmeteredPublicFunctionMember(
+			compositeType,
+			testTypeHaveFieldFunctionName,
+			haveFieldMatcherFunctionType,
+			testTypeHaveFieldFunctionDocString,
+		),
+	)
+	ty.haveFieldFunction = newTestTypeHaveFieldFunction(
+		haveFieldMatcherFunctionType,
+		matcherTestFunctionType,
+	)
+
 	// Test.expectFailure()
 	expectFailureFunctionType := newTestTypeExpectFailureFunctionType()
 	compositeType.Members.Set(
@@ -1249,6 +3069,34 @@ func newTestContractType() *TestContractType {
 	ty.expectFailureFunction = newTestTypeExpectFailureFunction(
 		expectFailureFunctionType,
 	)
+
+	// Test.each()
+	compositeType.Members.Set(
+		testTypeEachFunctionName,
+		sema.NewUnmeteredPublicFunctionMember(
+			compositeType,
+			testTypeEachFunctionName,
+			testTypeEachFunctionType,
+			testTypeEachFunctionDocString,
+		),
+	)
+
+	// Test.assertThat()
+	assertThatFunctionType := newTestTypeAssertThatFunctionType(assertionType.compositeType)
+	compositeType.Members.Set(
+		testTypeAssertThatFunctionName,
+		sema.NewUnmeteredPublicFunctionMember(
+			compositeType,
+			testTypeAssertThatFunctionName,
+			assertThatFunctionType,
+			testTypeAssertThatFunctionDocString,
+		),
+	)
+	ty.assertThatFunction = newTestTypeAssertThatFunction(
+		assertThatFunctionType,
+		assertionType,
+	)
+
 	compositeType.ResolveMembers()
 
 	return ty
@@ -1290,6 +3138,23 @@ func (t *TestContractType) matcherType() *sema.CompositeType {
 	return matcherType
 }
 
+func (t *TestContractType) privateKeyType() *sema.CompositeType {
+	typ, ok := t.CompositeType.NestedTypes.Get(testPrivateKeyTypeName)
+	if !ok {
+		panic(typeNotFoundError(testContractTypeName, testPrivateKeyTypeName))
+	}
+
+	privateKeyType, ok := typ.(*sema.CompositeType)
+	if !ok || privateKeyType.Kind != common.CompositeKindStructure {
+		panic(errors.NewUnexpectedError(
+			"invalid type for '%s'. expected struct type",
+			testPrivateKeyTypeName,
+		))
+	}
+
+	return privateKeyType
+}
+
 func (t *TestContractType) NewTestContract(
 	inter *interpreter.Interpreter,
 	testFramework TestFramework,
@@ -1323,20 +3188,51 @@ func (t *TestContractType) NewTestContract(
 	compositeValue.Functions.Set(testTypeAssertEqualFunctionName, testTypeAssertEqualFunction(inter, compositeValue))
 	compositeValue.Functions.Set(testTypeFailFunctionName, testTypeFailFunction(inter, compositeValue))
 	compositeValue.Functions.Set(testTypeExpectFunctionName, t.expectFunction(inter, compositeValue))
+	compositeValue.Functions.Set(testTypeAssertThatFunctionName, t.assertThatFunction(inter, compositeValue))
 	compositeValue.Functions.Set(
 		testTypeReadFileFunctionName,
 		newTestTypeReadFileFunction(testFramework, inter, compositeValue),
 	)
+	compositeValue.Functions.Set(
+		testTypeWriteFileFunctionName,
+		newTestTypeWriteFileFunction(testFramework, inter, compositeValue),
+	)
+	compositeValue.Functions.Set(
+		testTypeListFilesFunctionName,
+		newTestTypeListFilesFunction(testFramework, inter, compositeValue),
+	)
+	compositeValue.Functions.Set(
+		testTypeCreateKeyFunctionName,
+		newTestTypeCreateKeyFunction(testFramework, t.createKeyFunctionType, inter, compositeValue),
+	)
+	compositeValue.Functions.Set(
+		testTypeSignFunctionName,
+		newTestTypeSignFunction(testFramework, t.signFunctionType, inter, compositeValue),
+	)
 
 	// Inject natively implemented matchers
 	compositeValue.Functions.Set(testTypeNewMatcherFunctionName, t.newMatcherFunction(inter, compositeValue))
+	compositeValue.Functions.Set(testTypeSatisfyFunctionName, t.satisfyFunction(inter, compositeValue))
+	compositeValue.Functions.Set(testTypeAnyOfFunctionName, t.anyOfFunction(inter, compositeValue))
+	compositeValue.Functions.Set(testTypeAllOfFunctionName, t.allOfFunction(inter, compositeValue))
 	compositeValue.Functions.Set(testTypeEqualFunctionName, t.equalFunction(inter, compositeValue))
 	compositeValue.Functions.Set(testTypeBeEmptyFunctionName, t.beEmptyFunction(inter, compositeValue))
 	compositeValue.Functions.Set(testTypeHaveElementCountFunctionName, t.haveElementCountFunction(inter, compositeValue))
 	compositeValue.Functions.Set(testTypeContainFunctionName, t.containFunction(inter, compositeValue))
+	compositeValue.Functions.Set(testTypeNotContainFunctionName, t.notContainFunction(inter, compositeValue))
 	compositeValue.Functions.Set(testTypeBeGreaterThanFunctionName, t.beGreaterThanFunction(inter, compositeValue))
 	compositeValue.Functions.Set(testTypeBeLessThanFunctionName, t.beLessThanFunction(inter, compositeValue))
 	compositeValue.Functions.Set(testExpectFailureFunctionName, t.expectFailureFunction(inter, compositeValue))
+	compositeValue.Functions.Set(testTypeEachFunctionName, newTestTypeEachFunction(inter, compositeValue))
+	compositeValue.Functions.Set(testTypeBeInstanceOfFunctionName, t.beInstanceOfFunction(inter, compositeValue))
+	compositeValue.Functions.Set(testTypeConformToFunctionName, t.conformToFunction(inter, compositeValue))
+	compositeValue.Functions.Set(testTypeMatchRegexFunctionName, t.matchRegexFunction(inter, compositeValue))
+	compositeValue.Functions.Set(testTypeBeCloseToFunctionName, t.beCloseToFunction(inter, compositeValue))
+	compositeValue.Functions.Set(testTypeBeSortedFunctionName, t.beSortedFunction(inter, compositeValue))
+	compositeValue.Functions.Set(testTypeBeSortedDescendingFunctionName, t.beSortedDescendingFunction(inter, compositeValue))
+	compositeValue.Functions.Set(testTypeBeResourceFunctionName, t.beResourceFunction(inter, compositeValue))
+	compositeValue.Functions.Set(testTypeBeStructFunctionName, t.beStructFunction(inter, compositeValue))
+	compositeValue.Functions.Set(testTypeHaveFieldFunctionName, t.haveFieldFunction(inter, compositeValue))
 
 	return compositeValue, nil
 }
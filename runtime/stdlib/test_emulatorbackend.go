@@ -47,7 +47,9 @@ type testEmulatorBackendType struct {
 	serviceAccountFunctionType         *sema.FunctionType
 	eventsFunctionType                 *sema.FunctionType
 	resetFunctionType                  *sema.FunctionType
+	resetToGenesisFunctionType         *sema.FunctionType
 	moveTimeFunctionType               *sema.FunctionType
+	getCurrentBlockHeightFunctionType  *sema.FunctionType
 	createSnapshotFunctionType         *sema.FunctionType
 	loadSnapshotFunctionType           *sema.FunctionType
 	getAccountFunctionType             *sema.FunctionType
@@ -106,11 +108,21 @@ func newTestEmulatorBackendType(
 		testEmulatorBackendTypeResetFunctionName,
 	)
 
+	resetToGenesisFunctionType := interfaceFunctionType(
+		blockchainBackendInterfaceType,
+		testEmulatorBackendTypeResetToGenesisFunctionName,
+	)
+
 	moveTimeFunctionType := interfaceFunctionType(
 		blockchainBackendInterfaceType,
 		testEmulatorBackendTypeMoveTimeFunctionName,
 	)
 
+	getCurrentBlockHeightFunctionType := interfaceFunctionType(
+		blockchainBackendInterfaceType,
+		testEmulatorBackendTypeGetCurrentBlockHeightFunctionName,
+	)
+
 	createSnapshotFunctionType := interfaceFunctionType(
 		blockchainBackendInterfaceType,
 		testEmulatorBackendTypeCreateSnapshotFunctionName,
@@ -196,12 +208,24 @@ func newTestEmulatorBackendType(
 			resetFunctionType,
 			testEmulatorBackendTypeResetFunctionDocString,
 		),
+		sema.NewUnmeteredPublicFunctionMember(
+			compositeType,
+			testEmulatorBackendTypeResetToGenesisFunctionName,
+			resetToGenesisFunctionType,
+			testEmulatorBackendTypeResetToGenesisFunctionDocString,
+		),
 		sema.NewUnmeteredPublicFunctionMember(
 			compositeType,
 			testEmulatorBackendTypeMoveTimeFunctionName,
 			moveTimeFunctionType,
 			testEmulatorBackendTypeMoveTimeFunctionDocString,
 		),
+		sema.NewUnmeteredPublicFunctionMember(
+			compositeType,
+			testEmulatorBackendTypeGetCurrentBlockHeightFunctionName,
+			getCurrentBlockHeightFunctionType,
+			testEmulatorBackendTypeGetCurrentBlockHeightFunctionDocString,
+		),
 		sema.NewUnmeteredPublicFunctionMember(
 			compositeType,
 			testEmulatorBackendTypeCreateSnapshotFunctionName,
@@ -237,7 +261,9 @@ func newTestEmulatorBackendType(
 		serviceAccountFunctionType:         serviceAccountFunctionType,
 		eventsFunctionType:                 eventsFunctionType,
 		resetFunctionType:                  resetFunctionType,
+		resetToGenesisFunctionType:         resetToGenesisFunctionType,
 		moveTimeFunctionType:               moveTimeFunctionType,
+		getCurrentBlockHeightFunctionType:  getCurrentBlockHeightFunctionType,
 		createSnapshotFunctionType:         createSnapshotFunctionType,
 		loadSnapshotFunctionType:           loadSnapshotFunctionType,
 		getAccountFunctionType:             getAccountFunctionType,
@@ -318,6 +344,7 @@ func (t *testEmulatorBackendType) newCreateAccountFunction(
 				inter,
 				locationRange,
 				account,
+				blockchain,
 			)
 		},
 	)
@@ -327,6 +354,7 @@ func newTestAccountValue(
 	inter *interpreter.Interpreter,
 	locationRange interpreter.LocationRange,
 	account *Account,
+	hasher Hasher,
 ) interpreter.Value {
 
 	// Create address value
@@ -338,6 +366,27 @@ func newTestAccountValue(
 		account.PublicKey,
 	)
 
+	keyValues := make([]interpreter.Value, 0, len(account.Keys))
+	for _, key := range account.Keys {
+		keyValues = append(
+			keyValues,
+			NewAccountKeyValue(
+				inter,
+				locationRange,
+				key,
+				hasher,
+			),
+		)
+	}
+
+	keys := interpreter.NewArrayValue(
+		inter,
+		locationRange,
+		interpreter.NewVariableSizedStaticType(inter, interpreter.AccountKeyStaticType),
+		common.ZeroAddress,
+		keyValues...,
+	)
+
 	// Create an 'Account' by calling its constructor.
 	accountConstructor := getConstructor(inter, testAccountTypeName)
 	accountValue, err := inter.InvokeExternally(
@@ -346,6 +395,7 @@ func newTestAccountValue(
 		[]interpreter.Value{
 			address,
 			publicKey,
+			keys,
 		},
 	)
 
@@ -395,6 +445,7 @@ func (t *testEmulatorBackendType) newGetAccountFunction(
 				inter,
 				locationRange,
 				account,
+				blockchain,
 			)
 		},
 	)
@@ -557,6 +608,7 @@ const testEmulatorBackendTypeDeployContractFunctionName = "deployContract"
 
 const testEmulatorBackendTypeDeployContractFunctionDocString = `
 Deploys a given contract, and initializes it with the provided arguments.
+Returns the result of the deployment.
 `
 
 func (t *testEmulatorBackendType) newDeployContractFunction(
@@ -593,14 +645,14 @@ func (t *testEmulatorBackendType) newDeployContractFunction(
 				panic(err)
 			}
 
-			err = blockchain.DeployContract(
+			address, err := blockchain.DeployContract(
 				inter,
 				name.Str,
 				path.Str,
 				args,
 			)
 
-			return newErrorValue(inter, err)
+			return newContractDeploymentResult(inter, address, name.Str, err)
 		},
 	)
 }
@@ -685,6 +737,7 @@ func (t *testEmulatorBackendType) newServiceAccountFunction(
 				invocation.Interpreter,
 				invocation.LocationRange,
 				serviceAccount,
+				blockchain,
 			)
 		},
 	)
@@ -759,6 +812,32 @@ func (t *testEmulatorBackendType) newResetFunction(
 	)
 }
 
+// 'EmulatorBackend.resetToGenesis' function
+
+const testEmulatorBackendTypeResetToGenesisFunctionName = "resetToGenesis"
+
+const testEmulatorBackendTypeResetToGenesisFunctionDocString = `
+Resets the state of the blockchain to its genesis state,
+removing all created accounts, their storage, and all blocks.
+Snapshots are not affected.
+`
+
+func (t *testEmulatorBackendType) newResetToGenesisFunction(
+	inter *interpreter.Interpreter,
+	emulatorBackend interpreter.MemberAccessibleValue,
+	blockchain Blockchain,
+) interpreter.BoundFunctionValue {
+	return interpreter.NewUnmeteredBoundHostFunctionValue(
+		inter,
+		emulatorBackend,
+		t.resetToGenesisFunctionType,
+		func(invocation interpreter.Invocation) interpreter.Value {
+			blockchain.ResetToGenesis()
+			return interpreter.Void
+		},
+	)
+}
+
 // 'Emulator.moveTime' function
 
 const testEmulatorBackendTypeMoveTimeFunctionName = "moveTime"
@@ -788,6 +867,32 @@ func (t *testEmulatorBackendType) newMoveTimeFunction(
 	)
 }
 
+// 'Emulator.getCurrentBlockHeight' function
+
+const testEmulatorBackendTypeGetCurrentBlockHeightFunctionName = "getCurrentBlockHeight"
+
+const testEmulatorBackendTypeGetCurrentBlockHeightFunctionDocString = `
+Returns the current block height of the blockchain.
+`
+
+func (t *testEmulatorBackendType) newGetCurrentBlockHeightFunction(
+	inter *interpreter.Interpreter,
+	emulatorBackend interpreter.MemberAccessibleValue,
+	blockchain Blockchain,
+) interpreter.BoundFunctionValue {
+	return interpreter.NewUnmeteredBoundHostFunctionValue(
+		inter,
+		emulatorBackend,
+		t.getCurrentBlockHeightFunctionType,
+		func(invocation interpreter.Invocation) interpreter.Value {
+			return interpreter.NewUInt64Value(
+				invocation.Interpreter,
+				blockchain.CurrentBlockHeight,
+			)
+		},
+	)
+}
+
 // 'Emulator.createSnapshot' function
 
 const testEmulatorBackendTypeCreateSnapshotFunctionName = "createSnapshot"
@@ -905,10 +1010,18 @@ func (t *testEmulatorBackendType) newEmulatorBackend(
 			Name:  testEmulatorBackendTypeResetFunctionName,
 			Value: t.newResetFunction(inter, emulatorBackend, blockchain),
 		},
+		{
+			Name:  testEmulatorBackendTypeResetToGenesisFunctionName,
+			Value: t.newResetToGenesisFunction(inter, emulatorBackend, blockchain),
+		},
 		{
 			Name:  testEmulatorBackendTypeMoveTimeFunctionName,
 			Value: t.newMoveTimeFunction(inter, emulatorBackend, blockchain),
 		},
+		{
+			Name:  testEmulatorBackendTypeGetCurrentBlockHeightFunctionName,
+			Value: t.newGetCurrentBlockHeightFunction(inter, emulatorBackend, blockchain),
+		},
 		{
 			Name:  testEmulatorBackendTypeCreateSnapshotFunctionName,
 			Value: t.newCreateSnapshotFunction(inter, emulatorBackend, blockchain),
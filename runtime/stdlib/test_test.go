@@ -20,12 +20,21 @@
 package stdlib
 
 import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
 	"errors"
 	"fmt"
+	"math/big"
+	"path/filepath"
+	"sort"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/sha3"
 
 	"github.com/onflow/cadence/runtime/activations"
 	"github.com/onflow/cadence/runtime/ast"
@@ -51,6 +60,15 @@ func newTestContractInterpreterWithTestFramework(
 	t *testing.T,
 	code string,
 	testFramework TestFramework,
+) (*interpreter.Interpreter, error) {
+	return newTestContractInterpreterWithMemoryGauge(t, code, testFramework, nil)
+}
+
+func newTestContractInterpreterWithMemoryGauge(
+	t *testing.T,
+	code string,
+	testFramework TestFramework,
+	memoryGauge common.MemoryGauge,
 ) (*interpreter.Interpreter, error) {
 	program, err := parser.ParseProgram(
 		nil,
@@ -59,9 +77,13 @@ func newTestContractInterpreterWithTestFramework(
 	)
 	require.NoError(t, err)
 
+	hashAlgorithmConstructor := NewHashAlgorithmConstructor(testCryptoHandler{})
+
 	baseValueActivation := sema.NewVariableActivation(sema.BaseValueActivation)
 	baseValueActivation.DeclareValue(AssertFunction)
 	baseValueActivation.DeclareValue(PanicFunction)
+	baseValueActivation.DeclareValue(SignatureAlgorithmConstructor)
+	baseValueActivation.DeclareValue(hashAlgorithmConstructor)
 
 	checker, err := sema.NewChecker(
 		program,
@@ -105,12 +127,15 @@ func newTestContractInterpreterWithTestFramework(
 	baseActivation := activations.NewActivation(nil, interpreter.BaseActivation)
 	interpreter.Declare(baseActivation, AssertFunction)
 	interpreter.Declare(baseActivation, PanicFunction)
+	interpreter.Declare(baseActivation, SignatureAlgorithmConstructor)
+	interpreter.Declare(baseActivation, hashAlgorithmConstructor)
 
 	inter, err := interpreter.NewInterpreter(
 		interpreter.ProgramFromChecker(checker),
 		checker.Location,
 		&interpreter.Config{
-			Storage: storage,
+			Storage:     storage,
+			MemoryGauge: memoryGauge,
 			BaseActivationHandler: func(_ common.Location) *interpreter.VariableActivation {
 				return baseActivation
 			},
@@ -129,6 +154,16 @@ func newTestContractInterpreterWithTestFramework(
 				return nil
 			},
 			ContractValueHandler: NewTestInterpreterContractValueHandler(testFramework),
+			CompositeValueFunctionsHandler: func(
+				inter *interpreter.Interpreter,
+				_ interpreter.LocationRange,
+				compositeValue *interpreter.CompositeValue,
+			) *interpreter.FunctionOrderedMap {
+				if compositeValue.TypeID() != sema.PublicKeyType.ID() {
+					return nil
+				}
+				return PublicKeyFunctions(inter, compositeValue, testCryptoHandler{})
+			},
 			UUIDHandler: func() (uint64, error) {
 				uuid++
 				return uuid, nil
@@ -372,6 +407,247 @@ func TestTestNewMatcher(t *testing.T) {
 	})
 }
 
+func TestTestSatisfyMatcher(t *testing.T) {
+	t.Parallel()
+
+	t.Run("satisfied", func(t *testing.T) {
+		t.Parallel()
+
+		script := `
+           import Test
+
+           access(all)
+           fun test(): Bool {
+
+               let isEven = Test.satisfy(fun (_ value: Int): Bool {
+                    return value % 2 == 0
+               })
+
+               return isEven.test(4)
+           }
+        `
+
+		inter, err := newTestContractInterpreter(t, script)
+		require.NoError(t, err)
+
+		result, err := inter.Invoke("test")
+		require.NoError(t, err)
+		assert.Equal(t, interpreter.TrueValue, result)
+	})
+
+	t.Run("not satisfied", func(t *testing.T) {
+		t.Parallel()
+
+		script := `
+           import Test
+
+           access(all)
+           fun test(): Bool {
+
+               let isEven = Test.satisfy(fun (_ value: Int): Bool {
+                    return value % 2 == 0
+               })
+
+               return isEven.test(3)
+           }
+        `
+
+		inter, err := newTestContractInterpreter(t, script)
+		require.NoError(t, err)
+
+		result, err := inter.Invoke("test")
+		require.NoError(t, err)
+		assert.Equal(t, interpreter.FalseValue, result)
+	})
+
+	t.Run("invalid type usage", func(t *testing.T) {
+		t.Parallel()
+
+		script := `
+           import Test
+
+           access(all)
+           fun test() {
+
+               let isEven = Test.satisfy(fun (_ value: Int): Bool {
+                    return value % 2 == 0
+               })
+
+               // Invoke with an incorrect type
+               isEven.test("Hello")
+           }
+        `
+
+		inter, err := newTestContractInterpreter(t, script)
+		require.NoError(t, err)
+
+		_, err = inter.Invoke("test")
+		require.Error(t, err)
+		assert.ErrorAs(t, err, &interpreter.TypeMismatchError{})
+	})
+
+	t.Run("expect fail", func(t *testing.T) {
+		t.Parallel()
+
+		script := `
+           import Test
+
+           access(all)
+           fun test() {
+               let isEven = Test.satisfy(fun (_ value: Int): Bool {
+                    return value % 2 == 0
+               })
+
+               Test.expect(3, isEven)
+           }
+        `
+
+		inter, err := newTestContractInterpreter(t, script)
+		require.NoError(t, err)
+
+		_, err = inter.Invoke("test")
+		require.Error(t, err)
+
+		assertionErr := &AssertionError{}
+		assert.ErrorAs(t, err, assertionErr)
+		assert.Equal(t, "expected 3 to satisfy the predicate", assertionErr.Message)
+	})
+}
+
+func TestTestAnyOfMatcher(t *testing.T) {
+	t.Parallel()
+
+	script := `
+       import Test
+
+       access(all)
+       fun testEmpty(): Bool {
+           // No matcher succeeds for an empty list
+           let matcher = Test.anyOf([])
+           return matcher.test(1)
+       }
+
+       access(all)
+       fun testSingle(): Bool {
+           let matcher = Test.anyOf([Test.equal(1)])
+           return matcher.test(1) && !matcher.test(2)
+       }
+
+       access(all)
+       fun testMultiple(): Bool {
+           let matcher = Test.anyOf([Test.equal(1), Test.equal(2), Test.equal(3)])
+           return matcher.test(1) && matcher.test(3) && !matcher.test(4)
+       }
+
+       access(all)
+       fun testShortCircuit(): Bool {
+           let matcher = Test.anyOf([
+               Test.equal(1),
+               Test.satisfy(fun (_ value: String): Bool {
+                   return true
+               })
+           ])
+
+           // The second matcher would fail with a type mismatch, if it was tested
+           return matcher.test(1)
+       }
+
+       access(all)
+       fun testExpectFail() {
+           Test.expect(4, Test.anyOf([Test.equal(1), Test.equal(2)]))
+       }
+    `
+
+	inter, err := newTestContractInterpreter(t, script)
+	require.NoError(t, err)
+
+	result, err := inter.Invoke("testEmpty")
+	require.NoError(t, err)
+	assert.Equal(t, interpreter.FalseValue, result)
+
+	for _, name := range []string{"testSingle", "testMultiple", "testShortCircuit"} {
+		result, err = inter.Invoke(name)
+		require.NoError(t, err)
+		assert.Equal(t, interpreter.TrueValue, result, name)
+	}
+
+	_, err = inter.Invoke("testExpectFail")
+	require.Error(t, err)
+
+	assertionErr := &AssertionError{}
+	assert.ErrorAs(t, err, assertionErr)
+	assert.Equal(t, "expected 4 to match any of the matchers", assertionErr.Message)
+}
+
+func TestTestAllOfMatcher(t *testing.T) {
+	t.Parallel()
+
+	script := `
+       import Test
+
+       access(all)
+       fun testEmpty(): Bool {
+           // All matchers succeed for an empty list
+           let matcher = Test.allOf([])
+           return matcher.test(1)
+       }
+
+       access(all)
+       fun testSingle(): Bool {
+           let matcher = Test.allOf([Test.equal(1)])
+           return matcher.test(1) && !matcher.test(2)
+       }
+
+       access(all)
+       fun testMultiple(): Bool {
+           let matcher = Test.allOf([
+               Test.beGreaterThan(1),
+               Test.beLessThan(5),
+               Test.not(Test.equal(3))
+           ])
+           return matcher.test(2) && !matcher.test(3) && !matcher.test(6)
+       }
+
+       access(all)
+       fun testShortCircuit(): Bool {
+           let matcher = Test.allOf([
+               Test.equal(1),
+               Test.satisfy(fun (_ value: String): Bool {
+                   return true
+               })
+           ])
+
+           // The second matcher would fail with a type mismatch, if it was tested
+           return !matcher.test(2)
+       }
+
+       access(all)
+       fun testExpectFail() {
+           Test.expect(3, Test.allOf([Test.beGreaterThan(1), Test.beLessThan(2)]))
+       }
+    `
+
+	inter, err := newTestContractInterpreter(t, script)
+	require.NoError(t, err)
+
+	result, err := inter.Invoke("testEmpty")
+	require.NoError(t, err)
+	assert.Equal(t, interpreter.TrueValue, result)
+
+	for _, name := range []string{"testSingle", "testMultiple", "testShortCircuit"} {
+		result, err = inter.Invoke(name)
+		require.NoError(t, err)
+		assert.Equal(t, interpreter.TrueValue, result, name)
+	}
+
+	_, err = inter.Invoke("testExpectFail")
+	require.Error(t, err)
+
+	assertionErr := &AssertionError{}
+	assert.ErrorAs(t, err, assertionErr)
+	assert.Equal(t, "expected 3 to match all of the matchers", assertionErr.Message)
+}
+
 func TestTestEqualMatcher(t *testing.T) {
 
 	t.Parallel()
@@ -1046,109 +1322,82 @@ func TestAssertEqual(t *testing.T) {
 	})
 }
 
-func TestTestBeSucceededMatcher(t *testing.T) {
+func TestTestAssertThat(t *testing.T) {
 
 	t.Parallel()
 
-	t.Run("matcher beSucceeded with ScriptResult", func(t *testing.T) {
+	t.Run("isEqualTo", func(t *testing.T) {
 		t.Parallel()
 
 		script := `
             import Test
 
             access(all)
-            fun testMatch(): Bool {
-                let successful = Test.beSucceeded()
-
-                let scriptResult = Test.ScriptResult(
-                    status: Test.ResultStatus.succeeded,
-                    returnValue: 42,
-                    error: nil
-                )
-
-                return successful.test(scriptResult)
-            }
-
-            access(all)
-            fun testNoMatch(): Bool {
-                let successful = Test.beSucceeded()
-
-                let scriptResult = Test.ScriptResult(
-                    status: Test.ResultStatus.failed,
-                    returnValue: nil,
-                    error: Test.Error("Exceeding limit")
-                )
-
-                return successful.test(scriptResult)
+            fun test() {
+                Test.assertThat("this string").isEqualTo("this string")
             }
         `
 
 		inter, err := newTestContractInterpreter(t, script)
 		require.NoError(t, err)
 
-		result, err := inter.Invoke("testMatch")
-		require.NoError(t, err)
-		assert.Equal(t, interpreter.TrueValue, result)
-
-		result, err = inter.Invoke("testNoMatch")
+		_, err = inter.Invoke("test")
 		require.NoError(t, err)
-		assert.Equal(t, interpreter.FalseValue, result)
 	})
 
-	t.Run("matcher beSucceeded with TransactionResult", func(t *testing.T) {
+	t.Run("isEqualTo fail", func(t *testing.T) {
 		t.Parallel()
 
 		script := `
             import Test
 
             access(all)
-            fun testMatch(): Bool {
-                let successful = Test.beSucceeded()
+            fun test() {
+                Test.assertThat(21).isEqualTo(15)
+            }
+        `
 
-                let transactionResult = Test.TransactionResult(
-                    status: Test.ResultStatus.succeeded,
-                    error: nil
-                )
+		inter, err := newTestContractInterpreter(t, script)
+		require.NoError(t, err)
 
-                return successful.test(transactionResult)
-            }
+		_, err = inter.Invoke("test")
+		require.Error(t, err)
+		assert.ErrorAs(t, err, &AssertionError{})
+		assert.ErrorContains(
+			t,
+			err,
+			"assertion failed: not equal: expected: 15, actual: 21",
+		)
+	})
 
-            access(all)
-            fun testNoMatch(): Bool {
-                let successful = Test.beSucceeded()
+	t.Run("isGreaterThan", func(t *testing.T) {
+		t.Parallel()
 
-                let transactionResult = Test.TransactionResult(
-                    status: Test.ResultStatus.failed,
-                    error: Test.Error("Exceeded Limit")
-                )
+		script := `
+            import Test
 
-                return successful.test(transactionResult)
+            access(all)
+            fun test() {
+                Test.assertThat(21).isGreaterThan(15)
             }
         `
 
 		inter, err := newTestContractInterpreter(t, script)
 		require.NoError(t, err)
 
-		result, err := inter.Invoke("testMatch")
-		require.NoError(t, err)
-		assert.Equal(t, interpreter.TrueValue, result)
-
-		result, err = inter.Invoke("testNoMatch")
+		_, err = inter.Invoke("test")
 		require.NoError(t, err)
-		assert.Equal(t, interpreter.FalseValue, result)
 	})
 
-	t.Run("matcher beSucceeded with type mismatch", func(t *testing.T) {
+	t.Run("isGreaterThan fail", func(t *testing.T) {
 		t.Parallel()
 
 		script := `
             import Test
 
             access(all)
-            fun test(): Bool {
-                let successful = Test.beSucceeded()
-
-                return successful.test("hello")
+            fun test() {
+                Test.assertThat(15).isGreaterThan(21)
             }
         `
 
@@ -1157,50 +1406,291 @@ func TestTestBeSucceededMatcher(t *testing.T) {
 
 		_, err = inter.Invoke("test")
 		require.Error(t, err)
+		assert.ErrorAs(t, err, &AssertionError{})
+		assert.ErrorContains(
+			t,
+			err,
+			"assertion failed: not greater than: expected: greater than 21, actual: 15",
+		)
 	})
-}
-
-func TestTestBeFailedMatcher(t *testing.T) {
 
-	t.Parallel()
-
-	t.Run("matcher beFailed with ScriptResult", func(t *testing.T) {
+	t.Run("isGreaterThan fail with non-number", func(t *testing.T) {
 		t.Parallel()
 
 		script := `
             import Test
 
             access(all)
-            fun testMatch(): Bool {
-                let failed = Test.beFailed()
+            fun test() {
+                Test.assertThat("abc").isGreaterThan(21)
+            }
+        `
 
-                let scriptResult = Test.ScriptResult(
-                    status: Test.ResultStatus.failed,
-                    returnValue: nil,
-                    error: Test.Error("Exceeding limit")
-                )
+		inter, err := newTestContractInterpreter(t, script)
+		require.NoError(t, err)
 
-                return failed.test(scriptResult)
-            }
+		_, err = inter.Invoke("test")
+		require.Error(t, err)
+		assert.ErrorAs(t, err, &AssertionError{})
+		assert.ErrorContains(t, err, "assertion failed: not a number: \"abc\"")
+	})
 
-            access(all)
-            fun testNoMatch(): Bool {
-                let failed = Test.beFailed()
+	t.Run("isNil", func(t *testing.T) {
+		t.Parallel()
 
-                let scriptResult = Test.ScriptResult(
-                    status: Test.ResultStatus.succeeded,
-                    returnValue: 42,
-                    error: nil
-                )
+		script := `
+            import Test
 
-                return failed.test(scriptResult)
+            access(all)
+            fun test() {
+                let value: Int? = nil
+                Test.assertThat(value).isNil()
             }
         `
 
 		inter, err := newTestContractInterpreter(t, script)
 		require.NoError(t, err)
 
-		result, err := inter.Invoke("testMatch")
+		_, err = inter.Invoke("test")
+		require.NoError(t, err)
+	})
+
+	t.Run("isNil fail", func(t *testing.T) {
+		t.Parallel()
+
+		script := `
+            import Test
+
+            access(all)
+            fun test() {
+                let value: Int? = 42
+                Test.assertThat(value).isNil()
+            }
+        `
+
+		inter, err := newTestContractInterpreter(t, script)
+		require.NoError(t, err)
+
+		_, err = inter.Invoke("test")
+		require.Error(t, err)
+		assert.ErrorAs(t, err, &AssertionError{})
+		assert.ErrorContains(t, err, "assertion failed: not nil: 42")
+	})
+
+	t.Run("chained", func(t *testing.T) {
+		t.Parallel()
+
+		script := `
+            import Test
+
+            access(all)
+            fun test() {
+                Test.assertThat(21)
+                    .isGreaterThan(15)
+                    .isEqualTo(21)
+            }
+        `
+
+		inter, err := newTestContractInterpreter(t, script)
+		require.NoError(t, err)
+
+		_, err = inter.Invoke("test")
+		require.NoError(t, err)
+	})
+
+	t.Run("chained fail", func(t *testing.T) {
+		t.Parallel()
+
+		script := `
+            import Test
+
+            access(all)
+            fun test() {
+                Test.assertThat(21)
+                    .isGreaterThan(15)
+                    .isEqualTo(15)
+            }
+        `
+
+		inter, err := newTestContractInterpreter(t, script)
+		require.NoError(t, err)
+
+		_, err = inter.Invoke("test")
+		require.Error(t, err)
+		assert.ErrorAs(t, err, &AssertionError{})
+		assert.ErrorContains(
+			t,
+			err,
+			"assertion failed: not equal: expected: 15, actual: 21",
+		)
+	})
+}
+
+func TestTestBeSucceededMatcher(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("matcher beSucceeded with ScriptResult", func(t *testing.T) {
+		t.Parallel()
+
+		script := `
+            import Test
+
+            access(all)
+            fun testMatch(): Bool {
+                let successful = Test.beSucceeded()
+
+                let scriptResult = Test.ScriptResult(
+                    status: Test.ResultStatus.succeeded,
+                    returnValue: 42,
+                    returnType: Type<Int>(),
+                    error: nil
+                )
+
+                return successful.test(scriptResult)
+            }
+
+            access(all)
+            fun testNoMatch(): Bool {
+                let successful = Test.beSucceeded()
+
+                let scriptResult = Test.ScriptResult(
+                    status: Test.ResultStatus.failed,
+                    returnValue: nil,
+                    returnType: nil,
+                    error: Test.Error("Exceeding limit")
+                )
+
+                return successful.test(scriptResult)
+            }
+        `
+
+		inter, err := newTestContractInterpreter(t, script)
+		require.NoError(t, err)
+
+		result, err := inter.Invoke("testMatch")
+		require.NoError(t, err)
+		assert.Equal(t, interpreter.TrueValue, result)
+
+		result, err = inter.Invoke("testNoMatch")
+		require.NoError(t, err)
+		assert.Equal(t, interpreter.FalseValue, result)
+	})
+
+	t.Run("matcher beSucceeded with TransactionResult", func(t *testing.T) {
+		t.Parallel()
+
+		script := `
+            import Test
+
+            access(all)
+            fun testMatch(): Bool {
+                let successful = Test.beSucceeded()
+
+                let transactionResult = Test.TransactionResult(
+                    status: Test.ResultStatus.succeeded,
+                    authorizers: [],
+                    signers: [],
+                    computationUsed: 0,
+                    error: nil
+                )
+
+                return successful.test(transactionResult)
+            }
+
+            access(all)
+            fun testNoMatch(): Bool {
+                let successful = Test.beSucceeded()
+
+                let transactionResult = Test.TransactionResult(
+                    status: Test.ResultStatus.failed,
+                    authorizers: [],
+                    signers: [],
+                    computationUsed: 0,
+                    error: Test.Error("Exceeded Limit")
+                )
+
+                return successful.test(transactionResult)
+            }
+        `
+
+		inter, err := newTestContractInterpreter(t, script)
+		require.NoError(t, err)
+
+		result, err := inter.Invoke("testMatch")
+		require.NoError(t, err)
+		assert.Equal(t, interpreter.TrueValue, result)
+
+		result, err = inter.Invoke("testNoMatch")
+		require.NoError(t, err)
+		assert.Equal(t, interpreter.FalseValue, result)
+	})
+
+	t.Run("matcher beSucceeded with type mismatch", func(t *testing.T) {
+		t.Parallel()
+
+		script := `
+            import Test
+
+            access(all)
+            fun test(): Bool {
+                let successful = Test.beSucceeded()
+
+                return successful.test("hello")
+            }
+        `
+
+		inter, err := newTestContractInterpreter(t, script)
+		require.NoError(t, err)
+
+		_, err = inter.Invoke("test")
+		require.Error(t, err)
+	})
+}
+
+func TestTestBeFailedMatcher(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("matcher beFailed with ScriptResult", func(t *testing.T) {
+		t.Parallel()
+
+		script := `
+            import Test
+
+            access(all)
+            fun testMatch(): Bool {
+                let failed = Test.beFailed()
+
+                let scriptResult = Test.ScriptResult(
+                    status: Test.ResultStatus.failed,
+                    returnValue: nil,
+                    returnType: nil,
+                    error: Test.Error("Exceeding limit")
+                )
+
+                return failed.test(scriptResult)
+            }
+
+            access(all)
+            fun testNoMatch(): Bool {
+                let failed = Test.beFailed()
+
+                let scriptResult = Test.ScriptResult(
+                    status: Test.ResultStatus.succeeded,
+                    returnValue: 42,
+                    returnType: Type<Int>(),
+                    error: nil
+                )
+
+                return failed.test(scriptResult)
+            }
+        `
+
+		inter, err := newTestContractInterpreter(t, script)
+		require.NoError(t, err)
+
+		result, err := inter.Invoke("testMatch")
 		require.NoError(t, err)
 		assert.Equal(t, interpreter.TrueValue, result)
 
@@ -1221,6 +1711,9 @@ func TestTestBeFailedMatcher(t *testing.T) {
 
                 let transactionResult = Test.TransactionResult(
                     status: Test.ResultStatus.failed,
+                    authorizers: [],
+                    signers: [],
+                    computationUsed: 0,
                     error: Test.Error("Exceeding limit")
                 )
 
@@ -1233,6 +1726,9 @@ func TestTestBeFailedMatcher(t *testing.T) {
 
                 let transactionResult = Test.TransactionResult(
                     status: Test.ResultStatus.succeeded,
+                    authorizers: [],
+                    signers: [],
+                    computationUsed: 0,
                     error: nil
                 )
 
@@ -1289,6 +1785,7 @@ func TestTestAssertErrorMatcher(t *testing.T) {
                 let result = Test.ScriptResult(
                     status: Test.ResultStatus.failed,
                     returnValue: nil,
+                    returnType: nil,
                     error: Test.Error("computation exceeding limit")
                 )
 
@@ -1300,6 +1797,7 @@ func TestTestAssertErrorMatcher(t *testing.T) {
                 let result = Test.ScriptResult(
                     status: Test.ResultStatus.failed,
                     returnValue: nil,
+                    returnType: nil,
                     error: Test.Error("computation exceeding memory")
                 )
 
@@ -1311,6 +1809,7 @@ func TestTestAssertErrorMatcher(t *testing.T) {
                 let result = Test.ScriptResult(
                     status: Test.ResultStatus.succeeded,
                     returnValue: 42,
+                    returnType: Type<Int>(),
                     error: nil
                 )
 
@@ -1343,6 +1842,9 @@ func TestTestAssertErrorMatcher(t *testing.T) {
             fun testMatch() {
                 let result = Test.TransactionResult(
                     status: Test.ResultStatus.failed,
+                    authorizers: [],
+                    signers: [],
+                    computationUsed: 0,
                     error: Test.Error("computation exceeding limit")
                 )
 
@@ -1353,6 +1855,9 @@ func TestTestAssertErrorMatcher(t *testing.T) {
             fun testNoMatch() {
                 let result = Test.TransactionResult(
                     status: Test.ResultStatus.failed,
+                    authorizers: [],
+                    signers: [],
+                    computationUsed: 0,
                     error: Test.Error("computation exceeding memory")
                 )
 
@@ -1363,6 +1868,9 @@ func TestTestAssertErrorMatcher(t *testing.T) {
             fun testNoError() {
                 let result = Test.TransactionResult(
                     status: Test.ResultStatus.succeeded,
+                    authorizers: [],
+                    signers: [],
+                    computationUsed: 0,
                     error: nil
                 )
 
@@ -1496,52 +2004,37 @@ func TestTestBeEmptyMatcher(t *testing.T) {
 		assert.Equal(t, interpreter.FalseValue, result)
 	})
 
-	t.Run("matcher beEmpty with type mismatch", func(t *testing.T) {
+	t.Run("matcher beEmpty with String", func(t *testing.T) {
 		t.Parallel()
 
 		script := `
             import Test
 
             access(all)
-            fun test(): Bool {
-                let emptyDict = Test.beEmpty()
+            fun testMatch(): Bool {
+                let emptyString = Test.beEmpty()
 
-                return emptyDict.test("empty")
+                return emptyString.test("")
             }
-        `
-
-		inter, err := newTestContractInterpreter(t, script)
-		require.NoError(t, err)
-
-		_, err = inter.Invoke("test")
-		require.Error(t, err)
-		assert.ErrorAs(t, err, &cdcErrors.DefaultUserError{})
-		assert.ErrorContains(t, err, "expected Array or Dictionary argument")
-	})
-}
-
-func TestTestHaveElementCountMatcher(t *testing.T) {
-
-	t.Parallel()
 
-	t.Run("matcher haveElementCount with Array", func(t *testing.T) {
-		t.Parallel()
+            access(all)
+            fun testNoMatch(): Bool {
+                let emptyString = Test.beEmpty()
 
-		script := `
-            import Test
+                return emptyString.test("empty")
+            }
 
             access(all)
-            fun testMatch(): Bool {
-                let hasThreeElements = Test.haveElementCount(3)
+            fun testCharacter(): Bool {
+                let emptyString = Test.beEmpty()
+                let char: Character = "a"
 
-                return hasThreeElements.test([42, 23, 31])
+                return emptyString.test(char)
             }
 
             access(all)
-            fun testNoMatch(): Bool {
-                let hasThreeElements = Test.haveElementCount(3)
-
-                return hasThreeElements.test([42])
+            fun testExpect() {
+                Test.expect("", Test.beEmpty())
             }
         `
 
@@ -1555,10 +2048,78 @@ func TestTestHaveElementCountMatcher(t *testing.T) {
 		result, err = inter.Invoke("testNoMatch")
 		require.NoError(t, err)
 		assert.Equal(t, interpreter.FalseValue, result)
-	})
 
-	t.Run("matcher haveElementCount with Dictionary", func(t *testing.T) {
-		t.Parallel()
+		result, err = inter.Invoke("testCharacter")
+		require.NoError(t, err)
+		assert.Equal(t, interpreter.FalseValue, result)
+
+		_, err = inter.Invoke("testExpect")
+		require.NoError(t, err)
+	})
+
+	t.Run("matcher beEmpty with type mismatch", func(t *testing.T) {
+		t.Parallel()
+
+		script := `
+            import Test
+
+            access(all)
+            fun test(): Bool {
+                let emptyDict = Test.beEmpty()
+
+                return emptyDict.test(42)
+            }
+        `
+
+		inter, err := newTestContractInterpreter(t, script)
+		require.NoError(t, err)
+
+		_, err = inter.Invoke("test")
+		require.Error(t, err)
+		assert.ErrorAs(t, err, &cdcErrors.DefaultUserError{})
+		assert.ErrorContains(t, err, "expected Array, Dictionary, String, or Character argument")
+	})
+}
+
+func TestTestHaveElementCountMatcher(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("matcher haveElementCount with Array", func(t *testing.T) {
+		t.Parallel()
+
+		script := `
+            import Test
+
+            access(all)
+            fun testMatch(): Bool {
+                let hasThreeElements = Test.haveElementCount(3)
+
+                return hasThreeElements.test([42, 23, 31])
+            }
+
+            access(all)
+            fun testNoMatch(): Bool {
+                let hasThreeElements = Test.haveElementCount(3)
+
+                return hasThreeElements.test([42])
+            }
+        `
+
+		inter, err := newTestContractInterpreter(t, script)
+		require.NoError(t, err)
+
+		result, err := inter.Invoke("testMatch")
+		require.NoError(t, err)
+		assert.Equal(t, interpreter.TrueValue, result)
+
+		result, err = inter.Invoke("testNoMatch")
+		require.NoError(t, err)
+		assert.Equal(t, interpreter.FalseValue, result)
+	})
+
+	t.Run("matcher haveElementCount with Dictionary", func(t *testing.T) {
+		t.Parallel()
 
 		script := `
             import Test
@@ -1614,6 +2175,37 @@ func TestTestHaveElementCountMatcher(t *testing.T) {
 		assert.ErrorAs(t, err, &cdcErrors.DefaultUserError{})
 		assert.ErrorContains(t, err, "expected Array or Dictionary argument")
 	})
+
+	t.Run("matcher haveElementCount with Dictionary description", func(t *testing.T) {
+		t.Parallel()
+
+		script := `
+            import Test
+
+            access(all)
+            fun test() {
+                let dict: {String: [Int]} = {
+                    "e": [5],
+                    "c": [3],
+                    "a": [1],
+                    "d": [4],
+                    "b": [2]
+                }
+                Test.expect(dict, Test.haveElementCount(2))
+            }
+        `
+
+		const expectedMessage = `expected {"a": [1], "b": [2], "c": [3], "d": [4], "e": [5]} to have 2 elements`
+
+		for i := 0; i < 10; i++ {
+			inter, err := newTestContractInterpreter(t, script)
+			require.NoError(t, err)
+
+			_, err = inter.Invoke("test")
+			require.Error(t, err)
+			assert.ErrorContains(t, err, expectedMessage)
+		}
+	})
 }
 
 func TestTestContainMatcher(t *testing.T) {
@@ -1712,6 +2304,99 @@ func TestTestContainMatcher(t *testing.T) {
 	})
 }
 
+func TestTestNotContainMatcher(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("matcher notContain with Array", func(t *testing.T) {
+		t.Parallel()
+
+		script := `
+            import Test
+
+            access(all)
+            fun testMatch(): Bool {
+                let notContainsTwenty = Test.notContain(20)
+
+                return notContainsTwenty.test([42])
+            }
+
+            access(all)
+            fun testNoMatch(): Bool {
+                let notContainsTwenty = Test.notContain(20)
+
+                return notContainsTwenty.test([42, 20, 31])
+            }
+        `
+
+		inter, err := newTestContractInterpreter(t, script)
+		require.NoError(t, err)
+
+		result, err := inter.Invoke("testMatch")
+		require.NoError(t, err)
+		assert.Equal(t, interpreter.TrueValue, result)
+
+		result, err = inter.Invoke("testNoMatch")
+		require.NoError(t, err)
+		assert.Equal(t, interpreter.FalseValue, result)
+	})
+
+	t.Run("matcher notContain with Dictionary", func(t *testing.T) {
+		t.Parallel()
+
+		script := `
+            import Test
+
+            access(all)
+            fun testMatch(): Bool {
+                let notContainsFive = Test.notContain(5)
+                let dict: {Int: Bool} = {1: true, 0: false}
+
+                return notContainsFive.test(dict)
+            }
+
+            access(all)
+            fun testNoMatch(): Bool {
+                let notContainsFalse = Test.notContain(false)
+                let dict: {Bool: Int} = {true: 1, false: 0}
+
+                return notContainsFalse.test(dict)
+            }
+        `
+
+		inter, err := newTestContractInterpreter(t, script)
+		require.NoError(t, err)
+
+		result, err := inter.Invoke("testMatch")
+		require.NoError(t, err)
+		assert.Equal(t, interpreter.TrueValue, result)
+
+		result, err = inter.Invoke("testNoMatch")
+		require.NoError(t, err)
+		assert.Equal(t, interpreter.FalseValue, result)
+	})
+
+	t.Run("matcher notContain with description", func(t *testing.T) {
+		t.Parallel()
+
+		script := `
+            import Test
+
+            access(all)
+            fun test() {
+                Test.expect([42, 20], Test.notContain(20))
+            }
+        `
+
+		inter, err := newTestContractInterpreter(t, script)
+		require.NoError(t, err)
+
+		_, err = inter.Invoke("test")
+		require.Error(t, err)
+		assert.ErrorContains(t, err, "expected [42, 20] to not contain 20")
+	})
+}
+
 func TestTestBeGreaterThanMatcher(t *testing.T) {
 
 	t.Parallel()
@@ -1830,399 +2515,2333 @@ func TestTestBeLessThanMatcher(t *testing.T) {
 	})
 }
 
-func TestTestExpect(t *testing.T) {
+func TestTestBeInstanceOfMatcher(t *testing.T) {
 
 	t.Parallel()
 
-	t.Run("success", func(t *testing.T) {
+	t.Run("matcher beInstanceOf with own type", func(t *testing.T) {
 		t.Parallel()
 
 		script := `
-           import Test
+            import Test
 
-           access(all)
-           fun test() {
-               Test.expect("this string", Test.equal("this string"))
-           }
-        `
+            access(all)
+            struct interface I {}
 
-		inter, err := newTestContractInterpreter(t, script)
-		require.NoError(t, err)
+            access(all)
+            struct Foo: I {}
 
-		_, err = inter.Invoke("test")
-		require.NoError(t, err)
-	})
+            access(all)
+            struct Bar {}
 
-	t.Run("fail", func(t *testing.T) {
-		t.Parallel()
+            access(all)
+            fun testMatch(): Bool {
+                let isFoo = Test.beInstanceOf(Type<Foo>())
 
-		script := `
-           import Test
+                return isFoo.test(Foo())
+            }
 
-           access(all)
-           fun test() {
-               Test.expect("this string", Test.equal("other string"))
-           }
+            access(all)
+            fun testNoMatch(): Bool {
+                let isFoo = Test.beInstanceOf(Type<Foo>())
+
+                return isFoo.test(Bar())
+            }
         `
 
 		inter, err := newTestContractInterpreter(t, script)
 		require.NoError(t, err)
 
-		_, err = inter.Invoke("test")
-		require.Error(t, err)
+		result, err := inter.Invoke("testMatch")
+		require.NoError(t, err)
+		assert.Equal(t, interpreter.TrueValue, result)
 
-		assertionErr := &AssertionError{}
-		assert.ErrorAs(t, err, assertionErr)
-		assert.Equal(t, "given value is: \"this string\"", assertionErr.Message)
-		assert.Equal(t, "test", assertionErr.LocationRange.Location.String())
-		assert.Equal(t, 6, assertionErr.LocationRange.StartPosition().Line)
+		result, err = inter.Invoke("testNoMatch")
+		require.NoError(t, err)
+		assert.Equal(t, interpreter.FalseValue, result)
 	})
 
-	t.Run("different types", func(t *testing.T) {
+	t.Run("matcher beInstanceOf with supertype", func(t *testing.T) {
 		t.Parallel()
 
 		script := `
-           import Test
+            import Test
 
-           access(all)
-           fun test() {
-               Test.expect("string", Test.equal(1))
-           }
-        `
+            access(all)
+            struct interface I {}
 
-		inter, err := newTestContractInterpreter(t, script)
-		require.NoError(t, err)
+            access(all)
+            struct Foo: I {}
 
-		_, err = inter.Invoke("test")
-		require.Error(t, err)
-		assert.ErrorAs(t, err, &interpreter.TypeMismatchError{})
-	})
+            access(all)
+            struct Bar {}
 
-	t.Run("with explicit types", func(t *testing.T) {
-		t.Parallel()
+            access(all)
+            fun testMatchInterface(): Bool {
+                let isI = Test.beInstanceOf(Type<{I}>())
 
-		script := `
-           import Test
+                return isI.test(Foo())
+            }
 
-           access(all)
-           fun test() {
-               Test.expect<String>("hello", Test.equal("hello"))
-           }
+            access(all)
+            fun testMatchAnyStruct(): Bool {
+                let isAnyStruct = Test.beInstanceOf(Type<AnyStruct>())
+
+                return isAnyStruct.test(Foo())
+            }
+
+            access(all)
+            fun testNoMatch(): Bool {
+                let isI = Test.beInstanceOf(Type<{I}>())
+
+                return isI.test(Bar())
+            }
         `
 
 		inter, err := newTestContractInterpreter(t, script)
 		require.NoError(t, err)
 
-		_, err = inter.Invoke("test")
+		result, err := inter.Invoke("testMatchInterface")
+		require.NoError(t, err)
+		assert.Equal(t, interpreter.TrueValue, result)
+
+		result, err = inter.Invoke("testMatchAnyStruct")
+		require.NoError(t, err)
+		assert.Equal(t, interpreter.TrueValue, result)
+
+		result, err = inter.Invoke("testNoMatch")
 		require.NoError(t, err)
+		assert.Equal(t, interpreter.FalseValue, result)
 	})
+}
 
-	t.Run("mismatching types", func(t *testing.T) {
+func TestTestConformToMatcher(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("matcher conformTo with struct interface", func(t *testing.T) {
 		t.Parallel()
 
 		script := `
-           import Test
+            import Test
 
-           access(all)
-           fun test() {
-               Test.expect<Int>("string", Test.equal(1))
-           }
+            access(all)
+            struct interface I {}
+
+            access(all)
+            struct Foo: I {}
+
+            access(all)
+            struct Bar {}
+
+            access(all)
+            fun testMatch(): Bool {
+                let conformsToI = Test.conformTo(Type<{I}>())
+
+                return conformsToI.test(Foo())
+            }
+
+            access(all)
+            fun testNoMatch(): Bool {
+                let conformsToI = Test.conformTo(Type<{I}>())
+
+                return conformsToI.test(Bar())
+            }
         `
 
-		_, err := newTestContractInterpreter(t, script)
+		inter, err := newTestContractInterpreter(t, script)
+		require.NoError(t, err)
 
-		errs := checker.RequireCheckerErrors(t, err, 1)
+		result, err := inter.Invoke("testMatch")
+		require.NoError(t, err)
+		assert.Equal(t, interpreter.TrueValue, result)
 
-		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+		result, err = inter.Invoke("testNoMatch")
+		require.NoError(t, err)
+		assert.Equal(t, interpreter.FalseValue, result)
 	})
 
-	t.Run("resource with resource matcher", func(t *testing.T) {
+	t.Run("matcher conformTo with resource interface", func(t *testing.T) {
 		t.Parallel()
 
 		script := `
-           import Test
+            import Test
 
-           access(all)
-           fun test() {
-               let f1 <- create Foo()
-               let f2 <- create Foo()
-               Test.expect(<-f1, Test.equal(<-f2))
-           }
+            access(all)
+            resource interface I {}
 
-           access(all)
-           resource Foo {}
+            access(all)
+            resource Foo: I {}
+
+            access(all)
+            resource Bar {}
+
+            access(all)
+            fun testMatch(): Bool {
+                let conformsToI = Test.conformTo(Type<@{I}>())
+
+                let foo <- create Foo()
+                let matches = conformsToI.test(&foo as &Foo)
+                destroy foo
+                return matches
+            }
+
+            access(all)
+            fun testNoMatch(): Bool {
+                let conformsToI = Test.conformTo(Type<@{I}>())
+
+                let bar <- create Bar()
+                let matches = conformsToI.test(&bar as &Bar)
+                destroy bar
+                return matches
+            }
         `
 
-		_, err := newTestContractInterpreter(t, script)
+		inter, err := newTestContractInterpreter(t, script)
+		require.NoError(t, err)
 
-		errs := checker.RequireCheckerErrors(t, err, 2)
-		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
-		assert.IsType(t, &sema.TypeMismatchError{}, errs[1])
+		result, err := inter.Invoke("testMatch")
+		require.NoError(t, err)
+		assert.Equal(t, interpreter.TrueValue, result)
+
+		result, err = inter.Invoke("testNoMatch")
+		require.NoError(t, err)
+		assert.Equal(t, interpreter.FalseValue, result)
 	})
 
-	t.Run("resource with struct matcher", func(t *testing.T) {
+	t.Run("matcher conformTo with non-composite value", func(t *testing.T) {
 		t.Parallel()
 
 		script := `
-           import Test
+            import Test
 
-           access(all)
-           fun test() {
-               let foo <- create Foo()
-               let bar = Bar()
-               Test.expect(<-foo, Test.equal(bar))
-           }
+            access(all)
+            struct interface I {}
 
-           access(all)
-           resource Foo {}
-           access(all)
-           struct Bar {}
+            access(all)
+            fun test(): Bool {
+                let conformsToI = Test.conformTo(Type<{I}>())
+
+                return conformsToI.test(1)
+            }
         `
 
-		_, err := newTestContractInterpreter(t, script)
+		inter, err := newTestContractInterpreter(t, script)
+		require.NoError(t, err)
 
-		errs := checker.RequireCheckerErrors(t, err, 1)
-		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+		_, err = inter.Invoke("test")
+		require.Error(t, err)
+		assert.ErrorAs(t, err, &cdcErrors.DefaultUserError{})
+		assert.ErrorContains(t, err, "expected struct or resource argument, got `Int`")
 	})
 
-	t.Run("struct with resource matcher", func(t *testing.T) {
+	t.Run("matcher conformTo with non-interface type", func(t *testing.T) {
 		t.Parallel()
 
 		script := `
-           import Test
+            import Test
 
-           access(all)
-           fun test() {
-               let foo = Foo()
-               let bar <- create Bar()
-               Test.expect(foo, Test.equal(<-bar))
-           }
+            access(all)
+            struct Foo {}
 
-           access(all)
-           struct Foo {}
-           access(all)
-           resource Bar {}
+            access(all)
+            fun test(): Bool {
+                let conformsToFoo = Test.conformTo(Type<Foo>())
+
+                return conformsToFoo.test(Foo())
+            }
         `
 
-		_, err := newTestContractInterpreter(t, script)
+		inter, err := newTestContractInterpreter(t, script)
+		require.NoError(t, err)
 
-		errs := checker.RequireCheckerErrors(t, err, 1)
-		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+		_, err = inter.Invoke("test")
+		require.Error(t, err)
+		assert.ErrorAs(t, err, &cdcErrors.DefaultUserError{})
+		assert.ErrorContains(t, err, "expected interface type, got `S.test.Foo`")
 	})
 }
 
-func TestTestExpectFailure(t *testing.T) {
+func TestTestCompositeKindMatchers(t *testing.T) {
 
 	t.Parallel()
 
-	t.Run("expect failure with no failure found", func(t *testing.T) {
+	t.Run("matcher beResource", func(t *testing.T) {
 		t.Parallel()
 
 		script := `
             import Test
 
             access(all)
-            fun test() {
-                let foo = Foo(answer: 42)
-                Test.expectFailure(fun(): Void {
-                    foo.correctAnswer(42)
-                }, errorMessageSubstring: "wrong answer!")
-            }
+            resource R {}
 
             access(all)
-            struct Foo {
-                access(self) let answer: UInt8
+            struct S {}
 
-                init(answer: UInt8) {
-                    self.answer = answer
-                }
+            access(all)
+            fun testMatch(): Bool {
+                let isResource = Test.beResource()
 
-                access(all)
-                fun correctAnswer(_ input: UInt8): Bool {
-                    if self.answer != input {
-                        panic("wrong answer!")
-                    }
-                    return true
-                }
+                let r <- create R()
+                let matches = isResource.test(&r as &R)
+                destroy r
+                return matches
+            }
+
+            access(all)
+            fun testNoMatch(): Bool {
+                let isResource = Test.beResource()
+
+                return isResource.test(S())
             }
         `
 
 		inter, err := newTestContractInterpreter(t, script)
 		require.NoError(t, err)
 
-		_, err = inter.Invoke("test")
-		require.Error(t, err)
-		assert.ErrorContains(
-			t,
-			err,
-			"Expected a failure, but found none.",
-		)
+		result, err := inter.Invoke("testMatch")
+		require.NoError(t, err)
+		assert.Equal(t, interpreter.TrueValue, result)
+
+		result, err = inter.Invoke("testNoMatch")
+		require.NoError(t, err)
+		assert.Equal(t, interpreter.FalseValue, result)
 	})
 
-	t.Run("expect failure with matching error message", func(t *testing.T) {
+	t.Run("matcher beStruct", func(t *testing.T) {
 		t.Parallel()
 
 		script := `
             import Test
 
             access(all)
-            fun test() {
-                let foo = Foo(answer: 42)
-                Test.expectFailure(fun(): Void {
-                    foo.correctAnswer(43)
-                }, errorMessageSubstring: "wrong answer!")
-            }
+            resource R {}
 
             access(all)
-            struct Foo {
-                access(self) let answer: UInt8
+            struct S {}
 
-                init(answer: UInt8) {
-                    self.answer = answer
-                }
+            access(all)
+            fun testMatch(): Bool {
+                let isStruct = Test.beStruct()
 
-                access(all)
-                fun correctAnswer(_ input: UInt8): Bool {
-                    if self.answer != input {
-                        panic("wrong answer!")
-                    }
-                    return true
-                }
+                return isStruct.test(S())
+            }
+
+            access(all)
+            fun testNoMatch(): Bool {
+                let isStruct = Test.beStruct()
+
+                let r <- create R()
+                let matches = isStruct.test(&r as &R)
+                destroy r
+                return matches
             }
         `
 
 		inter, err := newTestContractInterpreter(t, script)
 		require.NoError(t, err)
 
-		_, err = inter.Invoke("test")
+		result, err := inter.Invoke("testMatch")
+		require.NoError(t, err)
+		assert.Equal(t, interpreter.TrueValue, result)
+
+		result, err = inter.Invoke("testNoMatch")
 		require.NoError(t, err)
+		assert.Equal(t, interpreter.FalseValue, result)
 	})
 
-	t.Run("expect failure with mismatching error message", func(t *testing.T) {
+	t.Run("matcher beStruct with non-composite value", func(t *testing.T) {
 		t.Parallel()
 
 		script := `
             import Test
 
             access(all)
-            fun test() {
-                let foo = Foo(answer: 42)
-                Test.expectFailure(fun(): Void {
-                    foo.correctAnswer(43)
-                }, errorMessageSubstring: "what is wrong?")
+            fun test(): Bool {
+                let isStruct = Test.beStruct()
+
+                return isStruct.test(1)
             }
+        `
 
-            access(all)
-            struct Foo {
-                access(self) let answer: UInt8
+		inter, err := newTestContractInterpreter(t, script)
+		require.NoError(t, err)
 
-                init(answer: UInt8) {
-                    self.answer = answer
-                }
+		_, err = inter.Invoke("test")
+		require.Error(t, err)
+		assert.ErrorAs(t, err, &cdcErrors.DefaultUserError{})
+		assert.ErrorContains(t, err, "expected struct or resource argument, got `Int`")
+	})
+}
 
-                access(all)
-                fun correctAnswer(_ input: UInt8): Bool {
-                    if self.answer != input {
-                        panic("wrong answer!")
-                    }
-                    return true
-                }
-            }
-        `
+type testMemoryGauge struct {
+	meter map[common.MemoryKind]uint64
+}
+
+func (g *testMemoryGauge) MeterMemory(usage common.MemoryUsage) error {
+	g.meter[usage.Kind] += usage.Amount
+	return nil
+}
+
+func TestTestMatcherMemoryMetering(t *testing.T) {
+
+	t.Parallel()
+
+	script := `
+        import Test
+
+        access(all)
+        fun withoutMatchers(): Bool {
+            return true
+        }
+
+        access(all)
+        fun withMatchers(): Bool {
+            let isStruct = Test.beStruct()
+            let isEmpty = Test.beEmpty()
+            return true
+        }
+    `
+
+	memoryGauge := &testMemoryGauge{
+		meter: map[common.MemoryKind]uint64{},
+	}
+
+	testFramework := &mockedTestFramework{
+		emulatorBackend: func() Blockchain {
+			return &mockedBlockchain{}
+		},
+	}
+
+	inter, err := newTestContractInterpreterWithMemoryGauge(t, script, testFramework, memoryGauge)
+	require.NoError(t, err)
+
+	invoke := func(name string) uint64 {
+		before := memoryGauge.meter[common.MemoryKindHostFunctionValue]
+
+		result, err := inter.Invoke(name)
+		require.NoError(t, err)
+		assert.Equal(t, interpreter.TrueValue, result)
+
+		return memoryGauge.meter[common.MemoryKindHostFunctionValue] - before
+	}
+
+	withoutMatchers := invoke("withoutMatchers")
+	withMatchers := invoke("withMatchers")
+
+	// At least the test function of both matchers, and the describe function of the beEmpty matcher
+	assert.GreaterOrEqual(t, withMatchers, withoutMatchers+3)
+}
+
+func TestTestMatchRegexMatcher(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("matcher matchRegex", func(t *testing.T) {
+		t.Parallel()
+
+		script := `
+            import Test
+
+            access(all)
+            fun testMatch(): Bool {
+                let isVersion = Test.matchRegex("v[0-9]+\\.[0-9]+")
+
+                return isVersion.test("v1.23")
+            }
+
+            access(all)
+            fun testNoMatch(): Bool {
+                let isVersion = Test.matchRegex("v[0-9]+\\.[0-9]+")
+
+                return isVersion.test("version v1.23")
+            }
+        `
+
+		inter, err := newTestContractInterpreter(t, script)
+		require.NoError(t, err)
+
+		result, err := inter.Invoke("testMatch")
+		require.NoError(t, err)
+		assert.Equal(t, interpreter.TrueValue, result)
+
+		result, err = inter.Invoke("testNoMatch")
+		require.NoError(t, err)
+		assert.Equal(t, interpreter.FalseValue, result)
+	})
+
+	t.Run("matcher matchRegex with invalid pattern", func(t *testing.T) {
+		t.Parallel()
+
+		script := `
+            import Test
+
+            access(all)
+            fun test() {
+                Test.matchRegex("v[0-9")
+            }
+        `
+
+		inter, err := newTestContractInterpreter(t, script)
+		require.NoError(t, err)
+
+		_, err = inter.Invoke("test")
+		require.Error(t, err)
+		assert.ErrorContains(t, err, "invalid regular expression \"v[0-9\"")
+	})
+
+	t.Run("matcher matchRegex with type mismatch", func(t *testing.T) {
+		t.Parallel()
+
+		script := `
+            import Test
+
+            access(all)
+            fun test(): Bool {
+                let isVersion = Test.matchRegex("v[0-9]+")
+
+                return isVersion.test(1)
+            }
+        `
+
+		inter, err := newTestContractInterpreter(t, script)
+		require.NoError(t, err)
+
+		_, err = inter.Invoke("test")
+		require.Error(t, err)
+		assert.ErrorContains(t, err, "expected String argument")
+	})
+}
+
+func TestTestBeCloseToMatcher(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("matcher beCloseTo with Fix64", func(t *testing.T) {
+		t.Parallel()
+
+		script := `
+            import Test
+
+            access(all)
+            fun testWithinDelta(): Bool {
+                let isCloseToOne = Test.beCloseTo(1.0, delta: 0.01)
+
+                return isCloseToOne.test(0.995)
+            }
+
+            access(all)
+            fun testAtDelta(): Bool {
+                let isCloseToOne = Test.beCloseTo(1.0, delta: 0.01)
+
+                return isCloseToOne.test(-0.99 + 1.98)
+                    && isCloseToOne.test(1.01)
+            }
+
+            access(all)
+            fun testOutsideDelta(): Bool {
+                let isCloseToOne = Test.beCloseTo(1.0, delta: 0.01)
+
+                return isCloseToOne.test(1.01000001)
+            }
+        `
+
+		inter, err := newTestContractInterpreter(t, script)
+		require.NoError(t, err)
+
+		result, err := inter.Invoke("testWithinDelta")
+		require.NoError(t, err)
+		assert.Equal(t, interpreter.TrueValue, result)
+
+		result, err = inter.Invoke("testAtDelta")
+		require.NoError(t, err)
+		assert.Equal(t, interpreter.TrueValue, result)
+
+		result, err = inter.Invoke("testOutsideDelta")
+		require.NoError(t, err)
+		assert.Equal(t, interpreter.FalseValue, result)
+	})
+
+	t.Run("matcher beCloseTo with UFix64", func(t *testing.T) {
+		t.Parallel()
+
+		script := `
+            import Test
+
+            access(all)
+            fun testAtDelta(): Bool {
+                let isCloseToOne = Test.beCloseTo(1.0, delta: 0.5)
+
+                let value: UFix64 = 0.5
+                return isCloseToOne.test(value)
+            }
+
+            access(all)
+            fun testOutsideDelta(): Bool {
+                let isCloseToOne = Test.beCloseTo(1.0, delta: 0.5)
+
+                let value: UFix64 = 1.50000001
+                return isCloseToOne.test(value)
+            }
+
+            access(all)
+            fun testNegative(): Bool {
+                let isCloseToZero = Test.beCloseTo(-0.1, delta: 0.2)
+
+                let value: UFix64 = 0.1
+                return isCloseToZero.test(value)
+            }
+        `
+
+		inter, err := newTestContractInterpreter(t, script)
+		require.NoError(t, err)
+
+		result, err := inter.Invoke("testAtDelta")
+		require.NoError(t, err)
+		assert.Equal(t, interpreter.TrueValue, result)
+
+		result, err = inter.Invoke("testOutsideDelta")
+		require.NoError(t, err)
+		assert.Equal(t, interpreter.FalseValue, result)
+
+		result, err = inter.Invoke("testNegative")
+		require.NoError(t, err)
+		assert.Equal(t, interpreter.TrueValue, result)
+	})
+
+	t.Run("matcher beCloseTo with type mismatch", func(t *testing.T) {
+		t.Parallel()
+
+		script := `
+            import Test
+
+            access(all)
+            fun test(): Bool {
+                let isCloseToOne = Test.beCloseTo(1.0, delta: 0.01)
+
+                return isCloseToOne.test(1)
+            }
+        `
+
+		inter, err := newTestContractInterpreter(t, script)
+		require.NoError(t, err)
+
+		_, err = inter.Invoke("test")
+		require.Error(t, err)
+		assert.ErrorContains(t, err, "expected Fix64 or UFix64 argument")
+	})
+}
+
+func TestTestBeSortedMatcher(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("matcher beSorted", func(t *testing.T) {
+		t.Parallel()
+
+		script := `
+            import Test
+
+            access(all)
+            fun testSorted(): Bool {
+                return Test.beSorted().test([1, 2, 2, 3])
+            }
+
+            access(all)
+            fun testUnsorted(): Bool {
+                return Test.beSorted().test([1, 3, 2])
+            }
+
+            access(all)
+            fun testEmpty(): Bool {
+                let array: [Int] = []
+                return Test.beSorted().test(array)
+            }
+
+            access(all)
+            fun testSingleElement(): Bool {
+                return Test.beSorted().test(["a"])
+            }
+        `
+
+		inter, err := newTestContractInterpreter(t, script)
+		require.NoError(t, err)
+
+		result, err := inter.Invoke("testSorted")
+		require.NoError(t, err)
+		assert.Equal(t, interpreter.TrueValue, result)
+
+		result, err = inter.Invoke("testUnsorted")
+		require.NoError(t, err)
+		assert.Equal(t, interpreter.FalseValue, result)
+
+		result, err = inter.Invoke("testEmpty")
+		require.NoError(t, err)
+		assert.Equal(t, interpreter.TrueValue, result)
+
+		result, err = inter.Invoke("testSingleElement")
+		require.NoError(t, err)
+		assert.Equal(t, interpreter.TrueValue, result)
+	})
+
+	t.Run("matcher beSortedDescending", func(t *testing.T) {
+		t.Parallel()
+
+		script := `
+            import Test
+
+            access(all)
+            fun testSorted(): Bool {
+                return Test.beSortedDescending().test(["c", "b", "b", "a"])
+            }
+
+            access(all)
+            fun testUnsorted(): Bool {
+                return Test.beSortedDescending().test(["a", "b"])
+            }
+        `
+
+		inter, err := newTestContractInterpreter(t, script)
+		require.NoError(t, err)
+
+		result, err := inter.Invoke("testSorted")
+		require.NoError(t, err)
+		assert.Equal(t, interpreter.TrueValue, result)
+
+		result, err = inter.Invoke("testUnsorted")
+		require.NoError(t, err)
+		assert.Equal(t, interpreter.FalseValue, result)
+	})
+
+	t.Run("matcher beSorted with non-array", func(t *testing.T) {
+		t.Parallel()
+
+		script := `
+            import Test
+
+            access(all)
+            fun test(): Bool {
+                return Test.beSorted().test(1)
+            }
+        `
+
+		inter, err := newTestContractInterpreter(t, script)
+		require.NoError(t, err)
+
+		_, err = inter.Invoke("test")
+		require.Error(t, err)
+		assert.ErrorContains(t, err, "expected Array argument")
+	})
+
+	t.Run("matcher beSorted with non-comparable elements", func(t *testing.T) {
+		t.Parallel()
+
+		script := `
+            import Test
+
+            access(all)
+            fun test(): Bool {
+                return Test.beSorted().test([[1], [2]])
+            }
+        `
+
+		inter, err := newTestContractInterpreter(t, script)
+		require.NoError(t, err)
+
+		_, err = inter.Invoke("test")
+		require.Error(t, err)
+		assert.ErrorContains(t, err, "expected array elements to be comparable")
+	})
+}
+
+func TestTestHaveFieldMatcher(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("matcher haveField", func(t *testing.T) {
+		t.Parallel()
+
+		script := `
+            import Test
+
+            access(all)
+            struct Point {
+                access(all) let x: Int
+                access(all) let y: Int
+
+                init(x: Int, y: Int) {
+                    self.x = x
+                    self.y = y
+                }
+            }
+
+            access(all)
+            fun testMatch(): Bool {
+                return Test.haveField("x", value: 1).test(Point(x: 1, y: 2))
+            }
+
+            access(all)
+            fun testNoMatch(): Bool {
+                return Test.haveField("y", value: 1).test(Point(x: 1, y: 2))
+            }
+
+            access(all)
+            fun testTypeMismatch(): Bool {
+                return Test.haveField("x", value: "1").test(Point(x: 1, y: 2))
+            }
+        `
+
+		inter, err := newTestContractInterpreter(t, script)
+		require.NoError(t, err)
+
+		result, err := inter.Invoke("testMatch")
+		require.NoError(t, err)
+		assert.Equal(t, interpreter.TrueValue, result)
+
+		result, err = inter.Invoke("testNoMatch")
+		require.NoError(t, err)
+		assert.Equal(t, interpreter.FalseValue, result)
+
+		result, err = inter.Invoke("testTypeMismatch")
+		require.NoError(t, err)
+		assert.Equal(t, interpreter.FalseValue, result)
+	})
+
+	t.Run("matcher haveField with resource reference", func(t *testing.T) {
+		t.Parallel()
+
+		script := `
+            import Test
+
+            access(all)
+            resource R {
+                access(all) let id: UInt64
+
+                init(id: UInt64) {
+                    self.id = id
+                }
+            }
+
+            access(all)
+            fun test(): Bool {
+                let r <- create R(id: 42)
+                let matches = Test.haveField("id", value: 42 as UInt64).test(&r as &R)
+                destroy r
+                return matches
+            }
+        `
+
+		inter, err := newTestContractInterpreter(t, script)
+		require.NoError(t, err)
+
+		result, err := inter.Invoke("test")
+		require.NoError(t, err)
+		assert.Equal(t, interpreter.TrueValue, result)
+	})
+
+	t.Run("matcher haveField with inaccessible field", func(t *testing.T) {
+		t.Parallel()
+
+		script := `
+            import Test
+
+            access(all)
+            struct Point {
+                access(self) let x: Int
+
+                init(x: Int) {
+                    self.x = x
+                }
+            }
+
+            access(all)
+            fun test(): Bool {
+                return Test.haveField("x", value: 1).test(Point(x: 1))
+            }
+        `
+
+		inter, err := newTestContractInterpreter(t, script)
+		require.NoError(t, err)
+
+		_, err = inter.Invoke("test")
+		require.Error(t, err)
+		assert.ErrorContains(t, err, "field 'x' is not accessible")
+	})
+
+	t.Run("matcher haveField with entitled field", func(t *testing.T) {
+		t.Parallel()
+
+		script := `
+            import Test
+
+            access(all)
+            entitlement E
+
+            access(all)
+            resource R {
+                access(E) let id: UInt64
+
+                init(id: UInt64) {
+                    self.id = id
+                }
+            }
+
+            access(all)
+            fun testAuthorized(): Bool {
+                let r <- create R(id: 42)
+                let matches = Test.haveField("id", value: 42 as UInt64).test(&r as auth(E) &R)
+                destroy r
+                return matches
+            }
+
+            access(all)
+            fun testUnauthorized(): Bool {
+                let r <- create R(id: 42)
+                let matches = Test.haveField("id", value: 42 as UInt64).test(&r as &R)
+                destroy r
+                return matches
+            }
+        `
+
+		inter, err := newTestContractInterpreter(t, script)
+		require.NoError(t, err)
+
+		result, err := inter.Invoke("testAuthorized")
+		require.NoError(t, err)
+		assert.Equal(t, interpreter.TrueValue, result)
+
+		_, err = inter.Invoke("testUnauthorized")
+		require.Error(t, err)
+		assert.ErrorContains(t, err, "field 'id' is not accessible")
+	})
+
+	t.Run("matcher haveField with missing field", func(t *testing.T) {
+		t.Parallel()
+
+		script := `
+            import Test
+
+            access(all)
+            struct Point {
+                access(all) let x: Int
+
+                init(x: Int) {
+                    self.x = x
+                }
+            }
+
+            access(all)
+            fun test(): Bool {
+                return Test.haveField("z", value: 1).test(Point(x: 1))
+            }
+        `
+
+		inter, err := newTestContractInterpreter(t, script)
+		require.NoError(t, err)
+
+		_, err = inter.Invoke("test")
+		require.Error(t, err)
+		assert.ErrorContains(t, err, "value has no field 'z'")
+	})
+
+	t.Run("matcher haveField with non-composite", func(t *testing.T) {
+		t.Parallel()
+
+		script := `
+            import Test
+
+            access(all)
+            fun test(): Bool {
+                return Test.haveField("x", value: 1).test(true)
+            }
+        `
+
+		inter, err := newTestContractInterpreter(t, script)
+		require.NoError(t, err)
+
+		_, err = inter.Invoke("test")
+		require.Error(t, err)
+		assert.ErrorContains(t, err, "expected struct, resource, or reference argument")
+	})
+}
+
+func TestTestExpect(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		script := `
+           import Test
+
+           access(all)
+           fun test() {
+               Test.expect("this string", Test.equal("this string"))
+           }
+        `
+
+		inter, err := newTestContractInterpreter(t, script)
+		require.NoError(t, err)
+
+		_, err = inter.Invoke("test")
+		require.NoError(t, err)
+	})
+
+	t.Run("fail", func(t *testing.T) {
+		t.Parallel()
+
+		script := `
+           import Test
+
+           access(all)
+           fun test() {
+               Test.expect("this string", Test.equal("other string"))
+           }
+        `
+
+		inter, err := newTestContractInterpreter(t, script)
+		require.NoError(t, err)
+
+		_, err = inter.Invoke("test")
+		require.Error(t, err)
+
+		assertionErr := &AssertionError{}
+		assert.ErrorAs(t, err, assertionErr)
+		assert.Equal(t, "expected \"this string\" to equal \"other string\"", assertionErr.Message)
+		assert.Equal(t, "test", assertionErr.LocationRange.Location.String())
+		assert.Equal(t, 6, assertionErr.LocationRange.StartPosition().Line)
+	})
+
+	t.Run("fail with message", func(t *testing.T) {
+		t.Parallel()
+
+		script := `
+           import Test
+
+           access(all)
+           fun test() {
+               Test.expect("this string", Test.equal("other string"), message: "unexpected greeting")
+           }
+        `
+
+		inter, err := newTestContractInterpreter(t, script)
+		require.NoError(t, err)
+
+		_, err = inter.Invoke("test")
+		require.Error(t, err)
+
+		assertionErr := &AssertionError{}
+		assert.ErrorAs(t, err, assertionErr)
+		assert.Equal(t,
+			"unexpected greeting: expected \"this string\" to equal \"other string\"",
+			assertionErr.Message,
+		)
+	})
+
+	t.Run("success with message", func(t *testing.T) {
+		t.Parallel()
+
+		script := `
+           import Test
+
+           access(all)
+           fun test() {
+               Test.expect("this string", Test.equal("this string"), message: "unexpected greeting")
+           }
+        `
+
+		inter, err := newTestContractInterpreter(t, script)
+		require.NoError(t, err)
+
+		_, err = inter.Invoke("test")
+		require.NoError(t, err)
+	})
+
+	t.Run("fail location is expect call site", func(t *testing.T) {
+		t.Parallel()
+
+		script := `
+           import Test
+
+           access(all)
+           let isPositive = Test.newMatcher(fun (_ value: Int): Bool {
+               return value > 0
+           })
+
+           access(all)
+           fun test() {
+               Test.expect(1, isPositive)
+               Test.expect(-1, Test.not(isPositive))
+               Test.expect(-1, isPositive)
+           }
+        `
+
+		inter, err := newTestContractInterpreter(t, script)
+		require.NoError(t, err)
+
+		_, err = inter.Invoke("test")
+		require.Error(t, err)
+
+		var assertionErr AssertionError
+		require.ErrorAs(t, err, &assertionErr)
+
+		assert.Equal(t, "test", assertionErr.LocationRange.Location.String())
+		assert.Equal(t,
+			ast.Position{Offset: 323, Line: 13, Column: 15},
+			assertionErr.LocationRange.StartPosition(),
+		)
+		assert.Equal(t,
+			ast.Position{Offset: 349, Line: 13, Column: 41},
+			assertionErr.LocationRange.EndPosition(nil),
+		)
+	})
+
+	t.Run("fail with integers", func(t *testing.T) {
+		t.Parallel()
+
+		script := `
+           import Test
+
+           access(all)
+           fun test() {
+               Test.expect(1, Test.equal(2))
+           }
+        `
+
+		inter, err := newTestContractInterpreter(t, script)
+		require.NoError(t, err)
+
+		_, err = inter.Invoke("test")
+		require.Error(t, err)
+
+		assertionErr := &AssertionError{}
+		assert.ErrorAs(t, err, assertionErr)
+		assert.Equal(t, "expected 1 to equal 2", assertionErr.Message)
+	})
+
+	t.Run("fail with other built-in matchers", func(t *testing.T) {
+		t.Parallel()
+
+		script := `
+           import Test
+
+           access(all)
+           fun testContain() {
+               Test.expect([1, 2], Test.contain(3))
+           }
+
+           access(all)
+           fun testBeGreaterThan() {
+               Test.expect(1, Test.beGreaterThan(2))
+           }
+        `
+
+		inter, err := newTestContractInterpreter(t, script)
+		require.NoError(t, err)
+
+		_, err = inter.Invoke("testContain")
+		require.Error(t, err)
+
+		assertionErr := &AssertionError{}
+		assert.ErrorAs(t, err, assertionErr)
+		assert.Equal(t, "expected [1, 2] to contain 3", assertionErr.Message)
+
+		_, err = inter.Invoke("testBeGreaterThan")
+		require.Error(t, err)
+
+		assert.ErrorAs(t, err, assertionErr)
+		assert.Equal(t, "expected 1 to be greater than 2", assertionErr.Message)
+	})
+
+	t.Run("fail with described built-in matchers", func(t *testing.T) {
+		t.Parallel()
+
+		script := `
+           import Test
+
+           access(all)
+           struct interface HasID {}
+
+           access(all)
+           struct Foo {
+               access(all)
+               let id: Int
+
+               init(id: Int) {
+                   self.id = id
+               }
+           }
+
+           access(all)
+           resource Bar {}
+
+           access(all)
+           fun testNotContain() {
+               Test.expect([1, 2], Test.notContain(2))
+           }
+
+           access(all)
+           fun testBeInstanceOf() {
+               Test.expect(1, Test.beInstanceOf(Type<String>()))
+           }
+
+           access(all)
+           fun testMatchRegex() {
+               Test.expect("abc", Test.matchRegex("[0-9]+"))
+           }
+
+           access(all)
+           fun testBeCloseTo() {
+               Test.expect(1.5, Test.beCloseTo(1.0, delta: 0.1))
+           }
+
+           access(all)
+           fun testBeSorted() {
+               Test.expect([2, 1], Test.beSorted())
+           }
+
+           access(all)
+           fun testBeSortedDescending() {
+               Test.expect([1, 2], Test.beSortedDescending())
+           }
+
+           access(all)
+           fun testBeResource() {
+               Test.expect(Foo(id: 1), Test.beResource())
+           }
+
+           access(all)
+           fun testBeStruct() {
+               let bar <- create Bar()
+               Test.expect(&bar as &Bar, Test.beStruct())
+               destroy bar
+           }
+
+           access(all)
+           fun testHaveField() {
+               Test.expect(Foo(id: 1), Test.haveField("id", value: 2))
+           }
+
+           access(all)
+           fun testConformTo() {
+               Test.expect(Foo(id: 1), Test.conformTo(Type<{HasID}>()))
+           }
+        `
+
+		inter, err := newTestContractInterpreter(t, script)
+		require.NoError(t, err)
+
+		for name, expectedMessage := range map[string]string{
+			"testNotContain":         "expected [1, 2] to not contain 2",
+			"testBeInstanceOf":       "expected 1 to be an instance of Type<String>()",
+			"testMatchRegex":         `expected "abc" to match "[0-9]+"`,
+			"testBeCloseTo":          "expected 1.50000000 to be within 0.10000000 of 1.00000000",
+			"testBeSorted":           "expected [2, 1] to be sorted in ascending order",
+			"testBeSortedDescending": "expected [1, 2] to be sorted in descending order",
+			"testBeResource":         "expected S.test.Foo(id: 1) to be a resource",
+			"testBeStruct":           "expected S.test.Bar(uuid: 1) to be a struct",
+			"testHaveField":          "expected S.test.Foo(id: 1) to have field 'id' equal to 2",
+			"testConformTo":          "expected S.test.Foo(id: 1) to conform to Type<{S.test.HasID}>()",
+		} {
+			_, err = inter.Invoke(name)
+			require.Error(t, err, name)
+
+			assertionErr := &AssertionError{}
+			require.ErrorAs(t, err, assertionErr, name)
+			assert.Equal(t, expectedMessage, assertionErr.Message, name)
+		}
+	})
+
+	t.Run("fail with custom matcher", func(t *testing.T) {
+		t.Parallel()
+
+		script := `
+           import Test
+
+           access(all)
+           fun test() {
+               let isEven = Test.newMatcher<Int>(fun (_ value: Int): Bool {
+                   return value % 2 == 0
+               })
+               Test.expect(3, isEven)
+           }
+        `
+
+		inter, err := newTestContractInterpreter(t, script)
+		require.NoError(t, err)
+
+		_, err = inter.Invoke("test")
+		require.Error(t, err)
+
+		assertionErr := &AssertionError{}
+		assert.ErrorAs(t, err, assertionErr)
+		assert.Equal(t, "given value is: 3", assertionErr.Message)
+	})
+
+	t.Run("different types", func(t *testing.T) {
+		t.Parallel()
+
+		script := `
+           import Test
+
+           access(all)
+           fun test() {
+               Test.expect("string", Test.equal(1))
+           }
+        `
+
+		inter, err := newTestContractInterpreter(t, script)
+		require.NoError(t, err)
+
+		_, err = inter.Invoke("test")
+		require.Error(t, err)
+		assert.ErrorAs(t, err, &interpreter.TypeMismatchError{})
+	})
+
+	t.Run("with explicit types", func(t *testing.T) {
+		t.Parallel()
+
+		script := `
+           import Test
+
+           access(all)
+           fun test() {
+               Test.expect<String>("hello", Test.equal("hello"))
+           }
+        `
+
+		inter, err := newTestContractInterpreter(t, script)
+		require.NoError(t, err)
+
+		_, err = inter.Invoke("test")
+		require.NoError(t, err)
+	})
+
+	t.Run("mismatching types", func(t *testing.T) {
+		t.Parallel()
+
+		script := `
+           import Test
+
+           access(all)
+           fun test() {
+               Test.expect<Int>("string", Test.equal(1))
+           }
+        `
+
+		_, err := newTestContractInterpreter(t, script)
+
+		errs := checker.RequireCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+
+	t.Run("resource with resource matcher", func(t *testing.T) {
+		t.Parallel()
+
+		script := `
+           import Test
+
+           access(all)
+           fun test() {
+               let f1 <- create Foo()
+               let f2 <- create Foo()
+               Test.expect(<-f1, Test.equal(<-f2))
+           }
+
+           access(all)
+           resource Foo {}
+        `
+
+		_, err := newTestContractInterpreter(t, script)
+
+		errs := checker.RequireCheckerErrors(t, err, 2)
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[1])
+	})
+
+	t.Run("resource with struct matcher", func(t *testing.T) {
+		t.Parallel()
+
+		script := `
+           import Test
+
+           access(all)
+           fun test() {
+               let foo <- create Foo()
+               let bar = Bar()
+               Test.expect(<-foo, Test.equal(bar))
+           }
+
+           access(all)
+           resource Foo {}
+           access(all)
+           struct Bar {}
+        `
+
+		_, err := newTestContractInterpreter(t, script)
+
+		errs := checker.RequireCheckerErrors(t, err, 1)
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+
+	t.Run("struct with resource matcher", func(t *testing.T) {
+		t.Parallel()
+
+		script := `
+           import Test
+
+           access(all)
+           fun test() {
+               let foo = Foo()
+               let bar <- create Bar()
+               Test.expect(foo, Test.equal(<-bar))
+           }
+
+           access(all)
+           struct Foo {}
+           access(all)
+           resource Bar {}
+        `
+
+		_, err := newTestContractInterpreter(t, script)
+
+		errs := checker.RequireCheckerErrors(t, err, 1)
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+}
+
+func TestTestExpectFailure(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("expect failure with no failure found", func(t *testing.T) {
+		t.Parallel()
+
+		script := `
+            import Test
+
+            access(all)
+            fun test() {
+                let foo = Foo(answer: 42)
+                Test.expectFailure(fun(): Void {
+                    foo.correctAnswer(42)
+                }, errorMessageSubstring: "wrong answer!")
+            }
+
+            access(all)
+            struct Foo {
+                access(self) let answer: UInt8
+
+                init(answer: UInt8) {
+                    self.answer = answer
+                }
+
+                access(all)
+                fun correctAnswer(_ input: UInt8): Bool {
+                    if self.answer != input {
+                        panic("wrong answer!")
+                    }
+                    return true
+                }
+            }
+        `
+
+		inter, err := newTestContractInterpreter(t, script)
+		require.NoError(t, err)
+
+		_, err = inter.Invoke("test")
+		require.Error(t, err)
+		assert.ErrorContains(
+			t,
+			err,
+			"Expected a failure, but found none.",
+		)
+	})
+
+	t.Run("expect failure with matching error message", func(t *testing.T) {
+		t.Parallel()
+
+		script := `
+            import Test
+
+            access(all)
+            fun test() {
+                let foo = Foo(answer: 42)
+                Test.expectFailure(fun(): Void {
+                    foo.correctAnswer(43)
+                }, errorMessageSubstring: "wrong answer!")
+            }
+
+            access(all)
+            struct Foo {
+                access(self) let answer: UInt8
+
+                init(answer: UInt8) {
+                    self.answer = answer
+                }
+
+                access(all)
+                fun correctAnswer(_ input: UInt8): Bool {
+                    if self.answer != input {
+                        panic("wrong answer!")
+                    }
+                    return true
+                }
+            }
+        `
+
+		inter, err := newTestContractInterpreter(t, script)
+		require.NoError(t, err)
+
+		_, err = inter.Invoke("test")
+		require.NoError(t, err)
+	})
+
+	t.Run("expect failure with mismatching error message", func(t *testing.T) {
+		t.Parallel()
+
+		script := `
+            import Test
+
+            access(all)
+            fun test() {
+                let foo = Foo(answer: 42)
+                Test.expectFailure(fun(): Void {
+                    foo.correctAnswer(43)
+                }, errorMessageSubstring: "what is wrong?")
+            }
+
+            access(all)
+            struct Foo {
+                access(self) let answer: UInt8
+
+                init(answer: UInt8) {
+                    self.answer = answer
+                }
+
+                access(all)
+                fun correctAnswer(_ input: UInt8): Bool {
+                    if self.answer != input {
+                        panic("wrong answer!")
+                    }
+                    return true
+                }
+            }
+        `
+
+		inter, err := newTestContractInterpreter(t, script)
+		require.NoError(t, err)
+
+		_, err = inter.Invoke("test")
+		require.Error(t, err)
+		assert.ErrorContains(
+			t,
+			err,
+			"Expected error message to include: \"what is wrong?\".",
+		)
+		assert.ErrorContains(
+			t,
+			err,
+			"Actual error: Execution failed:\nerror: panic: wrong answer!",
+		)
+	})
+
+	t.Run("expect failure with wrong function signature", func(t *testing.T) {
+		t.Parallel()
+
+		script := `
+            import Test
+
+            access(all)
+            fun test() {
+                let foo = Foo(answer: 42)
+                Test.expectFailure(fun(answer: UInt64): Foo {
+                    foo.correctAnswer(42)
+                    return foo
+                }, errorMessageSubstring: "wrong answer")
+            }
+
+            access(all)
+            struct Foo {
+                access(self) let answer: UInt8
+
+                init(answer: UInt8) {
+                    self.answer = answer
+                }
+
+                access(all)
+                fun correctAnswer(_ input: UInt8): Bool {
+                    if self.answer != input {
+                        panic("wrong answer!")
+                    }
+                    return true
+                }
+            }
+        `
+
+		_, err := newTestContractInterpreter(t, script)
+		errs := checker.RequireCheckerErrors(t, err, 1)
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+
+	t.Run("expect failure with wrong error message type", func(t *testing.T) {
+		t.Parallel()
+
+		script := `
+            import Test
+
+            access(all)
+            fun test() {
+                let foo = Foo(answer: 42)
+                Test.expectFailure(fun(): Void {
+                    foo.correctAnswer(42)
+                }, errorMessageSubstring: ["wrong answer"])
+            }
+
+            access(all)
+            struct Foo {
+                access(self) let answer: UInt8
+
+                init(answer: UInt8) {
+                    self.answer = answer
+                }
+
+                access(all)
+                fun correctAnswer(_ input: UInt8): Bool {
+                    if self.answer != input {
+                        panic("wrong answer!")
+                    }
+                    return true
+                }
+            }
+        `
+
+		_, err := newTestContractInterpreter(t, script)
+		errs := checker.RequireCheckerErrors(t, err, 1)
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+}
+
+func TestTestEach(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("all cases run", func(t *testing.T) {
+		t.Parallel()
+
+		script := `
+            import Test
+
+            access(all)
+            fun test(): [Int] {
+                let seen: [Int] = []
+                Test.each(
+                    cases: [1, 2, 3],
+                    body: fun (_ testCase: AnyStruct) {
+                        let value = testCase as! Int
+                        Test.assert(value > 0)
+                        seen.append(value)
+                    }
+                )
+                return seen
+            }
+        `
+
+		inter, err := newTestContractInterpreter(t, script)
+		require.NoError(t, err)
+
+		result, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		utils.RequireValuesEqual(
+			t,
+			inter,
+			interpreter.NewArrayValue(
+				inter,
+				interpreter.EmptyLocationRange,
+				interpreter.NewVariableSizedStaticType(inter, interpreter.PrimitiveStaticTypeInt),
+				common.ZeroAddress,
+				interpreter.NewUnmeteredIntValueFromInt64(1),
+				interpreter.NewUnmeteredIntValueFromInt64(2),
+				interpreter.NewUnmeteredIntValueFromInt64(3),
+			),
+			result,
+		)
+	})
+
+	t.Run("failing case", func(t *testing.T) {
+		t.Parallel()
+
+		script := `
+            import Test
+
+            access(all)
+            fun test() {
+                Test.each(
+                    cases: [2, 4, 5, 6],
+                    body: fun (_ testCase: AnyStruct) {
+                        let value = testCase as! Int
+                        Test.assert(value % 2 == 0, message: "odd value")
+                    }
+                )
+            }
+        `
+
+		inter, err := newTestContractInterpreter(t, script)
+		require.NoError(t, err)
+
+		_, err = inter.Invoke("test")
+		require.Error(t, err)
+
+		var assertionErr AssertionError
+		require.ErrorAs(t, err, &assertionErr)
+		assert.Contains(t, assertionErr.Message, "case 2 failed")
+		assert.Contains(t, assertionErr.Message, "odd value")
+	})
+
+	t.Run("internal error", func(t *testing.T) {
+		t.Parallel()
+
+		inter, err := newTestContractInterpreter(t, "")
+		require.NoError(t, err)
+
+		bodyType := testTypeEachFunctionType.Parameters[1].TypeAnnotation.Type.(*sema.FunctionType)
+
+		body := interpreter.NewUnmeteredStaticHostFunctionValue(
+			bodyType,
+			func(_ interpreter.Invocation) interpreter.Value {
+				panic(cdcErrors.NewUnexpectedError("internal failure"))
+			},
+		)
+
+		var recovered any
+		func() {
+			defer func() {
+				recovered = recover()
+			}()
+
+			invokeTestCase(
+				inter,
+				body,
+				interpreter.NewUnmeteredIntValueFromInt64(1),
+				0,
+				interpreter.EmptyLocationRange,
+			)
+		}()
+
+		err, ok := recovered.(error)
+		require.True(t, ok)
+
+		var assertionErr AssertionError
+		assert.False(t, errors.As(err, &assertionErr))
+
+		var unexpectedErr cdcErrors.UnexpectedError
+		require.ErrorAs(t, err, &unexpectedErr)
+	})
+}
+
+func TestBlockchain(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("all events, empty", func(t *testing.T) {
+		t.Parallel()
+
+		const script = `
+            import Test
+
+            access(all)
+            fun test() {
+                let events = Test.events()
+
+                Test.expect(events, Test.beEmpty())
+            }
+        `
+
+		eventsInvoked := false
+
+		testFramework := &mockedTestFramework{
+			emulatorBackend: func() Blockchain {
+				return &mockedBlockchain{
+					events: func(inter *interpreter.Interpreter, eventType interpreter.StaticType) interpreter.Value {
+						eventsInvoked = true
+						assert.Nil(t, eventType)
+						return interpreter.NewArrayValue(
+							inter,
+							interpreter.EmptyLocationRange,
+							interpreter.NewVariableSizedStaticType(inter, interpreter.PrimitiveStaticTypeAnyStruct),
+							common.Address{},
+						)
+					},
+				}
+			},
+		}
+
+		inter, err := newTestContractInterpreterWithTestFramework(t, script, testFramework)
+		require.NoError(t, err)
+
+		_, err = inter.Invoke("test")
+		require.NoError(t, err)
+
+		assert.True(t, eventsInvoked)
+	})
+
+	t.Run("typed events, empty", func(t *testing.T) {
+		t.Parallel()
+
+		const script = `
+            import Test
+
+            access(all)
+            struct Foo {}
+
+            access(all)
+            fun test() {
+                // 'Foo' is not an event-type.
+                // But we just need to test the API, so it doesn't really matter.
+                let typ = Type<Foo>()
+
+                let events = Test.eventsOfType(typ)
+
+                Test.expect(events, Test.beEmpty())
+            }
+        `
+
+		eventsInvoked := false
+
+		testFramework := &mockedTestFramework{
+			emulatorBackend: func() Blockchain {
+				return &mockedBlockchain{
+					events: func(inter *interpreter.Interpreter, eventType interpreter.StaticType) interpreter.Value {
+						eventsInvoked = true
+						assert.NotNil(t, eventType)
+
+						require.IsType(t, &interpreter.CompositeStaticType{}, eventType)
+						compositeType := eventType.(*interpreter.CompositeStaticType)
+						assert.Equal(t, "Foo", compositeType.QualifiedIdentifier)
+
+						return interpreter.NewArrayValue(
+							inter,
+							interpreter.EmptyLocationRange,
+							interpreter.NewVariableSizedStaticType(inter, interpreter.PrimitiveStaticTypeAnyStruct),
+							common.Address{},
+						)
+					},
+				}
+			},
+		}
+
+		inter, err := newTestContractInterpreterWithTestFramework(t, script, testFramework)
+		require.NoError(t, err)
+
+		_, err = inter.Invoke("test")
+		require.NoError(t, err)
+
+		assert.True(t, eventsInvoked)
+	})
+
+	t.Run("reset", func(t *testing.T) {
+		t.Parallel()
+
+		const script = `
+            import Test
+
+            access(all)
+            fun test() {
+                Test.reset(to: 5)
+            }
+        `
+
+		resetInvoked := false
+
+		testFramework := &mockedTestFramework{
+			emulatorBackend: func() Blockchain {
+				return &mockedBlockchain{
+					reset: func(height uint64) {
+						resetInvoked = true
+						assert.Equal(t, uint64(5), height)
+					},
+				}
+			},
+		}
+
+		inter, err := newTestContractInterpreterWithTestFramework(t, script, testFramework)
+		require.NoError(t, err)
+
+		_, err = inter.Invoke("test")
+		require.NoError(t, err)
+
+		assert.True(t, resetInvoked)
+	})
+
+	t.Run("reset with type mismatch for height", func(t *testing.T) {
+		t.Parallel()
+
+		const script = `
+            import Test
+
+            access(all)
+            fun test() {
+                Test.reset(to: 5.5)
+            }
+        `
+
+		resetInvoked := false
+
+		testFramework := &mockedTestFramework{
+			emulatorBackend: func() Blockchain {
+				return &mockedBlockchain{
+					reset: func(height uint64) {
+						resetInvoked = true
+					},
+				}
+			},
+		}
+
+		_, err := newTestContractInterpreterWithTestFramework(t, script, testFramework)
+		errs := checker.RequireCheckerErrors(t, err, 1)
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+		assert.False(t, resetInvoked)
+	})
+
+	t.Run("resetToGenesis", func(t *testing.T) {
+		t.Parallel()
+
+		const script = `
+            import Test
+
+            access(all)
+            fun createAccount(): Address {
+                let account = Test.createAccount()
+                Test.assertEqual(account.address, Test.getAccount(account.address).address)
+                return account.address
+            }
+
+            access(all)
+            fun reset() {
+                Test.resetToGenesis()
+            }
+
+            access(all)
+            fun getAccount(_ address: Address): Address {
+                return Test.getAccount(address).address
+            }
+        `
+
+		accounts := map[common.Address]*Account{}
+
+		testFramework := &mockedTestFramework{
+			emulatorBackend: func() Blockchain {
+				return &mockedBlockchain{
+					createAccount: func() (*Account, error) {
+						account := &Account{
+							PublicKey: &PublicKey{
+								PublicKey: []byte{1, 2, 3},
+								SignAlgo:  sema.SignatureAlgorithmECDSA_P256,
+							},
+							Address: common.Address{byte(len(accounts) + 1)},
+						}
+						accounts[account.Address] = account
+						return account, nil
+					},
+					getAccount: func(address interpreter.AddressValue) (*Account, error) {
+						account, ok := accounts[address.ToAddress()]
+						if !ok {
+							return nil, fmt.Errorf("account not found")
+						}
+						return account, nil
+					},
+					resetToGenesis: func() {
+						accounts = map[common.Address]*Account{}
+					},
+				}
+			},
+		}
+
+		inter, err := newTestContractInterpreterWithTestFramework(t, script, testFramework)
+		require.NoError(t, err)
+
+		address, err := inter.Invoke("createAccount")
+		require.NoError(t, err)
+
+		_, err = inter.Invoke("createAccount")
+		require.NoError(t, err)
+		require.Len(t, accounts, 2)
+
+		_, err = inter.Invoke("reset")
+		require.NoError(t, err)
+		assert.Empty(t, accounts)
+
+		_, err = inter.Invoke("getAccount", address)
+		require.Error(t, err)
+		assert.ErrorContains(t, err, "account with address: 0x0100000000000000 was not found")
+	})
+
+	t.Run("moveTime forward", func(t *testing.T) {
+		t.Parallel()
+
+		const script = `
+            import Test
+
+            access(all)
+            fun testMoveForward() {
+                // timeDelta is the representation of 35 days,
+                // in the form of seconds.
+                let timeDelta = Fix64(35 * 24 * 60 * 60)
+                Test.moveTime(by: timeDelta)
+            }
+        `
+
+		moveTimeInvoked := false
+
+		testFramework := &mockedTestFramework{
+			emulatorBackend: func() Blockchain {
+				return &mockedBlockchain{
+					moveTime: func(timeDelta int64) {
+						moveTimeInvoked = true
+						assert.Equal(t, int64(3024000), timeDelta)
+					},
+				}
+			},
+		}
+
+		inter, err := newTestContractInterpreterWithTestFramework(t, script, testFramework)
+		require.NoError(t, err)
+
+		_, err = inter.Invoke("testMoveForward")
+		require.NoError(t, err)
+
+		assert.True(t, moveTimeInvoked)
+	})
+
+	t.Run("moveTime advances block height", func(t *testing.T) {
+		t.Parallel()
+
+		const script = `
+            import Test
+
+            access(all)
+            fun getHeight(): UInt64 {
+                return Test.getCurrentBlockHeight()
+            }
+
+            access(all)
+            fun moveForward() {
+                Test.moveTime(by: 60.0)
+            }
+        `
+
+		var height uint64 = 42
+		var timestamp int64 = 1000
+
+		testFramework := &mockedTestFramework{
+			emulatorBackend: func() Blockchain {
+				return &mockedBlockchain{
+					moveTime: func(timeDelta int64) {
+						timestamp += timeDelta
+						height++
+					},
+					currentBlockHeight: func() uint64 {
+						return height
+					},
+				}
+			},
+		}
+
+		inter, err := newTestContractInterpreterWithTestFramework(t, script, testFramework)
+		require.NoError(t, err)
+
+		result, err := inter.Invoke("getHeight")
+		require.NoError(t, err)
+		assert.Equal(t, interpreter.NewUnmeteredUInt64Value(42), result)
+
+		_, err = inter.Invoke("moveForward")
+		require.NoError(t, err)
+
+		result, err = inter.Invoke("getHeight")
+		require.NoError(t, err)
+		assert.Equal(t, interpreter.NewUnmeteredUInt64Value(43), result)
+
+		assert.Equal(t, int64(1060), timestamp)
+	})
+
+	t.Run("moveTime backward", func(t *testing.T) {
+		t.Parallel()
+
+		const script = `
+            import Test
+
+            access(all)
+            fun testMoveBackward() {
+                // timeDelta is the representation of 35 days,
+                // in the form of seconds.
+                let timeDelta = Fix64(35 * 24 * 60 * 60) * -1.0
+                Test.moveTime(by: timeDelta)
+            }
+        `
+
+		moveTimeInvoked := false
+
+		testFramework := &mockedTestFramework{
+			emulatorBackend: func() Blockchain {
+				return &mockedBlockchain{
+					moveTime: func(timeDelta int64) {
+						moveTimeInvoked = true
+						assert.Equal(t, int64(-3024000), timeDelta)
+					},
+				}
+			},
+		}
+
+		inter, err := newTestContractInterpreterWithTestFramework(t, script, testFramework)
+		require.NoError(t, err)
+
+		_, err = inter.Invoke("testMoveBackward")
+		require.NoError(t, err)
+
+		assert.True(t, moveTimeInvoked)
+	})
+
+	t.Run("moveTime with invalid time delta", func(t *testing.T) {
+		t.Parallel()
+
+		const script = `
+            import Test
+
+            access(all)
+            fun testMoveTime() {
+                Test.moveTime(by: 3000)
+            }
+        `
+
+		moveTimeInvoked := false
+
+		testFramework := &mockedTestFramework{
+			emulatorBackend: func() Blockchain {
+				return &mockedBlockchain{
+					moveTime: func(timeDelta int64) {
+						moveTimeInvoked = true
+					},
+				}
+			},
+		}
+
+		_, err := newTestContractInterpreterWithTestFramework(t, script, testFramework)
+		errs := checker.RequireCheckerErrors(t, err, 1)
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+		assert.False(t, moveTimeInvoked)
+	})
+
+	t.Run("createSnapshot", func(t *testing.T) {
+		t.Parallel()
+
+		const script = `
+            import Test
+
+            access(all)
+            fun test() {
+                Test.createSnapshot(name: "adminCreated")
+            }
+        `
+
+		createSnapshotInvoked := false
+
+		testFramework := &mockedTestFramework{
+			emulatorBackend: func() Blockchain {
+				return &mockedBlockchain{
+					createSnapshot: func(name string) error {
+						createSnapshotInvoked = true
+						assert.Equal(t, "adminCreated", name)
+
+						return nil
+					},
+				}
+			},
+		}
+
+		inter, err := newTestContractInterpreterWithTestFramework(t, script, testFramework)
+		require.NoError(t, err)
+
+		_, err = inter.Invoke("test")
+		require.NoError(t, err)
+
+		assert.True(t, createSnapshotInvoked)
+	})
+
+	t.Run("createSnapshot failure", func(t *testing.T) {
+		t.Parallel()
+
+		const script = `
+            import Test
+
+            access(all)
+            fun test() {
+                Test.createSnapshot(name: "adminCreated")
+            }
+        `
+
+		createSnapshotInvoked := false
+
+		testFramework := &mockedTestFramework{
+			emulatorBackend: func() Blockchain {
+				return &mockedBlockchain{
+					createSnapshot: func(name string) error {
+						createSnapshotInvoked = true
+						assert.Equal(t, "adminCreated", name)
+
+						return fmt.Errorf("failed to create snapshot: %s", name)
+					},
+				}
+			},
+		}
+
+		inter, err := newTestContractInterpreterWithTestFramework(t, script, testFramework)
+		require.NoError(t, err)
+
+		_, err = inter.Invoke("test")
+		require.ErrorContains(t, err, "panic: failed to create snapshot: adminCreated")
+
+		assert.True(t, createSnapshotInvoked)
+	})
+
+	t.Run("loadSnapshot", func(t *testing.T) {
+		t.Parallel()
+
+		const script = `
+            import Test
+
+            access(all)
+            fun test() {
+                Test.createSnapshot(name: "adminCreated")
+                Test.loadSnapshot(name: "adminCreated")
+            }
+        `
+
+		loadSnapshotInvoked := false
+
+		testFramework := &mockedTestFramework{
+			emulatorBackend: func() Blockchain {
+				return &mockedBlockchain{
+					createSnapshot: func(name string) error {
+						assert.Equal(t, "adminCreated", name)
 
-		inter, err := newTestContractInterpreter(t, script)
+						return nil
+					},
+					loadSnapshot: func(name string) error {
+						loadSnapshotInvoked = true
+						assert.Equal(t, "adminCreated", name)
+
+						return nil
+					},
+				}
+			},
+		}
+
+		inter, err := newTestContractInterpreterWithTestFramework(t, script, testFramework)
 		require.NoError(t, err)
 
 		_, err = inter.Invoke("test")
-		require.Error(t, err)
-		assert.ErrorContains(
-			t,
-			err,
-			"Expected error message to include: \"what is wrong?\".",
-		)
+		require.NoError(t, err)
+
+		assert.True(t, loadSnapshotInvoked)
 	})
 
-	t.Run("expect failure with wrong function signature", func(t *testing.T) {
+	t.Run("loadSnapshot failure", func(t *testing.T) {
 		t.Parallel()
 
-		script := `
+		const script = `
             import Test
 
             access(all)
             fun test() {
-                let foo = Foo(answer: 42)
-                Test.expectFailure(fun(answer: UInt64): Foo {
-                    foo.correctAnswer(42)
-                    return foo
-                }, errorMessageSubstring: "wrong answer")
+                Test.createSnapshot(name: "adminCreated")
+                Test.loadSnapshot(name: "contractDeployed")
             }
+        `
 
-            access(all)
-            struct Foo {
-                access(self) let answer: UInt8
+		loadSnapshotInvoked := false
 
-                init(answer: UInt8) {
-                    self.answer = answer
-                }
+		testFramework := &mockedTestFramework{
+			emulatorBackend: func() Blockchain {
+				return &mockedBlockchain{
+					createSnapshot: func(name string) error {
+						assert.Equal(t, "adminCreated", name)
 
-                access(all)
-                fun correctAnswer(_ input: UInt8): Bool {
-                    if self.answer != input {
-                        panic("wrong answer!")
-                    }
-                    return true
-                }
-            }
-        `
+						return nil
+					},
+					loadSnapshot: func(name string) error {
+						loadSnapshotInvoked = true
+						assert.Equal(t, "contractDeployed", name)
 
-		_, err := newTestContractInterpreter(t, script)
-		errs := checker.RequireCheckerErrors(t, err, 1)
-		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+						return fmt.Errorf("failed to create snapshot: %s", name)
+					},
+				}
+			},
+		}
+
+		inter, err := newTestContractInterpreterWithTestFramework(t, script, testFramework)
+		require.NoError(t, err)
+
+		_, err = inter.Invoke("test")
+		require.ErrorContains(t, err, "panic: failed to create snapshot: contractDeployed")
+
+		assert.True(t, loadSnapshotInvoked)
 	})
 
-	t.Run("expect failure with wrong error message type", func(t *testing.T) {
+	t.Run("deployContract", func(t *testing.T) {
 		t.Parallel()
 
-		script := `
+		const script = `
             import Test
 
             access(all)
             fun test() {
-                let foo = Foo(answer: 42)
-                Test.expectFailure(fun(): Void {
-                    foo.correctAnswer(42)
-                }, errorMessageSubstring: ["wrong answer"])
+                let err = Test.deployContract(
+                    name: "FooContract",
+                    path: "./contracts/FooContract.cdc",
+                    arguments: ["Hey, there!"]
+                )
+
+                Test.expect(err, Test.beNil())
             }
+        `
 
-            access(all)
-            struct Foo {
-                access(self) let answer: UInt8
+		deployContractInvoked := false
 
-                init(answer: UInt8) {
-                    self.answer = answer
-                }
+		testFramework := &mockedTestFramework{
+			emulatorBackend: func() Blockchain {
+				return &mockedBlockchain{
+					deployContract: func(
+						inter *interpreter.Interpreter,
+						name string,
+						path string,
+						arguments []interpreter.Value,
+					) (common.Address, error) {
+						deployContractInvoked = true
+						assert.Equal(t, "FooContract", name)
+						assert.Equal(t, "./contracts/FooContract.cdc", path)
+						assert.Equal(t, 1, len(arguments))
+						argument := arguments[0].(*interpreter.StringValue)
+						assert.Equal(t, "Hey, there!", argument.Str)
 
-                access(all)
-                fun correctAnswer(_ input: UInt8): Bool {
-                    if self.answer != input {
-                        panic("wrong answer!")
-                    }
-                    return true
-                }
-            }
-        `
+						return common.MustBytesToAddress([]byte{0x5}), nil
+					},
+				}
+			},
+		}
 
-		_, err := newTestContractInterpreter(t, script)
-		errs := checker.RequireCheckerErrors(t, err, 1)
-		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
-	})
-}
+		inter, err := newTestContractInterpreterWithTestFramework(t, script, testFramework)
+		require.NoError(t, err)
 
-func TestBlockchain(t *testing.T) {
+		_, err = inter.Invoke("test")
+		require.NoError(t, err)
 
-	t.Parallel()
+		assert.True(t, deployContractInvoked)
+	})
 
-	t.Run("all events, empty", func(t *testing.T) {
+	t.Run("deployContract with failure", func(t *testing.T) {
 		t.Parallel()
 
 		const script = `
@@ -2230,26 +4849,33 @@ func TestBlockchain(t *testing.T) {
 
             access(all)
             fun test() {
-                let events = Test.events()
+                let err = Test.deployContract(
+                    name: "FooContract",
+                    path: "./contracts/FooContract.cdc",
+                    arguments: ["Hey, there!"]
+                )
 
-                Test.expect(events, Test.beEmpty())
+                Test.assertEqual(
+                    "failed to deploy contract: FooContract",
+                    err!.message
+                )
             }
         `
 
-		eventsInvoked := false
+		deployContractInvoked := false
 
 		testFramework := &mockedTestFramework{
 			emulatorBackend: func() Blockchain {
 				return &mockedBlockchain{
-					events: func(inter *interpreter.Interpreter, eventType interpreter.StaticType) interpreter.Value {
-						eventsInvoked = true
-						assert.Nil(t, eventType)
-						return interpreter.NewArrayValue(
-							inter,
-							interpreter.EmptyLocationRange,
-							interpreter.NewVariableSizedStaticType(inter, interpreter.PrimitiveStaticTypeAnyStruct),
-							common.Address{},
-						)
+					deployContract: func(
+						inter *interpreter.Interpreter,
+						name string,
+						path string,
+						arguments []interpreter.Value,
+					) (common.Address, error) {
+						deployContractInvoked = true
+
+						return common.ZeroAddress, fmt.Errorf("failed to deploy contract: %s", name)
 					},
 				}
 			},
@@ -2261,49 +4887,61 @@ func TestBlockchain(t *testing.T) {
 		_, err = inter.Invoke("test")
 		require.NoError(t, err)
 
-		assert.True(t, eventsInvoked)
+		assert.True(t, deployContractInvoked)
 	})
 
-	t.Run("typed events, empty", func(t *testing.T) {
+	t.Run("deployContractWithResult", func(t *testing.T) {
 		t.Parallel()
 
 		const script = `
             import Test
 
-            access(all)
-            struct Foo {}
-
             access(all)
             fun test() {
-                // 'Foo' is not an event-type.
-                // But we just need to test the API, so it doesn't really matter.
-                let typ = Type<Foo>()
+                let deployment = Test.deployContractWithResult(
+                    name: "FooContract",
+                    path: "./contracts/FooContract.cdc",
+                    arguments: []
+                )
 
-                let events = Test.eventsOfType(typ)
+                Test.expect(deployment, Test.beSucceeded())
+                Test.assertEqual(Address(0x05), deployment.address)
+                Test.assertEqual("FooContract", deployment.contractName)
 
-                Test.expect(events, Test.beEmpty())
+                let code = "import "
+                    .concat(deployment.contractName)
+                    .concat(" from ")
+                    .concat(deployment.address.toString())
+                    .concat(" access(all) fun main(): Int { return FooContract.answer() }")
+
+                let scriptResult = Test.executeScript(code, [])
+                Test.assertEqual(42, scriptResult.returnValue! as! Int)
             }
         `
 
-		eventsInvoked := false
+		contractAddress := common.MustBytesToAddress([]byte{0x5})
 
 		testFramework := &mockedTestFramework{
 			emulatorBackend: func() Blockchain {
 				return &mockedBlockchain{
-					events: func(inter *interpreter.Interpreter, eventType interpreter.StaticType) interpreter.Value {
-						eventsInvoked = true
-						assert.NotNil(t, eventType)
-
-						require.IsType(t, &interpreter.CompositeStaticType{}, eventType)
-						compositeType := eventType.(*interpreter.CompositeStaticType)
-						assert.Equal(t, "Foo", compositeType.QualifiedIdentifier)
-
-						return interpreter.NewArrayValue(
-							inter,
-							interpreter.EmptyLocationRange,
-							interpreter.NewVariableSizedStaticType(inter, interpreter.PrimitiveStaticTypeAnyStruct),
-							common.Address{},
-						)
+					deployContract: func(
+						_ *interpreter.Interpreter,
+						_ string,
+						_ string,
+						_ []interpreter.Value,
+					) (common.Address, error) {
+						return contractAddress, nil
+					},
+					runScript: func(
+						_ *interpreter.Interpreter,
+						code string,
+						_ []interpreter.Value,
+					) *ScriptResult {
+						assert.Contains(t, code, "import FooContract from 0x0000000000000005")
+
+						return &ScriptResult{
+							Value: interpreter.NewUnmeteredIntValueFromInt64(42),
+						}
 					},
 				}
 			},
@@ -2314,11 +4952,9 @@ func TestBlockchain(t *testing.T) {
 
 		_, err = inter.Invoke("test")
 		require.NoError(t, err)
-
-		assert.True(t, eventsInvoked)
 	})
 
-	t.Run("reset", func(t *testing.T) {
+	t.Run("deployContractWithResult with failure", func(t *testing.T) {
 		t.Parallel()
 
 		const script = `
@@ -2326,18 +4962,30 @@ func TestBlockchain(t *testing.T) {
 
             access(all)
             fun test() {
-                Test.reset(to: 5)
+                let deployment = Test.deployContractWithResult(
+                    name: "FooContract",
+                    path: "./contracts/FooContract.cdc",
+                    arguments: []
+                )
+
+                Test.expect(deployment, Test.beFailed())
+                Test.assertEqual(
+                    "failed to deploy contract: FooContract",
+                    deployment.error!.message
+                )
             }
         `
 
-		resetInvoked := false
-
 		testFramework := &mockedTestFramework{
 			emulatorBackend: func() Blockchain {
 				return &mockedBlockchain{
-					reset: func(height uint64) {
-						resetInvoked = true
-						assert.Equal(t, uint64(5), height)
+					deployContract: func(
+						_ *interpreter.Interpreter,
+						name string,
+						_ string,
+						_ []interpreter.Value,
+					) (common.Address, error) {
+						return common.ZeroAddress, fmt.Errorf("failed to deploy contract: %s", name)
 					},
 				}
 			},
@@ -2348,11 +4996,9 @@ func TestBlockchain(t *testing.T) {
 
 		_, err = inter.Invoke("test")
 		require.NoError(t, err)
-
-		assert.True(t, resetInvoked)
 	})
 
-	t.Run("reset with type mismatch for height", func(t *testing.T) {
+	t.Run("getAccount", func(t *testing.T) {
 		t.Parallel()
 
 		const script = `
@@ -2360,51 +5006,64 @@ func TestBlockchain(t *testing.T) {
 
             access(all)
             fun test() {
-                Test.reset(to: 5.5)
+                let account = Test.getAccount(0x0000000000000009)
+                Test.assertEqual(0x0000000000000009 as Address, account.address)
             }
         `
 
-		resetInvoked := false
+		getAccountInvoked := false
 
 		testFramework := &mockedTestFramework{
 			emulatorBackend: func() Blockchain {
 				return &mockedBlockchain{
-					reset: func(height uint64) {
-						resetInvoked = true
+					getAccount: func(address interpreter.AddressValue) (*Account, error) {
+						getAccountInvoked = true
+						assert.Equal(t, "0000000000000009", address.Hex())
+						addr := common.Address(address)
+
+						return &Account{
+							Address: addr,
+							PublicKey: &PublicKey{
+								PublicKey: []byte{1, 2, 3},
+								SignAlgo:  sema.SignatureAlgorithmECDSA_P256,
+							},
+						}, nil
 					},
 				}
 			},
 		}
 
-		_, err := newTestContractInterpreterWithTestFramework(t, script, testFramework)
-		errs := checker.RequireCheckerErrors(t, err, 1)
-		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
-		assert.False(t, resetInvoked)
+		inter, err := newTestContractInterpreterWithTestFramework(t, script, testFramework)
+		require.NoError(t, err)
+
+		_, err = inter.Invoke("test")
+		require.NoError(t, err)
+
+		assert.True(t, getAccountInvoked)
 	})
 
-	t.Run("moveTime forward", func(t *testing.T) {
+	t.Run("getAccount with failure", func(t *testing.T) {
 		t.Parallel()
 
 		const script = `
             import Test
 
             access(all)
-            fun testMoveForward() {
-                // timeDelta is the representation of 35 days,
-                // in the form of seconds.
-                let timeDelta = Fix64(35 * 24 * 60 * 60)
-                Test.moveTime(by: timeDelta)
+            fun test() {
+                let account = Test.getAccount(0x0000000000000009)
             }
         `
 
-		moveTimeInvoked := false
+		getAccountInvoked := false
 
 		testFramework := &mockedTestFramework{
 			emulatorBackend: func() Blockchain {
 				return &mockedBlockchain{
-					moveTime: func(timeDelta int64) {
-						moveTimeInvoked = true
-						assert.Equal(t, int64(3024000), timeDelta)
+					getAccount: func(address interpreter.AddressValue) (*Account, error) {
+						getAccountInvoked = true
+						assert.Equal(t, "0000000000000009", address.Hex())
+
+						return nil, fmt.Errorf("failed to retrieve account with address: %s", address)
 					},
 				}
 			},
@@ -2413,35 +5072,80 @@ func TestBlockchain(t *testing.T) {
 		inter, err := newTestContractInterpreterWithTestFramework(t, script, testFramework)
 		require.NoError(t, err)
 
-		_, err = inter.Invoke("testMoveForward")
-		require.NoError(t, err)
+		_, err = inter.Invoke("test")
+		require.Error(t, err)
+		assert.ErrorContains(t, err, "account with address: 0x0000000000000009 was not found")
 
-		assert.True(t, moveTimeInvoked)
+		assert.True(t, getAccountInvoked)
 	})
 
-	t.Run("moveTime backward", func(t *testing.T) {
+	// TODO: Add more tests for the remaining functions.
+
+	t.Run("executeTransaction with multiple authorizers and signers", func(t *testing.T) {
 		t.Parallel()
 
 		const script = `
             import Test
 
             access(all)
-            fun testMoveBackward() {
-                // timeDelta is the representation of 35 days,
-                // in the form of seconds.
-                let timeDelta = Fix64(35 * 24 * 60 * 60) * -1.0
-                Test.moveTime(by: timeDelta)
+            fun test() {
+                let signer = Test.createAccount()
+
+                let tx = Test.Transaction(
+                    code: "transaction { prepare(a: &Account, b: &Account) {} }",
+                    authorizers: [0x1, 0x2],
+                    signers: [signer],
+                    arguments: []
+                )
+
+                let result = Test.executeTransaction(tx)
+
+                Test.expect(result, Test.beSucceeded())
+                Test.assertEqual([0x1, 0x2] as [Address], result.authorizers)
+                Test.assertEqual([0x3] as [Address], result.signers)
+
+                Test.expect(result, Test.beSignedBy([0x3]))
+                Test.expect(result, Test.not(Test.beSignedBy([0x1, 0x2])))
+                Test.expect(result, Test.not(Test.beSignedBy([0x1, 0x3])))
             }
         `
 
-		moveTimeInvoked := false
+		var authorizers []common.Address
+		var signers []common.Address
 
 		testFramework := &mockedTestFramework{
 			emulatorBackend: func() Blockchain {
 				return &mockedBlockchain{
-					moveTime: func(timeDelta int64) {
-						moveTimeInvoked = true
-						assert.Equal(t, int64(-3024000), timeDelta)
+					createAccount: func() (*Account, error) {
+						return &Account{
+							PublicKey: &PublicKey{
+								PublicKey: []byte{1, 2, 3},
+								SignAlgo:  sema.SignatureAlgorithmECDSA_P256,
+							},
+							Address: common.MustBytesToAddress([]byte{0x3}),
+						}, nil
+					},
+					addTransaction: func(
+						_ *interpreter.Interpreter,
+						_ string,
+						txAuthorizers []common.Address,
+						txSigners []*Account,
+						_ []interpreter.Value,
+					) error {
+						authorizers = txAuthorizers
+						for _, signer := range txSigners {
+							signers = append(signers, signer.Address)
+						}
+						return nil
+					},
+					executeTransaction: func() *TransactionResult {
+						return &TransactionResult{
+							Authorizers: authorizers,
+							Signers:     signers,
+						}
+					},
+					commitBlock: func() error {
+						return nil
 					},
 				}
 			},
@@ -2450,43 +5154,118 @@ func TestBlockchain(t *testing.T) {
 		inter, err := newTestContractInterpreterWithTestFramework(t, script, testFramework)
 		require.NoError(t, err)
 
-		_, err = inter.Invoke("testMoveBackward")
+		_, err = inter.Invoke("test")
 		require.NoError(t, err)
+	})
 
-		assert.True(t, moveTimeInvoked)
+	t.Run("constructed transaction result", func(t *testing.T) {
+		t.Parallel()
+
+		const script = `
+            import Test
+
+            access(all)
+            fun test() {
+                let result = Test.TransactionResult(
+                    status: Test.ResultStatus.succeeded,
+                    authorizers: [0x1],
+                    signers: [0x1, 0x2],
+                    computationUsed: 42,
+                    error: nil
+                )
+
+                Test.assertEqual([0x1] as [Address], result.authorizers)
+                Test.assertEqual([0x1, 0x2] as [Address], result.signers)
+
+                Test.expect(result, Test.beSignedBy([0x2, 0x1]))
+                Test.expect(result, Test.beSignedBy([0x1, 0x2, 0x1]))
+                Test.expect(result, Test.not(Test.beSignedBy([0x1, 0x1])))
+                Test.assertEqual(42 as UInt64, result.computationUsed)
+            }
+        `
+
+		inter, err := newTestContractInterpreter(t, script)
+		require.NoError(t, err)
+
+		_, err = inter.Invoke("test")
+		require.NoError(t, err)
 	})
 
-	t.Run("moveTime with invalid time delta", func(t *testing.T) {
+	t.Run("executeTransaction computation used", func(t *testing.T) {
 		t.Parallel()
 
 		const script = `
             import Test
 
             access(all)
-            fun testMoveTime() {
-                Test.moveTime(by: 3000)
+            fun test() {
+                let simpleTx = Test.Transaction(
+                    code: "transaction { execute {} }",
+                    authorizers: [],
+                    signers: [],
+                    arguments: []
+                )
+
+                let simpleResult = Test.executeTransaction(simpleTx)
+
+                Test.expect(simpleResult, Test.beSucceeded())
+                Test.assertEqual(10 as UInt64, simpleResult.computationUsed)
+                Test.expect(simpleResult, Test.useLessComputationThan(100))
+
+                let loopTx = Test.Transaction(
+                    code: "transaction { execute { var i = 0; while i < 1000 { i = i + 1 } } }",
+                    authorizers: [],
+                    signers: [],
+                    arguments: []
+                )
+
+                let loopResult = Test.executeTransaction(loopTx)
+
+                Test.expect(loopResult, Test.beSucceeded())
+                Test.expect(loopResult, Test.not(Test.useLessComputationThan(100)))
             }
         `
 
-		moveTimeInvoked := false
+		var code string
 
 		testFramework := &mockedTestFramework{
 			emulatorBackend: func() Blockchain {
 				return &mockedBlockchain{
-					moveTime: func(timeDelta int64) {
-						moveTimeInvoked = true
+					addTransaction: func(
+						_ *interpreter.Interpreter,
+						txCode string,
+						_ []common.Address,
+						_ []*Account,
+						_ []interpreter.Value,
+					) error {
+						code = txCode
+						return nil
+					},
+					executeTransaction: func() *TransactionResult {
+						// Simulate the computation metered by the backend
+						var computationUsed uint64 = 10
+						if strings.Contains(code, "while") {
+							computationUsed = 1000
+						}
+						return &TransactionResult{
+							ComputationUsed: computationUsed,
+						}
+					},
+					commitBlock: func() error {
+						return nil
 					},
 				}
 			},
 		}
 
-		_, err := newTestContractInterpreterWithTestFramework(t, script, testFramework)
-		errs := checker.RequireCheckerErrors(t, err, 1)
-		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
-		assert.False(t, moveTimeInvoked)
+		inter, err := newTestContractInterpreterWithTestFramework(t, script, testFramework)
+		require.NoError(t, err)
+
+		_, err = inter.Invoke("test")
+		require.NoError(t, err)
 	})
 
-	t.Run("createSnapshot", func(t *testing.T) {
+	t.Run("createSnapshot and loadSnapshot", func(t *testing.T) {
 		t.Parallel()
 
 		const script = `
@@ -2494,19 +5273,81 @@ func TestBlockchain(t *testing.T) {
 
             access(all)
             fun test() {
-                Test.createSnapshot(name: "adminCreated")
+                let tx = Test.Transaction(
+                    code: "transaction { prepare(acct: &Account) {} }",
+                    authorizers: [0x1],
+                    signers: [],
+                    arguments: []
+                )
+
+                let getCounter = fun (): Int {
+                    let scriptResult = Test.executeScript("access(all) fun main(): Int { return 0 }", [])
+                    return scriptResult.returnValue! as! Int
+                }
+
+                Test.expect(Test.executeTransaction(tx), Test.beSucceeded())
+                Test.assertEqual(1, getCounter())
+
+                Test.createSnapshot(name: "first")
+
+                Test.expect(Test.executeTransaction(tx), Test.beSucceeded())
+                Test.expect(Test.executeTransaction(tx), Test.beSucceeded())
+                Test.assertEqual(3, getCounter())
+
+                Test.loadSnapshot(name: "first")
+                Test.assertEqual(1, getCounter())
+            }
+
+            access(all)
+            fun testUnknownSnapshot() {
+                Test.loadSnapshot(name: "unknown")
             }
         `
 
-		createSnapshotInvoked := false
+		// the counter models the account storage of the blockchain:
+		// every executed transaction mutates it, scripts read it
+
+		var counter int64
+		snapshots := map[string]int64{}
 
 		testFramework := &mockedTestFramework{
 			emulatorBackend: func() Blockchain {
 				return &mockedBlockchain{
+					addTransaction: func(
+						_ *interpreter.Interpreter,
+						_ string,
+						_ []common.Address,
+						_ []*Account,
+						_ []interpreter.Value,
+					) error {
+						return nil
+					},
+					executeTransaction: func() *TransactionResult {
+						counter++
+						return &TransactionResult{}
+					},
+					commitBlock: func() error {
+						return nil
+					},
+					runScript: func(
+						_ *interpreter.Interpreter,
+						_ string,
+						_ []interpreter.Value,
+					) *ScriptResult {
+						return &ScriptResult{
+							Value: interpreter.NewUnmeteredIntValueFromInt64(counter),
+						}
+					},
 					createSnapshot: func(name string) error {
-						createSnapshotInvoked = true
-						assert.Equal(t, "adminCreated", name)
-
+						snapshots[name] = counter
+						return nil
+					},
+					loadSnapshot: func(name string) error {
+						snapshot, ok := snapshots[name]
+						if !ok {
+							return fmt.Errorf("snapshot %q does not exist", name)
+						}
+						counter = snapshot
 						return nil
 					},
 				}
@@ -2519,10 +5360,14 @@ func TestBlockchain(t *testing.T) {
 		_, err = inter.Invoke("test")
 		require.NoError(t, err)
 
-		assert.True(t, createSnapshotInvoked)
+		assert.Equal(t, int64(1), counter)
+
+		_, err = inter.Invoke("testUnknownSnapshot")
+		require.Error(t, err)
+		assert.ErrorContains(t, err, `snapshot "unknown" does not exist`)
 	})
 
-	t.Run("createSnapshot failure", func(t *testing.T) {
+	t.Run("run script returning array", func(t *testing.T) {
 		t.Parallel()
 
 		const script = `
@@ -2530,20 +5375,41 @@ func TestBlockchain(t *testing.T) {
 
             access(all)
             fun test() {
-                Test.createSnapshot(name: "adminCreated")
+                let result = Test.executeScript(
+                    "access(all) fun main(): [Int] { return [1, 2, 3] }",
+                    []
+                )
+
+                Test.expect(result, Test.beSucceeded())
+                Test.assertEqual(Type<[Int]>(), result.returnType!)
+
+                let values = result.returnValue! as! [Int]
+                Test.assertEqual([1, 2, 3], values)
+                Test.assertEqual(Type<[Int]>(), values.getType())
             }
         `
 
-		createSnapshotInvoked := false
-
 		testFramework := &mockedTestFramework{
 			emulatorBackend: func() Blockchain {
 				return &mockedBlockchain{
-					createSnapshot: func(name string) error {
-						createSnapshotInvoked = true
-						assert.Equal(t, "adminCreated", name)
-
-						return fmt.Errorf("failed to create snapshot: %s", name)
+					runScript: func(
+						inter *interpreter.Interpreter,
+						_ string,
+						_ []interpreter.Value,
+					) *ScriptResult {
+						return &ScriptResult{
+							Value: interpreter.NewArrayValue(
+								inter,
+								interpreter.EmptyLocationRange,
+								&interpreter.VariableSizedStaticType{
+									Type: interpreter.PrimitiveStaticTypeInt,
+								},
+								common.ZeroAddress,
+								interpreter.NewUnmeteredIntValueFromInt64(1),
+								interpreter.NewUnmeteredIntValueFromInt64(2),
+								interpreter.NewUnmeteredIntValueFromInt64(3),
+							),
+						}
 					},
 				}
 			},
@@ -2553,12 +5419,10 @@ func TestBlockchain(t *testing.T) {
 		require.NoError(t, err)
 
 		_, err = inter.Invoke("test")
-		require.ErrorContains(t, err, "panic: failed to create snapshot: adminCreated")
-
-		assert.True(t, createSnapshotInvoked)
+		require.NoError(t, err)
 	})
 
-	t.Run("loadSnapshot", func(t *testing.T) {
+	t.Run("run script returning optional", func(t *testing.T) {
 		t.Parallel()
 
 		const script = `
@@ -2566,26 +5430,29 @@ func TestBlockchain(t *testing.T) {
 
             access(all)
             fun test() {
-                Test.createSnapshot(name: "adminCreated")
-                Test.loadSnapshot(name: "adminCreated")
+                let result = Test.executeScript(
+                    "access(all) fun main(): Int? { return 1 }",
+                    []
+                )
+
+                Test.expect(result, Test.beSucceeded())
+                Test.assertEqual(Type<Int?>(), result.returnType!)
             }
         `
 
-		loadSnapshotInvoked := false
-
 		testFramework := &mockedTestFramework{
 			emulatorBackend: func() Blockchain {
 				return &mockedBlockchain{
-					createSnapshot: func(name string) error {
-						assert.Equal(t, "adminCreated", name)
-
-						return nil
-					},
-					loadSnapshot: func(name string) error {
-						loadSnapshotInvoked = true
-						assert.Equal(t, "adminCreated", name)
-
-						return nil
+					runScript: func(
+						inter *interpreter.Interpreter,
+						_ string,
+						_ []interpreter.Value,
+					) *ScriptResult {
+						return &ScriptResult{
+							Value: interpreter.NewUnmeteredSomeValueNonCopying(
+								interpreter.NewUnmeteredIntValueFromInt64(1),
+							),
+						}
 					},
 				}
 			},
@@ -2596,11 +5463,9 @@ func TestBlockchain(t *testing.T) {
 
 		_, err = inter.Invoke("test")
 		require.NoError(t, err)
-
-		assert.True(t, loadSnapshotInvoked)
 	})
 
-	t.Run("loadSnapshot failure", func(t *testing.T) {
+	t.Run("run script returning resource", func(t *testing.T) {
 		t.Parallel()
 
 		const script = `
@@ -2608,26 +5473,32 @@ func TestBlockchain(t *testing.T) {
 
             access(all)
             fun test() {
-                Test.createSnapshot(name: "adminCreated")
-                Test.loadSnapshot(name: "contractDeployed")
+                Test.executeScript(
+                    "access(all) resource R {} access(all) fun main(): @R { return <- create R() }",
+                    []
+                )
             }
         `
 
-		loadSnapshotInvoked := false
-
 		testFramework := &mockedTestFramework{
 			emulatorBackend: func() Blockchain {
 				return &mockedBlockchain{
-					createSnapshot: func(name string) error {
-						assert.Equal(t, "adminCreated", name)
-
-						return nil
-					},
-					loadSnapshot: func(name string) error {
-						loadSnapshotInvoked = true
-						assert.Equal(t, "contractDeployed", name)
-
-						return fmt.Errorf("failed to create snapshot: %s", name)
+					runScript: func(
+						inter *interpreter.Interpreter,
+						_ string,
+						_ []interpreter.Value,
+					) *ScriptResult {
+						return &ScriptResult{
+							Value: interpreter.NewCompositeValue(
+								inter,
+								interpreter.EmptyLocationRange,
+								utils.TestLocation,
+								"R",
+								common.CompositeKindResource,
+								nil,
+								common.ZeroAddress,
+							),
+						}
 					},
 				}
 			},
@@ -2637,12 +5508,17 @@ func TestBlockchain(t *testing.T) {
 		require.NoError(t, err)
 
 		_, err = inter.Invoke("test")
-		require.ErrorContains(t, err, "panic: failed to create snapshot: contractDeployed")
-
-		assert.True(t, loadSnapshotInvoked)
+		require.Error(t, err)
+		assert.ErrorAs(t, err, &cdcErrors.DefaultUserError{})
+		assert.ErrorContains(t, err, "scripts executed by the test framework cannot return resources: got `S.test.R`")
 	})
+}
 
-	t.Run("deployContract", func(t *testing.T) {
+func TestBlockchainAccount(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("create account", func(t *testing.T) {
 		t.Parallel()
 
 		const script = `
@@ -2650,35 +5526,22 @@ func TestBlockchain(t *testing.T) {
 
             access(all)
             fun test() {
-                let err = Test.deployContract(
-                    name: "FooContract",
-                    path: "./contracts/FooContract.cdc",
-                    arguments: ["Hey, there!"]
-                )
-
-                Test.expect(err, Test.beNil())
+                let account = Test.createAccount()
+                assert(account.address == 0x0100000000000000)
             }
         `
 
-		deployContractInvoked := false
-
 		testFramework := &mockedTestFramework{
 			emulatorBackend: func() Blockchain {
 				return &mockedBlockchain{
-					deployContract: func(
-						inter *interpreter.Interpreter,
-						name string,
-						path string,
-						arguments []interpreter.Value,
-					) error {
-						deployContractInvoked = true
-						assert.Equal(t, "FooContract", name)
-						assert.Equal(t, "./contracts/FooContract.cdc", path)
-						assert.Equal(t, 1, len(arguments))
-						argument := arguments[0].(*interpreter.StringValue)
-						assert.Equal(t, "Hey, there!", argument.Str)
-
-						return nil
+					createAccount: func() (*Account, error) {
+						return &Account{
+							PublicKey: &PublicKey{
+								PublicKey: []byte{1, 2, 3},
+								SignAlgo:  sema.SignatureAlgorithmECDSA_P256,
+							},
+							Address: common.Address{1},
+						}, nil
 					},
 				}
 			},
@@ -2689,11 +5552,9 @@ func TestBlockchain(t *testing.T) {
 
 		_, err = inter.Invoke("test")
 		require.NoError(t, err)
-
-		assert.True(t, deployContractInvoked)
 	})
 
-	t.Run("deployContract with failure", func(t *testing.T) {
+	t.Run("create account with keys", func(t *testing.T) {
 		t.Parallel()
 
 		const script = `
@@ -2701,33 +5562,102 @@ func TestBlockchain(t *testing.T) {
 
             access(all)
             fun test() {
-                let err = Test.deployContract(
-                    name: "FooContract",
-                    path: "./contracts/FooContract.cdc",
-                    arguments: ["Hey, there!"]
+                let account = Test.createAccount()
+                Test.assertEqual(2, account.keys.length)
+
+                let first = account.keys[0]
+                Test.assertEqual(0, first.keyIndex)
+                Test.assertEqual(1000.0, first.weight)
+                Test.assertEqual(3 as UInt8, first.hashAlgorithm.rawValue)
+                Test.assertEqual(false, first.isRevoked)
+                Test.assertEqual(
+                    [7, 8, 9] as [UInt8],
+                    first.hashAlgorithm.hash([1, 2, 3])
                 )
 
+                let second = account.keys[1]
+                Test.assertEqual(1, second.keyIndex)
+                Test.assertEqual(500.0, second.weight)
+                Test.assertEqual(1 as UInt8, second.hashAlgorithm.rawValue)
+                Test.assertEqual(true, second.isRevoked)
                 Test.assertEqual(
-                    "failed to deploy contract: FooContract",
-                    err!.message
+                    2 as UInt8,
+                    second.publicKey.signatureAlgorithm.rawValue
+                )
+
+                let tx = Test.Transaction(
+                    code: "transaction { execute {} }",
+                    authorizers: [],
+                    signers: [account],
+                    arguments: []
+                )
+
+                let result = Test.executeTransaction(tx)
+                Test.expect(result, Test.beSucceeded())
+
+                let constructed = Test.TestAccount(
+                    address: account.address,
+                    publicKey: account.publicKey,
+                    keys: account.keys
                 )
+                Test.assertEqual(2, constructed.keys.length)
             }
         `
 
-		deployContractInvoked := false
+		keys := []*AccountKey{
+			{
+				KeyIndex: 0,
+				PublicKey: &PublicKey{
+					PublicKey: []byte{1, 2, 3},
+					SignAlgo:  sema.SignatureAlgorithmECDSA_P256,
+				},
+				HashAlgo: sema.HashAlgorithmSHA3_256,
+				Weight:   1000,
+			},
+			{
+				KeyIndex: 1,
+				PublicKey: &PublicKey{
+					PublicKey: []byte{4, 5, 6},
+					SignAlgo:  sema.SignatureAlgorithmECDSA_secp256k1,
+				},
+				HashAlgo:  sema.HashAlgorithmSHA2_256,
+				Weight:    500,
+				IsRevoked: true,
+			},
+		}
+
+		var signers []*Account
 
 		testFramework := &mockedTestFramework{
 			emulatorBackend: func() Blockchain {
 				return &mockedBlockchain{
-					deployContract: func(
-						inter *interpreter.Interpreter,
-						name string,
-						path string,
-						arguments []interpreter.Value,
+					createAccount: func() (*Account, error) {
+						return &Account{
+							PublicKey: keys[0].PublicKey,
+							Keys:      keys,
+							Address:   common.Address{1},
+						}, nil
+					},
+					addTransaction: func(
+						_ *interpreter.Interpreter,
+						_ string,
+						_ []common.Address,
+						txSigners []*Account,
+						_ []interpreter.Value,
 					) error {
-						deployContractInvoked = true
-
-						return fmt.Errorf("failed to deploy contract: %s", name)
+						signers = txSigners
+						return nil
+					},
+					executeTransaction: func() *TransactionResult {
+						return &TransactionResult{}
+					},
+					commitBlock: func() error {
+						return nil
+					},
+					hash: func(data []byte, tag string, hashAlgorithm sema.HashAlgorithm) ([]byte, error) {
+						assert.Equal(t, []byte{1, 2, 3}, data)
+						assert.Equal(t, sema.HashAlgorithmSHA3_256, hashAlgorithm)
+						return []byte{7, 8, 9}, nil
 					},
 				}
 			},
@@ -2739,10 +5669,107 @@ func TestBlockchain(t *testing.T) {
 		_, err = inter.Invoke("test")
 		require.NoError(t, err)
 
-		assert.True(t, deployContractInvoked)
+		require.Len(t, signers, 1)
+		assert.Equal(t, keys, signers[0].Keys)
 	})
+}
 
-	t.Run("getAccount", func(t *testing.T) {
+func TestArrayValueIterate(t *testing.T) {
+
+	t.Parallel()
+
+	inter, err := newTestContractInterpreter(t, "")
+	require.NoError(t, err)
+
+	array := interpreter.NewArrayValue(
+		inter,
+		interpreter.EmptyLocationRange,
+		interpreter.NewVariableSizedStaticType(inter, interpreter.PrimitiveStaticTypeInt),
+		common.ZeroAddress,
+		interpreter.NewUnmeteredIntValueFromInt64(1),
+		interpreter.NewUnmeteredIntValueFromInt64(2),
+		interpreter.NewUnmeteredIntValueFromInt64(3),
+	)
+
+	t.Run("early exit", func(t *testing.T) {
+		var visited []interpreter.Value
+
+		err := arrayValueIterate(
+			inter,
+			array,
+			interpreter.EmptyLocationRange,
+			func(element interpreter.Value) (resume bool) {
+				visited = append(visited, element)
+				return len(visited) < 2
+			},
+		)
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			[]interpreter.Value{
+				interpreter.NewUnmeteredIntValueFromInt64(1),
+				interpreter.NewUnmeteredIntValueFromInt64(2),
+			},
+			visited,
+		)
+	})
+
+	t.Run("slice", func(t *testing.T) {
+		values, err := arrayValueToSlice(inter, array, interpreter.EmptyLocationRange)
+		require.NoError(t, err)
+
+		assert.Len(t, values, 3)
+	})
+
+	t.Run("non-array", func(t *testing.T) {
+		err := arrayValueIterate(
+			inter,
+			interpreter.TrueValue,
+			interpreter.EmptyLocationRange,
+			func(element interpreter.Value) (resume bool) {
+				return true
+			},
+		)
+		require.Error(t, err)
+		assert.ErrorContains(t, err, "value is not an array")
+	})
+}
+
+func TestTestFiles(t *testing.T) {
+
+	t.Parallel()
+
+	newInMemoryTestFramework := func(files map[string]string) *mockedTestFramework {
+		return &mockedTestFramework{
+			emulatorBackend: func() Blockchain {
+				return &mockedBlockchain{}
+			},
+			readFile: func(path string) (string, error) {
+				content, ok := files[path]
+				if !ok {
+					return "", fmt.Errorf("file %q does not exist", path)
+				}
+				return content, nil
+			},
+			writeFile: func(path string, content string) error {
+				files[path] = content
+				return nil
+			},
+			listFiles: func(directory string) ([]string, error) {
+				var fileNames []string
+				for path := range files {
+					dir, name := filepath.Split(path)
+					if filepath.Clean(dir) == filepath.Clean(directory) {
+						fileNames = append(fileNames, name)
+					}
+				}
+				sort.Strings(fileNames)
+				return fileNames, nil
+			},
+		}
+	}
+
+	t.Run("write and read", func(t *testing.T) {
 		t.Parallel()
 
 		const script = `
@@ -2750,87 +5777,94 @@ func TestBlockchain(t *testing.T) {
 
             access(all)
             fun test() {
-                let account = Test.getAccount(0x0000000000000009)
-                Test.assertEqual(0x0000000000000009 as Address, account.address)
+                Test.writeFile(path: "fixtures/a.json", content: "{\"a\": 1}")
+                Test.writeFile(path: "fixtures/b.json", content: "{\"b\": 2}")
+
+                Test.assertEqual("{\"a\": 1}", Test.readFile("fixtures/a.json"))
+                Test.assertEqual(["a.json", "b.json"], Test.listFiles(directory: "fixtures"))
             }
         `
 
-		getAccountInvoked := false
-
-		testFramework := &mockedTestFramework{
-			emulatorBackend: func() Blockchain {
-				return &mockedBlockchain{
-					getAccount: func(address interpreter.AddressValue) (*Account, error) {
-						getAccountInvoked = true
-						assert.Equal(t, "0000000000000009", address.Hex())
-						addr := common.Address(address)
-
-						return &Account{
-							Address: addr,
-							PublicKey: &PublicKey{
-								PublicKey: []byte{1, 2, 3},
-								SignAlgo:  sema.SignatureAlgorithmECDSA_P256,
-							},
-						}, nil
-					},
-				}
-			},
-		}
+		files := map[string]string{}
 
-		inter, err := newTestContractInterpreterWithTestFramework(t, script, testFramework)
+		inter, err := newTestContractInterpreterWithTestFramework(
+			t,
+			script,
+			newInMemoryTestFramework(files),
+		)
 		require.NoError(t, err)
 
 		_, err = inter.Invoke("test")
 		require.NoError(t, err)
 
-		assert.True(t, getAccountInvoked)
+		assert.Equal(t,
+			map[string]string{
+				"fixtures/a.json": `{"a": 1}`,
+				"fixtures/b.json": `{"b": 2}`,
+			},
+			files,
+		)
 	})
 
-	t.Run("getAccount with failure", func(t *testing.T) {
+	t.Run("path escaping test root", func(t *testing.T) {
 		t.Parallel()
 
 		const script = `
             import Test
 
             access(all)
-            fun test() {
-                let account = Test.getAccount(0x0000000000000009)
+            fun testWriteParent() {
+                Test.writeFile(path: "fixtures/../../secret.txt", content: "")
             }
-        `
 
-		getAccountInvoked := false
+            access(all)
+            fun testWriteAbsolute() {
+                Test.writeFile(path: "/etc/secret.txt", content: "")
+            }
 
-		testFramework := &mockedTestFramework{
-			emulatorBackend: func() Blockchain {
-				return &mockedBlockchain{
-					getAccount: func(address interpreter.AddressValue) (*Account, error) {
-						getAccountInvoked = true
-						assert.Equal(t, "0000000000000009", address.Hex())
+            access(all)
+            fun testListParent() {
+                Test.listFiles(directory: "..")
+            }
+        `
 
-						return nil, fmt.Errorf("failed to retrieve account with address: %s", address)
-					},
-				}
-			},
-		}
+		files := map[string]string{}
 
-		inter, err := newTestContractInterpreterWithTestFramework(t, script, testFramework)
+		inter, err := newTestContractInterpreterWithTestFramework(
+			t,
+			script,
+			newInMemoryTestFramework(files),
+		)
 		require.NoError(t, err)
 
-		_, err = inter.Invoke("test")
-		require.Error(t, err)
-		assert.ErrorContains(t, err, "account with address: 0x0000000000000009 was not found")
+		_, err = inter.Invoke("testWriteParent")
+		require.ErrorContains(t, err, "path must not escape the test root: fixtures/../../secret.txt")
 
-		assert.True(t, getAccountInvoked)
-	})
+		_, err = inter.Invoke("testWriteAbsolute")
+		require.ErrorContains(t, err, "path must be relative to the test root: /etc/secret.txt")
 
-	// TODO: Add more tests for the remaining functions.
+		_, err = inter.Invoke("testListParent")
+		require.ErrorContains(t, err, "path must not escape the test root: ..")
+
+		assert.Empty(t, files)
+	})
 }
 
-func TestBlockchainAccount(t *testing.T) {
+func TestTestSignatures(t *testing.T) {
 
 	t.Parallel()
 
-	t.Run("create account", func(t *testing.T) {
+	cryptoHandler := testCryptoHandler{}
+
+	testFramework := &mockedTestFramework{
+		emulatorBackend: func() Blockchain {
+			return &mockedBlockchain{}
+		},
+		createKey: cryptoHandler.CreateKey,
+		sign:      cryptoHandler.Sign,
+	}
+
+	t.Run("sign and verify", func(t *testing.T) {
 		t.Parallel()
 
 		const script = `
@@ -2838,38 +5872,89 @@ func TestBlockchainAccount(t *testing.T) {
 
             access(all)
             fun test() {
-                let account = Test.createAccount()
-                assert(account.address == 0x0100000000000000)
+                let key = Test.createKey(signatureAlgorithm: SignatureAlgorithm.ECDSA_P256)
+                Test.assertEqual(
+                    SignatureAlgorithm.ECDSA_P256.rawValue,
+                    key.publicKey.signatureAlgorithm.rawValue
+                )
+
+                let message = "hello".utf8
+                let signature = Test.sign(
+                    key,
+                    message: message,
+                    domainSeparationTag: "FLOW-V0.0-user",
+                    hashAlgorithm: HashAlgorithm.SHA3_256
+                )
+
+                Test.assert(
+                    key.publicKey.verify(
+                        signature: signature,
+                        signedData: message,
+                        domainSeparationTag: "FLOW-V0.0-user",
+                        hashAlgorithm: HashAlgorithm.SHA3_256
+                    )
+                )
+
+                Test.assert(
+                    !key.publicKey.verify(
+                        signature: signature,
+                        signedData: "bye".utf8,
+                        domainSeparationTag: "FLOW-V0.0-user",
+                        hashAlgorithm: HashAlgorithm.SHA3_256
+                    )
+                )
+
+                let otherKey = Test.createKey(signatureAlgorithm: SignatureAlgorithm.ECDSA_P256)
+                Test.assert(
+                    !otherKey.publicKey.verify(
+                        signature: signature,
+                        signedData: message,
+                        domainSeparationTag: "FLOW-V0.0-user",
+                        hashAlgorithm: HashAlgorithm.SHA3_256
+                    )
+                )
             }
         `
 
-		testFramework := &mockedTestFramework{
-			emulatorBackend: func() Blockchain {
-				return &mockedBlockchain{
-					createAccount: func() (*Account, error) {
-						return &Account{
-							PublicKey: &PublicKey{
-								PublicKey: []byte{1, 2, 3},
-								SignAlgo:  sema.SignatureAlgorithmECDSA_P256,
-							},
-							Address: common.Address{1},
-						}, nil
-					},
-				}
-			},
-		}
-
 		inter, err := newTestContractInterpreterWithTestFramework(t, script, testFramework)
 		require.NoError(t, err)
 
 		_, err = inter.Invoke("test")
 		require.NoError(t, err)
 	})
+
+	t.Run("unsupported signature algorithm", func(t *testing.T) {
+		t.Parallel()
+
+		const script = `
+            import Test
+
+            access(all)
+            fun test() {
+                Test.createKey(signatureAlgorithm: SignatureAlgorithm.BLS_BLS12_381)
+            }
+        `
+
+		inter, err := newTestContractInterpreterWithTestFramework(t, script, testFramework)
+		require.NoError(t, err)
+
+		_, err = inter.Invoke("test")
+		require.ErrorContains(t, err, "unsupported signature algorithm: SignatureAlgorithmBLS_BLS12_381")
+	})
 }
 
 type mockedTestFramework struct {
 	emulatorBackend func() Blockchain
 	readFile        func(s string) (string, error)
+	writeFile       func(path string, content string) error
+	listFiles       func(directory string) ([]string, error)
+	createKey       func(signatureAlgorithm sema.SignatureAlgorithm) (*PrivateKey, error)
+	sign            func(
+		privateKey *PrivateKey,
+		message []byte,
+		tag string,
+		hashAlgorithm sema.HashAlgorithm,
+	) ([]byte, error)
 }
 
 var _ TestFramework = &mockedTestFramework{}
@@ -2890,22 +5975,185 @@ func (m mockedTestFramework) ReadFile(fileName string) (string, error) {
 	return m.readFile(fileName)
 }
 
+func (m mockedTestFramework) WriteFile(path string, content string) error {
+	if m.writeFile == nil {
+		panic("'WriteFile' is not implemented")
+	}
+
+	return m.writeFile(path, content)
+}
+
+func (m mockedTestFramework) ListFiles(directory string) ([]string, error) {
+	if m.listFiles == nil {
+		panic("'ListFiles' is not implemented")
+	}
+
+	return m.listFiles(directory)
+}
+
+func (m mockedTestFramework) CreateKey(signatureAlgorithm sema.SignatureAlgorithm) (*PrivateKey, error) {
+	if m.createKey == nil {
+		panic("'CreateKey' is not implemented")
+	}
+
+	return m.createKey(signatureAlgorithm)
+}
+
+func (m mockedTestFramework) Sign(
+	privateKey *PrivateKey,
+	message []byte,
+	tag string,
+	hashAlgorithm sema.HashAlgorithm,
+) ([]byte, error) {
+	if m.sign == nil {
+		panic("'Sign' is not implemented")
+	}
+
+	return m.sign(privateKey, message, tag, hashAlgorithm)
+}
+
+// testCryptoHandler implements hashing, and ECDSA_P256 key generation,
+// signing, and signature verification, for tests
+type testCryptoHandler struct{}
+
+var _ Hasher = testCryptoHandler{}
+var _ PublicKeyFunctionsHandler = testCryptoHandler{}
+
+const testECDSAP256ScalarLength = 32
+
+func (testCryptoHandler) Hash(data []byte, tag string, algorithm sema.HashAlgorithm) ([]byte, error) {
+	taggedData := append([]byte(tag), data...)
+
+	switch algorithm {
+	case sema.HashAlgorithmSHA2_256:
+		digest := sha256.Sum256(taggedData)
+		return digest[:], nil
+
+	case sema.HashAlgorithmSHA3_256:
+		digest := sha3.Sum256(taggedData)
+		return digest[:], nil
+
+	default:
+		return nil, fmt.Errorf("unsupported hash algorithm: %s", algorithm)
+	}
+}
+
+func (h testCryptoHandler) CreateKey(signatureAlgorithm sema.SignatureAlgorithm) (*PrivateKey, error) {
+	if signatureAlgorithm != sema.SignatureAlgorithmECDSA_P256 {
+		return nil, fmt.Errorf("unsupported signature algorithm: %s", signatureAlgorithm)
+	}
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	publicKey := make([]byte, 2*testECDSAP256ScalarLength)
+	key.X.FillBytes(publicKey[:testECDSAP256ScalarLength])
+	key.Y.FillBytes(publicKey[testECDSAP256ScalarLength:])
+
+	return &PrivateKey{
+		PublicKey: &PublicKey{
+			PublicKey: publicKey,
+			SignAlgo:  signatureAlgorithm,
+		},
+		PrivateKey: key.D.FillBytes(make([]byte, testECDSAP256ScalarLength)),
+	}, nil
+}
+
+func (h testCryptoHandler) Sign(
+	privateKey *PrivateKey,
+	message []byte,
+	tag string,
+	hashAlgorithm sema.HashAlgorithm,
+) ([]byte, error) {
+	digest, err := h.Hash(message, tag, hashAlgorithm)
+	if err != nil {
+		return nil, err
+	}
+
+	publicKey := privateKey.PublicKey.PublicKey
+	key := &ecdsa.PrivateKey{
+		PublicKey: ecdsa.PublicKey{
+			Curve: elliptic.P256(),
+			X:     new(big.Int).SetBytes(publicKey[:testECDSAP256ScalarLength]),
+			Y:     new(big.Int).SetBytes(publicKey[testECDSAP256ScalarLength:]),
+		},
+		D: new(big.Int).SetBytes(privateKey.PrivateKey),
+	}
+
+	r, s, err := ecdsa.Sign(rand.Reader, key, digest)
+	if err != nil {
+		return nil, err
+	}
+
+	signature := make([]byte, 2*testECDSAP256ScalarLength)
+	r.FillBytes(signature[:testECDSAP256ScalarLength])
+	s.FillBytes(signature[testECDSAP256ScalarLength:])
+
+	return signature, nil
+}
+
+func (h testCryptoHandler) VerifySignature(
+	signature []byte,
+	tag string,
+	signedData []byte,
+	publicKey []byte,
+	signatureAlgorithm sema.SignatureAlgorithm,
+	hashAlgorithm sema.HashAlgorithm,
+) (bool, error) {
+	if signatureAlgorithm != sema.SignatureAlgorithmECDSA_P256 {
+		return false, fmt.Errorf("unsupported signature algorithm: %s", signatureAlgorithm)
+	}
+
+	if len(signature) != 2*testECDSAP256ScalarLength ||
+		len(publicKey) != 2*testECDSAP256ScalarLength {
+
+		return false, nil
+	}
+
+	digest, err := h.Hash(signedData, tag, hashAlgorithm)
+	if err != nil {
+		return false, err
+	}
+
+	key := &ecdsa.PublicKey{
+		Curve: elliptic.P256(),
+		X:     new(big.Int).SetBytes(publicKey[:testECDSAP256ScalarLength]),
+		Y:     new(big.Int).SetBytes(publicKey[testECDSAP256ScalarLength:]),
+	}
+
+	return ecdsa.Verify(
+		key,
+		digest,
+		new(big.Int).SetBytes(signature[:testECDSAP256ScalarLength]),
+		new(big.Int).SetBytes(signature[testECDSAP256ScalarLength:]),
+	), nil
+}
+
+func (testCryptoHandler) BLSVerifyPOP(_ *PublicKey, _ []byte) (bool, error) {
+	return false, errors.New("BLS is not supported")
+}
+
 // mockedBlockchain is the implementation of `Blockchain` for testing purposes.
 type mockedBlockchain struct {
-	runScript          func(inter *interpreter.Interpreter, code string, arguments []interpreter.Value)
+	runScript          func(inter *interpreter.Interpreter, code string, arguments []interpreter.Value) *ScriptResult
 	createAccount      func() (*Account, error)
 	getAccount         func(interpreter.AddressValue) (*Account, error)
 	addTransaction     func(inter *interpreter.Interpreter, code string, authorizers []common.Address, signers []*Account, arguments []interpreter.Value) error
 	executeTransaction func() *TransactionResult
 	commitBlock        func() error
-	deployContract     func(inter *interpreter.Interpreter, name string, path string, arguments []interpreter.Value) error
+	deployContract     func(inter *interpreter.Interpreter, name string, path string, arguments []interpreter.Value) (common.Address, error)
 	logs               func() []string
 	serviceAccount     func() (*Account, error)
 	events             func(inter *interpreter.Interpreter, eventType interpreter.StaticType) interpreter.Value
 	reset              func(uint64)
+	resetToGenesis     func()
 	moveTime           func(int64)
+	currentBlockHeight func() uint64
 	createSnapshot     func(string) error
 	loadSnapshot       func(string) error
+	hash               func(data []byte, tag string, hashAlgorithm sema.HashAlgorithm) ([]byte, error)
 }
 
 var _ Blockchain = &mockedBlockchain{}
@@ -2919,7 +6167,7 @@ func (m mockedBlockchain) RunScript(
 		panic("'RunScript' is not implemented")
 	}
 
-	return m.RunScript(inter, code, arguments)
+	return m.runScript(inter, code, arguments)
 }
 
 func (m mockedBlockchain) CreateAccount() (*Account, error) {
@@ -2973,7 +6221,7 @@ func (m mockedBlockchain) DeployContract(
 	name string,
 	path string,
 	arguments []interpreter.Value,
-) error {
+) (common.Address, error) {
 	if m.deployContract == nil {
 		panic("'DeployContract' is not implemented")
 	}
@@ -3016,6 +6264,14 @@ func (m mockedBlockchain) Reset(height uint64) {
 	m.reset(height)
 }
 
+func (m mockedBlockchain) ResetToGenesis() {
+	if m.resetToGenesis == nil {
+		panic("'ResetToGenesis' is not implemented")
+	}
+
+	m.resetToGenesis()
+}
+
 func (m mockedBlockchain) MoveTime(timeDelta int64) {
 	if m.moveTime == nil {
 		panic("'SetTimestamp' is not implemented")
@@ -3024,6 +6280,14 @@ func (m mockedBlockchain) MoveTime(timeDelta int64) {
 	m.moveTime(timeDelta)
 }
 
+func (m mockedBlockchain) CurrentBlockHeight() uint64 {
+	if m.currentBlockHeight == nil {
+		panic("'CurrentBlockHeight' is not implemented")
+	}
+
+	return m.currentBlockHeight()
+}
+
 func (m mockedBlockchain) CreateSnapshot(name string) error {
 	if m.createSnapshot == nil {
 		panic("'CreateSnapshot' is not implemented")
@@ -3039,3 +6303,11 @@ func (m mockedBlockchain) LoadSnapshot(name string) error {
 
 	return m.loadSnapshot(name)
 }
+
+func (m mockedBlockchain) Hash(data []byte, tag string, hashAlgorithm sema.HashAlgorithm) ([]byte, error) {
+	if m.hash == nil {
+		panic("'Hash' is not implemented")
+	}
+
+	return m.hash(data, tag, hashAlgorithm)
+}
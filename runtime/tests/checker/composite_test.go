@@ -2298,3 +2298,67 @@ func TestCheckKeywordsAsFieldNames(t *testing.T) {
 		})
 	}
 }
+
+func TestCheckCompositeMemberDocComments(t *testing.T) {
+
+	t.Parallel()
+
+	checker, err := ParseAndCheck(t, `
+      struct Test {
+
+          /// The question.
+          let question: String
+
+          // not a doc comment
+
+          /// Returns the answer.
+          /// Always 42.
+          fun answer(): Int {
+              return 42
+          }
+
+          init() {
+              self.question = "?"
+          }
+      }
+    `)
+	require.NoError(t, err)
+
+	testType := RequireGlobalType(t, checker.Elaboration, "Test")
+	require.IsType(t, &sema.CompositeType{}, testType)
+
+	members := testType.(*sema.CompositeType).Members
+
+	t.Run("field", func(t *testing.T) {
+
+		t.Parallel()
+
+		member, ok := members.Get("question")
+		require.True(t, ok)
+
+		assert.Equal(t, " The question.", member.DocString)
+
+		require.Len(t, member.DocComments, 1)
+
+		assert.Equal(t, "The question.", string(member.DocComments[0].Text()))
+		assert.Equal(t, 4, member.DocComments[0].StartPos.Line)
+	})
+
+	t.Run("function", func(t *testing.T) {
+
+		t.Parallel()
+
+		member, ok := members.Get("answer")
+		require.True(t, ok)
+
+		assert.Equal(t, " Returns the answer.\n Always 42.", member.DocString)
+
+		require.Len(t, member.DocComments, 2)
+
+		assert.Equal(t, "Returns the answer.", string(member.DocComments[0].Text()))
+		assert.Equal(t, 9, member.DocComments[0].StartPos.Line)
+
+		assert.Equal(t, "Always 42.", string(member.DocComments[1].Text()))
+		assert.Equal(t, 10, member.DocComments[1].StartPos.Line)
+	})
+}
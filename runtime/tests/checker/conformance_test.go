@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/onflow/cadence/runtime/ast"
@@ -167,7 +168,28 @@ func TestCheckConformanceWithFunctionSubtype(t *testing.T) {
 
 		errs := RequireCheckerErrors(t, err, 1)
 
-		require.IsType(t, &sema.ConformanceError{}, errs[0])
+		var conformanceErr *sema.ConformanceError
+		require.ErrorAs(t, errs[0], &conformanceErr)
+
+		notes := conformanceErr.ErrorNotes()
+		require.Len(t, notes, 2)
+
+		require.IsType(t, &sema.MemberMismatchNote{}, notes[0])
+
+		require.IsType(t, &sema.ParameterMismatchNote{}, notes[1])
+		parameterMismatchNote := notes[1].(*sema.ParameterMismatchNote)
+
+		assert.Equal(t,
+			ast.Range{
+				StartPos: ast.Position{Offset: 193, Line: 11, Column: 25},
+				EndPos:   ast.Position{Offset: 194, Line: 11, Column: 26},
+			},
+			parameterMismatchNote.Range,
+		)
+		assert.Equal(t,
+			"mismatched type for parameter 1: expected `{RI}`, got `R`",
+			parameterMismatchNote.Message(),
+		)
 	})
 
 	t.Run("invalid, parameter type is supertype", func(t *testing.T) {
@@ -192,7 +214,321 @@ func TestCheckConformanceWithFunctionSubtype(t *testing.T) {
 
 		errs := RequireCheckerErrors(t, err, 1)
 
-		require.IsType(t, &sema.ConformanceError{}, errs[0])
+		var conformanceErr *sema.ConformanceError
+		require.ErrorAs(t, errs[0], &conformanceErr)
+
+		notes := conformanceErr.ErrorNotes()
+		require.Len(t, notes, 2)
+
+		require.IsType(t, &sema.MemberMismatchNote{}, notes[0])
+
+		require.IsType(t, &sema.ParameterMismatchNote{}, notes[1])
+		parameterMismatchNote := notes[1].(*sema.ParameterMismatchNote)
+
+		assert.Equal(t,
+			ast.Range{
+				StartPos: ast.Position{Offset: 190, Line: 11, Column: 25},
+				EndPos:   ast.Position{Offset: 194, Line: 11, Column: 29},
+			},
+			parameterMismatchNote.Range,
+		)
+		assert.Equal(t,
+			"mismatched type for parameter 1: expected `R`, got `{RI}`",
+			parameterMismatchNote.Message(),
+		)
+	})
+}
+
+func TestCheckConformanceWithMultipleInterfaceReturnTypes(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("valid, return type is subtype of both", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource interface A {}
+
+          resource interface B {}
+
+          resource R: A, B {}
+
+          struct interface SA {
+              fun get(): @{A}
+          }
+
+          struct interface SB {
+              fun get(): @{B}
+          }
+
+          struct S: SA, SB {
+              fun get(): @R {
+                  return <- create R()
+              }
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("valid, return type is intersection of both", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource interface A {}
+
+          resource interface B {}
+
+          resource R: A, B {}
+
+          struct interface SA {
+              fun get(): @{A}
+          }
+
+          struct interface SB {
+              fun get(): @{B}
+          }
+
+          struct S: SA, SB {
+              fun get(): @{A, B} {
+                  return <- create R()
+              }
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("valid, optional and non-optional", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct interface SA {
+              fun get(): Int?
+          }
+
+          struct interface SB {
+              fun get(): Integer
+          }
+
+          struct S: SA, SB {
+              fun get(): Int {
+                  return 1
+              }
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("valid, number supertypes", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct interface SA {
+              fun get(): SignedNumber
+          }
+
+          struct interface SB {
+              fun get(): Integer
+          }
+
+          struct S: SA, SB {
+              fun get(): Int {
+                  return 1
+              }
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("valid, optionals", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct interface SA {
+              fun get(): String?
+          }
+
+          struct interface SB {
+              fun get(): Int?
+          }
+
+          struct S: SA, SB {
+              fun get(): Never? {
+                  return nil
+              }
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("invalid, optional and non-optional, no common subtype", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct interface SA {
+              fun get(): String?
+          }
+
+          struct interface SB {
+              fun get(): Int
+          }
+
+          struct S: SA, SB {
+              fun get(): Int {
+                  return 1
+              }
+          }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		var conformanceErr *sema.ConformanceError
+		require.ErrorAs(t, errs[0], &conformanceErr)
+
+		require.NotNil(t, conformanceErr.ReturnTypeConflict)
+	})
+
+	t.Run("invalid, return type is subtype of one", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource interface A {}
+
+          resource interface B {}
+
+          resource R: A, B {}
+
+          struct interface SA {
+              fun get(): @{A}
+          }
+
+          struct interface SB {
+              fun get(): @{B}
+          }
+
+          struct S: SA, SB {
+              fun get(): @{A} {
+                  return <- create R()
+              }
+          }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		var conformanceErr *sema.ConformanceError
+		require.ErrorAs(t, errs[0], &conformanceErr)
+
+		assert.Nil(t, conformanceErr.ReturnTypeConflict)
+		assert.Equal(t, "SB", conformanceErr.InterfaceType.Identifier)
+	})
+
+	t.Run("invalid, no common subtype", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct interface SA {
+              fun get(): Int
+          }
+
+          struct interface SB {
+              fun get(): String
+          }
+
+          struct S: SA, SB {
+              fun get(): Int {
+                  return 1
+              }
+          }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		var conformanceErr *sema.ConformanceError
+		require.ErrorAs(t, errs[0], &conformanceErr)
+
+		require.NotNil(t, conformanceErr.ReturnTypeConflict)
+		assert.Equal(t, "SA", conformanceErr.InterfaceType.Identifier)
+		assert.Equal(t, "SB", conformanceErr.ReturnTypeConflict.InterfaceType.Identifier)
+
+		assert.Equal(t,
+			"structure `S` cannot conform to both structure interface `SA` and structure interface `SB`",
+			conformanceErr.Error(),
+		)
+		assert.Equal(t,
+			"function `get` has incompatible return types `Int` and `String`, which have no common subtype",
+			conformanceErr.SecondaryError(),
+		)
+	})
+
+	t.Run("invalid, no common subtype, composite and intersection", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource interface A {}
+
+          resource R {}
+
+          struct interface SA {
+              fun get(): @{A}
+          }
+
+          struct interface SB {
+              fun get(): @R
+          }
+
+          struct S: SA, SB {
+              fun get(): @R {
+                  return <- create R()
+              }
+          }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		var conformanceErr *sema.ConformanceError
+		require.ErrorAs(t, errs[0], &conformanceErr)
+
+		require.NotNil(t, conformanceErr.ReturnTypeConflict)
+	})
+
+	t.Run("invalid, no common subtype, default function", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct interface SA {
+              fun get(): Int {
+                  return 1
+              }
+          }
+
+          struct interface SB {
+              fun get(): String
+          }
+
+          struct S: SA, SB {}
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		var conformanceErr *sema.ConformanceError
+		require.ErrorAs(t, errs[0], &conformanceErr)
+
+		require.NotNil(t, conformanceErr.ReturnTypeConflict)
+		assert.Equal(t, "get", conformanceErr.ReturnTypeConflict.FunctionName)
 	})
 }
 
@@ -283,6 +619,37 @@ func TestCheckInitializerConformanceErrorMessages(t *testing.T) {
 			conformanceErr.SecondaryError(),
 		)
 	})
+
+	t.Run("missing members and initializer mismatch", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          access(all) resource interface I {
+              fun foo(): Int
+              fun bar(): Int
+              init(x: Int)
+          }
+
+          access(all) resource R: I {
+              init(y: String) {}
+          }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		var conformanceErr *sema.ConformanceError
+		require.ErrorAs(t, errs[0], &conformanceErr)
+
+		require.Len(t, conformanceErr.MissingMembers, 2)
+		require.NotNil(t, conformanceErr.InitializerMismatch)
+
+		require.Equal(t,
+			"`R` is missing definitions for members: `foo`, `bar`. "+
+				"`R` also has a mismatching initializer: expected `init(x: Int)`, got `init(y: String)`",
+			conformanceErr.SecondaryError(),
+		)
+	})
 }
 
 func TestCheckConformanceAccessModifierMatches(t *testing.T) {
@@ -355,6 +722,20 @@ func TestCheckConformanceAccessModifierMatches(t *testing.T) {
 		}
 	}
 
+	// Implementations may widen primitive accesses,
+	// but entitlement-based accesses must match exactly
+	isWidening := func(interfaceAccess, implementationAccess sema.Access) bool {
+		interfacePrimitiveAccess, ok := interfaceAccess.(sema.PrimitiveAccess)
+		if !ok {
+			return false
+		}
+		implementationPrimitiveAccess, ok := implementationAccess.(sema.PrimitiveAccess)
+		if !ok {
+			return false
+		}
+		return implementationPrimitiveAccess > interfacePrimitiveAccess
+	}
+
 	test := func(t *testing.T, interfaceAccess, implementationAccess sema.Access) {
 		name := fmt.Sprintf("%s %s", interfaceAccess, implementationAccess)
 		t.Run(name, func(t *testing.T) {
@@ -381,7 +762,9 @@ func TestCheckConformanceAccessModifierMatches(t *testing.T) {
 			)
 
 			if interfaceAccess == sema.PrimitiveAccess(ast.AccessSelf) {
-				if implementationAccess == sema.PrimitiveAccess(ast.AccessSelf) {
+				if implementationAccess == sema.PrimitiveAccess(ast.AccessSelf) ||
+					isWidening(interfaceAccess, implementationAccess) {
+
 					errs := RequireCheckerErrors(t, err, 1)
 
 					require.IsType(t, &sema.InvalidAccessModifierError{}, errs[0])
@@ -391,7 +774,9 @@ func TestCheckConformanceAccessModifierMatches(t *testing.T) {
 					require.IsType(t, &sema.InvalidAccessModifierError{}, errs[0])
 					require.IsType(t, &sema.ConformanceError{}, errs[1])
 				}
-			} else if !implementationAccess.Equal(interfaceAccess) {
+			} else if !implementationAccess.Equal(interfaceAccess) &&
+				!isWidening(interfaceAccess, implementationAccess) {
+
 				errs := RequireCheckerErrors(t, err, 1)
 
 				var conformanceErr *sema.ConformanceError
@@ -411,3 +796,264 @@ func TestCheckConformanceAccessModifierMatches(t *testing.T) {
 		}
 	}
 }
+
+func TestCheckConformanceFieldVariableKind(t *testing.T) {
+
+	t.Parallel()
+
+	test := func(t *testing.T, interfaceKind, implementationKind ast.VariableKind) {
+		name := fmt.Sprintf("%s %s", interfaceKind.Keyword(), implementationKind.Keyword())
+		t.Run(name, func(t *testing.T) {
+
+			t.Parallel()
+
+			_, err := ParseAndCheck(t,
+				fmt.Sprintf(
+					`
+                      struct interface SI {
+                          access(all) %s x: Int
+                      }
+
+                      struct S: SI {
+                          access(all) %s x: Int
+
+                          init() {
+                              self.x = 1
+                          }
+                      }
+                    `,
+					interfaceKind.Keyword(),
+					implementationKind.Keyword(),
+				),
+			)
+
+			if implementationKind == interfaceKind {
+				require.NoError(t, err)
+				return
+			}
+
+			errs := RequireCheckerErrors(t, err, 1)
+
+			var conformanceErr *sema.ConformanceError
+			require.ErrorAs(t, errs[0], &conformanceErr)
+
+			require.Len(t, conformanceErr.MemberMismatches, 1)
+
+			notes := conformanceErr.ErrorNotes()
+			require.Len(t, notes, 2)
+
+			require.IsType(t, &sema.MemberMismatchNote{}, notes[0])
+
+			require.IsType(t, &sema.VariableKindMismatchNote{}, notes[1])
+			variableKindMismatchNote := notes[1].(*sema.VariableKindMismatchNote)
+
+			assert.Equal(t,
+				fmt.Sprintf(
+					"mismatched variable kind: expected `%s`, got `%s`",
+					interfaceKind.Keyword(),
+					implementationKind.Keyword(),
+				),
+				variableKindMismatchNote.Message(),
+			)
+		})
+	}
+
+	for _, interfaceKind := range ast.VariableKinds {
+		for _, implementationKind := range ast.VariableKinds {
+			test(t, interfaceKind, implementationKind)
+		}
+	}
+}
+
+func TestCheckConformanceFieldAccess(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("narrowing", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct interface SI {
+              access(all) let x: Int
+          }
+
+          struct S: SI {
+              access(self) let x: Int
+
+              init() {
+                  self.x = 1
+              }
+          }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		var conformanceErr *sema.ConformanceError
+		require.ErrorAs(t, errs[0], &conformanceErr)
+
+		require.Len(t, conformanceErr.MemberMismatches, 1)
+		assert.Equal(t,
+			&sema.AccessMismatch{
+				ExpectedAccess: sema.PrimitiveAccess(ast.AccessAll),
+				ActualAccess:   sema.PrimitiveAccess(ast.AccessSelf),
+			},
+			conformanceErr.MemberMismatches[0].AccessMismatch,
+		)
+		assert.Equal(t,
+			"`S` has a more restrictive access for member `x`: expected `access(all)`, got `access(self)`",
+			conformanceErr.SecondaryError(),
+		)
+	})
+
+	t.Run("widening", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          contract C {
+
+              struct interface SI {
+                  access(contract) let x: Int
+              }
+
+              struct S: SI {
+                  access(all) let x: Int
+
+                  init() {
+                      self.x = 1
+                  }
+              }
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("widening entitlements", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          entitlement E
+
+          struct interface SI {
+              access(E) let x: Int
+          }
+
+          struct S: SI {
+              access(all) let x: Int
+
+              init() {
+                  self.x = 1
+              }
+          }
+        `)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		var conformanceErr *sema.ConformanceError
+		require.ErrorAs(t, errs[0], &conformanceErr)
+
+		require.Len(t, conformanceErr.MemberMismatches, 1)
+		require.NotNil(t, conformanceErr.MemberMismatches[0].AccessMismatch)
+	})
+}
+
+func TestCheckIncompleteConformance(t *testing.T) {
+
+	t.Parallel()
+
+	const code = `
+      access(all) struct interface I {
+          access(all) fun foo(): Int
+          access(all) fun bar(): Int
+      }
+
+      access(all) struct S: I {
+          access(all) fun bar(): Int {
+              return 1
+          }
+      }
+    `
+
+	t.Run("not allowed", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, code)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		var conformanceErr *sema.ConformanceError
+		require.ErrorAs(t, errs[0], &conformanceErr)
+		require.Len(t, conformanceErr.MissingMembers, 1)
+	})
+
+	t.Run("allowed", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheckWithOptions(t,
+			code,
+			ParseAndCheckOptions{
+				Config: &sema.Config{
+					AllowIncompleteConformance: true,
+				},
+			},
+		)
+		require.NoError(t, err)
+
+		warnings := checker.Warnings()
+		require.Len(t, warnings, 1)
+
+		var warning *sema.IncompleteConformanceWarning
+		require.ErrorAs(t, warnings[0], &warning)
+
+		assert.Equal(t, "I", warning.InterfaceType.Identifier)
+		assert.Equal(t,
+			"structure `S` does not fully conform to structure interface `I`",
+			warning.Error(),
+		)
+		assert.Equal(t,
+			"`S` is missing definitions for members: `foo`",
+			warning.SecondaryError(),
+		)
+	})
+
+	t.Run("allowed, mismatch", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheckWithOptions(t,
+			`
+              access(all) struct interface I {
+                  access(all) fun foo(): Int
+                  access(all) fun bar(): Int
+              }
+
+              access(all) struct S: I {
+                  access(all) fun bar(): String {
+                      return ""
+                  }
+              }
+            `,
+			ParseAndCheckOptions{
+				Config: &sema.Config{
+					AllowIncompleteConformance: true,
+				},
+			},
+		)
+
+		errs := RequireCheckerErrors(t, err, 1)
+
+		var conformanceErr *sema.ConformanceError
+		require.ErrorAs(t, errs[0], &conformanceErr)
+		assert.Empty(t, conformanceErr.MissingMembers)
+		assert.Len(t, conformanceErr.MemberMismatches, 1)
+
+		warnings := checker.Warnings()
+		require.Len(t, warnings, 1)
+		require.IsType(t, &sema.IncompleteConformanceWarning{}, warnings[0])
+	})
+}
@@ -0,0 +1,120 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package checker
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/sema"
+	"github.com/onflow/cadence/runtime/stdlib"
+)
+
+func TestCheckDeprecatedIdentifiers(t *testing.T) {
+
+	t.Parallel()
+
+	baseValueActivation := sema.NewVariableActivation(sema.BaseValueActivation)
+	baseValueActivation.DeclareValue(stdlib.AssertFunction)
+	baseValueActivation.DeclareValue(stdlib.PanicFunction)
+
+	parseAndCheck := func(t *testing.T, code string) (*sema.Checker, error) {
+		return ParseAndCheckWithOptions(t,
+			code,
+			ParseAndCheckOptions{
+				Config: &sema.Config{
+					BaseValueActivationHandler: func(_ common.Location) *sema.VariableActivation {
+						return baseValueActivation
+					},
+					DeprecatedIdentifiers: map[string]string{
+						"assert": "use `panic` instead",
+						"Word8":  "",
+					},
+				},
+			},
+		)
+	}
+
+	t.Run("deprecated function", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := parseAndCheck(t, `
+            fun test() {
+                assert(true)
+            }
+        `)
+		require.NoError(t, err)
+
+		warnings := checker.Warnings()
+		require.Len(t, warnings, 1)
+
+		require.IsType(t, &sema.DeprecatedIdentifierWarning{}, warnings[0])
+		warning := warnings[0].(*sema.DeprecatedIdentifierWarning)
+
+		assert.Equal(t, "assert", warning.Identifier)
+		assert.Equal(t, "`assert` is deprecated", warning.Error())
+		assert.Equal(t, "use `panic` instead", warning.SecondaryError())
+		assert.Equal(t,
+			ast.Range{
+				StartPos: ast.Position{Offset: 42, Line: 3, Column: 16},
+				EndPos:   ast.Position{Offset: 47, Line: 3, Column: 21},
+			},
+			warning.Range,
+		)
+	})
+
+	t.Run("deprecated type", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := parseAndCheck(t, `
+            let x: Word8 = 1
+        `)
+		require.NoError(t, err)
+
+		warnings := checker.Warnings()
+		require.Len(t, warnings, 1)
+
+		require.IsType(t, &sema.DeprecatedIdentifierWarning{}, warnings[0])
+		warning := warnings[0].(*sema.DeprecatedIdentifierWarning)
+
+		assert.Equal(t, "Word8", warning.Identifier)
+		assert.Empty(t, warning.SecondaryError())
+	})
+
+	t.Run("other function", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := parseAndCheck(t, `
+            fun test() {
+                panic("test")
+            }
+        `)
+		require.NoError(t, err)
+
+		assert.Empty(t, checker.Warnings())
+	})
+
+}
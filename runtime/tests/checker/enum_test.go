@@ -246,3 +246,40 @@ func TestCheckEnumInContract(t *testing.T) {
 
 	require.NoError(t, err)
 }
+
+func TestCheckEnumCaseDocComments(t *testing.T) {
+
+	t.Parallel()
+
+	checker, err := ParseAndCheck(t, `
+      enum E: UInt8 {
+
+          // not a doc comment
+
+          /// The first case
+          access(all) case a
+
+          access(all) case b
+      }
+    `)
+	require.NoError(t, err)
+
+	constructorType := RequireGlobalValue(t, checker.Elaboration, "E")
+	require.IsType(t, &sema.FunctionType{}, constructorType)
+
+	members := constructorType.(*sema.FunctionType).Members
+
+	first, ok := members.Get("a")
+	require.True(t, ok)
+
+	assert.Equal(t, " The first case", first.DocString)
+
+	require.Len(t, first.DocComments, 1)
+	assert.Equal(t, "The first case", string(first.DocComments[0].Text()))
+	assert.Equal(t, 6, first.DocComments[0].StartPos.Line)
+
+	second, ok := members.Get("b")
+	require.True(t, ok)
+
+	assert.Empty(t, second.DocComments)
+}
@@ -2688,16 +2688,26 @@ func TestCheckInterfaceInheritance(t *testing.T) {
             struct X: B, Q {}
         `)
 
-		errs := RequireCheckerErrors(t, err, 2)
+		errs := RequireCheckerErrors(t, err, 3)
+
+		// The return types of the functions have no common subtype
 
 		conformanceError := &sema.ConformanceError{}
 		require.ErrorAs(t, errs[0], &conformanceError)
 		assert.Equal(t, "B", conformanceError.InterfaceType.QualifiedIdentifier())
 		assert.Equal(t, "A", conformanceError.NestedInterfaceType.QualifiedIdentifier())
+		require.NotNil(t, conformanceError.ReturnTypeConflict)
+		assert.Equal(t, "P", conformanceError.ReturnTypeConflict.InterfaceType.QualifiedIdentifier())
 
 		require.ErrorAs(t, errs[1], &conformanceError)
+		assert.Equal(t, "B", conformanceError.InterfaceType.QualifiedIdentifier())
+		assert.Equal(t, "A", conformanceError.NestedInterfaceType.QualifiedIdentifier())
+		assert.Nil(t, conformanceError.ReturnTypeConflict)
+
+		require.ErrorAs(t, errs[2], &conformanceError)
 		assert.Equal(t, "Q", conformanceError.InterfaceType.QualifiedIdentifier())
 		assert.Equal(t, "P", conformanceError.NestedInterfaceType.QualifiedIdentifier())
+		assert.Nil(t, conformanceError.ReturnTypeConflict)
 	})
 
 	t.Run("duplicate methods same type", func(t *testing.T) {
@@ -609,3 +609,88 @@ func TestCheckSwitchResourceInvalidation(t *testing.T) {
 		assert.IsType(t, &sema.ResourceUseAfterInvalidationError{}, errs[0])
 	})
 }
+
+func TestCheckSwitchStatementDuplicateLiteralCases(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("duplicate string", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          fun test(x: String): Int {
+              switch x {
+              case "a":
+                  return 1
+              case "a":
+                  return 2
+              }
+
+              return 0
+          }
+        `)
+
+		require.NoError(t, err)
+
+		warnings := checker.Warnings()
+		require.Len(t, warnings, 1)
+
+		require.IsType(t, &sema.DuplicateSwitchCaseWarning{}, warnings[0])
+		warning := warnings[0].(*sema.DuplicateSwitchCaseWarning)
+
+		assert.Equal(t, 6, warning.StartPos.Line)
+		assert.Equal(t, 4, warning.PreviousRange.StartPos.Line)
+
+		notes := warning.ErrorNotes()
+		require.Len(t, notes, 1)
+		assert.Equal(t, warning.PreviousRange, notes[0].(sema.DuplicateSwitchCaseNote).Range)
+	})
+
+	t.Run("duplicate integer", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          fun test(x: Int): Int {
+              switch x {
+              case 0x1:
+                  return 1
+              case 2:
+                  return 2
+              case 1:
+                  return 3
+              }
+
+              return 0
+          }
+        `)
+
+		require.NoError(t, err)
+
+		warnings := checker.Warnings()
+		require.Len(t, warnings, 1)
+		require.IsType(t, &sema.DuplicateSwitchCaseWarning{}, warnings[0])
+	})
+
+	t.Run("distinct", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          fun test(x: String): Int {
+              switch x {
+              case "a":
+                  return 1
+              case "b":
+                  return 2
+              default:
+                  return 0
+              }
+          }
+        `)
+
+		require.NoError(t, err)
+		require.Empty(t, checker.Warnings())
+	})
+}
@@ -34,28 +34,33 @@ func TestInterpretPathCapability(t *testing.T) {
 
 	t.Parallel()
 
-	test := func(t *testing.T, code string) (*interpreter.Interpreter, error) {
-		borrowType := &sema.ReferenceType{
-			Type:          sema.StringType,
-			Authorization: sema.UnauthorizedAccess,
-		}
+	borrowType := &sema.ReferenceType{
+		Type:          sema.StringType,
+		Authorization: sema.UnauthorizedAccess,
+	}
+
+	borrowStaticType := interpreter.ConvertSemaToStaticType(nil, borrowType)
+
+	address := interpreter.AddressValue{0x42}
+
+	path := interpreter.PathValue{
+		Domain:     common.PathDomainStorage,
+		Identifier: "foo",
+	}
 
-		borrowStaticType := interpreter.ConvertSemaToStaticType(nil, borrowType)
+	testWithCapability := func(
+		t *testing.T,
+		code string,
+		capability *interpreter.PathCapabilityValue, //nolint:staticcheck
+	) (*interpreter.Interpreter, error) {
 
 		value := stdlib.StandardLibraryValue{
 			Type: &sema.CapabilityType{
 				BorrowType: borrowType,
 			},
-			Value: interpreter.NewUnmeteredPathCapabilityValue( //nolint:staticcheck
-				borrowStaticType,
-				interpreter.AddressValue{0x42},
-				interpreter.PathValue{
-					Domain:     common.PathDomainStorage,
-					Identifier: "foo",
-				},
-			),
-			Name: "cap",
-			Kind: common.DeclarationKindConstant,
+			Value: capability,
+			Name:  "cap",
+			Kind:  common.DeclarationKindConstant,
 		}
 
 		baseValueActivation := sema.NewVariableActivation(sema.BaseValueActivation)
@@ -83,6 +88,18 @@ func TestInterpretPathCapability(t *testing.T) {
 		)
 	}
 
+	test := func(t *testing.T, code string) (*interpreter.Interpreter, error) {
+		return testWithCapability(
+			t,
+			code,
+			interpreter.NewUnmeteredPathCapabilityValue( //nolint:staticcheck
+				borrowStaticType,
+				address,
+				path,
+			),
+		)
+	}
+
 	t.Run("transfer", func(t *testing.T) {
 
 		t.Parallel()
@@ -144,4 +161,70 @@ func TestInterpretPathCapability(t *testing.T) {
 		require.NoError(t, err)
 		require.Equal(t, interpreter.UInt64Value(0), res)
 	})
+	t.Run("id, resolved", func(t *testing.T) {
+
+		t.Parallel()
+
+		capability := interpreter.NewUnmeteredPathCapabilityValueWithIDResolver( //nolint:staticcheck
+			borrowStaticType,
+			address,
+			path,
+			func(addressPath interpreter.AddressPath, borrowType interpreter.StaticType) (interpreter.UInt64Value, bool) {
+				require.Equal(t,
+					interpreter.AddressPath{
+						Address: common.Address(address),
+						Path:    path,
+					},
+					addressPath,
+				)
+				require.Equal(t, borrowStaticType, borrowType)
+
+				return 42, true
+			},
+		)
+
+		inter, err := testWithCapability(
+			t,
+			`
+              fun test(): UInt64 {
+                  return cap.id
+              }
+            `,
+			capability,
+		)
+		require.NoError(t, err)
+
+		res, err := inter.Invoke("test")
+		require.NoError(t, err)
+		require.Equal(t, interpreter.UInt64Value(42), res)
+	})
+
+	t.Run("id, unresolved", func(t *testing.T) {
+
+		t.Parallel()
+
+		capability := interpreter.NewUnmeteredPathCapabilityValueWithIDResolver( //nolint:staticcheck
+			borrowStaticType,
+			address,
+			path,
+			func(_ interpreter.AddressPath, _ interpreter.StaticType) (interpreter.UInt64Value, bool) {
+				return 0, false
+			},
+		)
+
+		inter, err := testWithCapability(
+			t,
+			`
+              fun test(): UInt64 {
+                  return cap.id
+              }
+            `,
+			capability,
+		)
+		require.NoError(t, err)
+
+		res, err := inter.Invoke("test")
+		require.NoError(t, err)
+		require.Equal(t, interpreter.InvalidCapabilityID, res)
+	})
 }
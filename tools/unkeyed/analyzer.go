@@ -10,6 +10,7 @@ import (
 	"fmt"
 	"go/ast"
 	"go/types"
+	"strings"
 
 	"golang.org/x/tools/go/analysis"
 	"golang.org/x/tools/go/analysis/passes/inspect"
@@ -26,15 +27,34 @@ var Analyzer = &analysis.Analyzer{
 	Run:              run,
 }
 
+var allowlistFlag string
+
+func init() {
+	Analyzer.Flags.StringVar(
+		&allowlistFlag,
+		"allowlist",
+		"",
+		"comma-separated list of package-qualified struct types which may be used unkeyed (e.g. image.Point)",
+	)
+}
+
 func run(pass *analysis.Pass) (interface{}, error) {
 	inspect := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
 
+	allowlist := parseAllowlist(allowlistFlag)
+
+	ignoredLines := parseIgnoreDirectives(pass)
+
 	nodeFilter := []ast.Node{
 		(*ast.CompositeLit)(nil),
 	}
 	inspect.Preorder(nodeFilter, func(n ast.Node) {
 		cl := n.(*ast.CompositeLit)
 
+		if isIgnored(pass, cl, ignoredLines) {
+			return
+		}
+
 		typ := pass.TypesInfo.Types[cl].Type
 		if typ == nil {
 			// cannot determine composite literals' type, skip it
@@ -56,6 +76,10 @@ func run(pass *analysis.Pass) (interface{}, error) {
 		}
 
 		for _, typ := range structuralTypes {
+			if isAllowlisted(typ, allowlist) {
+				continue
+			}
+
 			under := deref(typ.Underlying())
 
 			strct, ok := under.(*types.Struct)
@@ -78,6 +102,13 @@ func run(pass *analysis.Pass) (interface{}, error) {
 					if !field.Exported() {
 						// Adding unexported field names for structs not defined
 						// locally will not work.
+						// This also excludes blank fields.
+						suggestedFixAvailable = false
+						break
+					}
+					if field.Embedded() {
+						// The name of an embedded field is derived from its type,
+						// which might not be resolvable at the literal.
 						suggestedFixAvailable = false
 						break
 					}
@@ -110,6 +141,122 @@ func run(pass *analysis.Pass) (interface{}, error) {
 	return nil, nil
 }
 
+// parseAllowlist parses a comma-separated list of package-qualified type names
+func parseAllowlist(list string) map[string]struct{} {
+	allowlist := map[string]struct{}{}
+	for _, name := range strings.Split(list, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		allowlist[name] = struct{}{}
+	}
+	return allowlist
+}
+
+// Comments with these directives suppress reports for composite literals starting on the same line,
+// i.e. `//unkeyed:ignore` or `//nolint:unkeyed`
+const (
+	ignoreDirective       = "unkeyed:ignore"
+	nolintDirectivePrefix = "nolint:"
+	nolintLinterName      = "unkeyed"
+)
+
+// fileLine identifies a line in a file
+type fileLine struct {
+	filename string
+	line     int
+}
+
+// parseIgnoreDirectives returns the lines of all files of the package
+// which have a comment suppressing reports of unkeyed composite literals
+func parseIgnoreDirectives(pass *analysis.Pass) map[fileLine]struct{} {
+	ignoredLines := map[fileLine]struct{}{}
+
+	for _, file := range pass.Files {
+		for _, group := range file.Comments {
+			for _, comment := range group.List {
+				if !isIgnoreDirective(comment.Text) {
+					continue
+				}
+
+				position := pass.Fset.Position(comment.Slash)
+				ignoredLines[fileLine{
+					filename: position.Filename,
+					line:     position.Line,
+				}] = struct{}{}
+			}
+		}
+	}
+
+	return ignoredLines
+}
+
+// isIgnoreDirective returns true if the given comment is `//unkeyed:ignore`,
+// or a `//nolint` directive which lists the unkeyed linter, e.g. `//nolint:unkeyed,staticcheck`
+func isIgnoreDirective(text string) bool {
+	text, ok := strings.CutPrefix(text, "//")
+	if !ok {
+		return false
+	}
+
+	if text == ignoreDirective {
+		return true
+	}
+
+	linters, ok := strings.CutPrefix(text, nolintDirectivePrefix)
+	if !ok {
+		return false
+	}
+
+	// Strip an optional explanation, e.g. `//nolint:unkeyed // reason`
+	linters, _, _ = strings.Cut(linters, " ")
+
+	for _, linter := range strings.Split(linters, ",") {
+		if linter == nolintLinterName {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isIgnored returns true if the given composite literal starts on a line
+// which has an ignore directive
+func isIgnored(pass *analysis.Pass, cl *ast.CompositeLit, ignoredLines map[fileLine]struct{}) bool {
+	if len(ignoredLines) == 0 {
+		return false
+	}
+
+	position := pass.Fset.Position(cl.Pos())
+	_, ok := ignoredLines[fileLine{
+		filename: position.Filename,
+		line:     position.Line,
+	}]
+	return ok
+}
+
+// isAllowlisted returns true if the given type is a named type
+// which is contained in the allowlist
+func isAllowlisted(typ types.Type, allowlist map[string]struct{}) bool {
+	if len(allowlist) == 0 {
+		return false
+	}
+
+	named, ok := typ.(*types.Named)
+	if !ok {
+		return false
+	}
+
+	obj := named.Obj()
+	if obj.Pkg() == nil {
+		return false
+	}
+
+	_, ok = allowlist[obj.Pkg().Path()+"."+obj.Name()]
+	return ok
+}
+
 func deref(typ types.Type) types.Type {
 	for {
 		ptr, ok := typ.(*types.Pointer)
@@ -19,6 +19,9 @@
 package main
 
 import (
+	"encoding/json"
+	"os/exec"
+	"strings"
 	"testing"
 
 	"golang.org/x/exp/typeparams"
@@ -33,3 +36,86 @@ func TestAll(t *testing.T) {
 	}
 	analysistest.RunWithSuggestedFixes(t, testdata, Analyzer, pkgs...)
 }
+
+func TestAllowlist(t *testing.T) {
+	err := Analyzer.Flags.Set("allowlist", "image.Point")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		_ = Analyzer.Flags.Set("allowlist", "")
+	}()
+
+	testdata := analysistest.TestData()
+	analysistest.Run(t, testdata, Analyzer, "allowlist")
+}
+
+func TestIgnoreDirectives(t *testing.T) {
+	testdata := analysistest.TestData()
+	analysistest.Run(t, testdata, Analyzer, "ignore")
+}
+
+// jsonDiagnostic is a diagnostic, as emitted by the -json flag of the analysis driver
+type jsonDiagnostic struct {
+	Posn           string `json:"posn"`
+	Message        string `json:"message"`
+	SuggestedFixes []struct {
+		Message string `json:"message"`
+		Edits   []struct {
+			Filename string `json:"filename"`
+			Start    int    `json:"start"`
+			End      int    `json:"end"`
+			New      string `json:"new"`
+		} `json:"edits"`
+	} `json:"suggested_fixes"`
+}
+
+func TestJSONOutput(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping building and running the analyzer in short mode")
+	}
+
+	cmd := exec.Command(
+		"go", "run", ".",
+		"-json",
+		"-allowlist=image.Point",
+		"./testdata/src/allowlist",
+	)
+	output, err := cmd.Output()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The output maps package paths to analyzer names to diagnostics
+	var tree map[string]map[string][]jsonDiagnostic
+	err = json.Unmarshal(output, &tree)
+	if err != nil {
+		t.Fatalf("invalid JSON output: %s\n%s", err, output)
+	}
+
+	const packagePath = "github.com/onflow/cadence/tools/unkeyed/testdata/src/allowlist"
+	diagnostics := tree[packagePath][Analyzer.Name]
+	if len(diagnostics) != 1 {
+		t.Fatalf("expected one diagnostic, got %d:\n%s", len(diagnostics), output)
+	}
+
+	diagnostic := diagnostics[0]
+
+	if !strings.HasSuffix(diagnostic.Posn, "allowlist.go:29:17") {
+		t.Errorf("unexpected position: %s", diagnostic.Posn)
+	}
+
+	const expectedMessage = "image.Rectangle struct literal uses unkeyed fields"
+	if diagnostic.Message != expectedMessage {
+		t.Errorf("unexpected message: %s", diagnostic.Message)
+	}
+
+	if len(diagnostic.SuggestedFixes) != 1 {
+		t.Fatalf("expected one suggested fix, got %d", len(diagnostic.SuggestedFixes))
+	}
+
+	edits := diagnostic.SuggestedFixes[0].Edits
+	if len(edits) != 2 || edits[0].New != "Min: " || edits[1].New != "Max: " {
+		t.Errorf("unexpected edits: %+v", edits)
+	}
+}
@@ -153,3 +153,41 @@ var badNamedPointerSliceLiteral = []*unicode.CaseRange{
 	{1, 2, delta},                   // want "unkeyed fields"
 	&unicode.CaseRange{1, 2, delta}, // want "unkeyed fields"
 }
+
+type Inner struct {
+	A int
+	B int
+}
+
+type Outer struct {
+	Inner Inner
+	C     int
+}
+
+var NestedOK = Outer{
+	Inner: Inner{A: 1, B: 2},
+	C:     3,
+}
+
+var NestedBad = Outer{ // want "unkeyed fields"
+	Inner{1, 2}, // want "unkeyed fields"
+	3,
+}
+
+// Embedded fields are named after their type.
+// We expect a diagnostic but no suggested fix.
+type Embedding struct {
+	Inner
+	C int
+}
+
+var EmbeddedBad = Embedding{Inner{A: 1, B: 2}, 3} // want "unkeyed fields"
+
+// Blank fields cannot be keyed.
+// We expect a diagnostic but no suggested fix.
+type WithBlank struct {
+	A int
+	_ int
+}
+
+var BlankBad = WithBlank{1, 2} // want "unkeyed fields"
@@ -0,0 +1,32 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package allowlist
+
+import (
+	"image"
+)
+
+// image.Point is allowlisted, so it may be used unkeyed
+var Point = image.Point{1, 2}
+
+// image.Rectangle is not allowlisted
+var Rectangle = image.Rectangle{ // want "unkeyed fields"
+	image.Point{1, 2},
+	image.Point{3, 4},
+}
@@ -0,0 +1,39 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright Flow Foundation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ignore
+
+import (
+	"image"
+)
+
+var Annotated = image.Point{1, 2} //nolint:unkeyed
+
+var AnnotatedWithOtherLinters = image.Point{1, 2} //nolint:staticcheck,unkeyed // reason
+
+var AnnotatedIgnore = image.Point{1, 2} //unkeyed:ignore
+
+var AnnotatedOtherLinter = image.Point{1, 2} //nolint:staticcheck // want "unkeyed fields"
+
+var Unannotated = image.Point{1, 2} // want "unkeyed fields"
+
+// Only the literal starting on the annotated line is ignored
+var Rectangle = image.Rectangle{ //nolint:unkeyed
+	image.Point{1, 2}, // want "unkeyed fields"
+	image.Point{X: 3, Y: 4},
+}